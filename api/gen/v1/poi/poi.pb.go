@@ -11,6 +11,7 @@ import (
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -22,16 +23,126 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type SortBy int32
+
+const (
+	// the service default, sorts by distance, or by route position for route searches
+	SortBy_SORT_BY_UNSPECIFIED SortBy = 0
+	SortBy_SORT_BY_DISTANCE    SortBy = 1
+	SortBy_SORT_BY_ID          SortBy = 2
+	// only valid for route searches
+	SortBy_SORT_BY_ROUTE_POSITION SortBy = 3
+)
+
+// Enum value maps for SortBy.
+var (
+	SortBy_name = map[int32]string{
+		0: "SORT_BY_UNSPECIFIED",
+		1: "SORT_BY_DISTANCE",
+		2: "SORT_BY_ID",
+		3: "SORT_BY_ROUTE_POSITION",
+	}
+	SortBy_value = map[string]int32{
+		"SORT_BY_UNSPECIFIED":    0,
+		"SORT_BY_DISTANCE":       1,
+		"SORT_BY_ID":             2,
+		"SORT_BY_ROUTE_POSITION": 3,
+	}
+)
+
+func (x SortBy) Enum() *SortBy {
+	p := new(SortBy)
+	*p = x
+	return p
+}
+
+func (x SortBy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SortBy) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_poi_poi_proto_enumTypes[0].Descriptor()
+}
+
+func (SortBy) Type() protoreflect.EnumType {
+	return &file_v1_poi_poi_proto_enumTypes[0]
+}
+
+func (x SortBy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SortBy.Descriptor instead.
+func (SortBy) EnumDescriptor() ([]byte, []int) {
+	return file_v1_poi_poi_proto_rawDescGZIP(), []int{0}
+}
+
+type ConnectorType int32
+
+const (
+	// accepts AC and DC charging stations, unknown for the connector of a PoI
+	ConnectorType_CONNECTOR_TYPE_UNSPECIFIED ConnectorType = 0
+	ConnectorType_CONNECTOR_TYPE_AC          ConnectorType = 1
+	ConnectorType_CONNECTOR_TYPE_DC          ConnectorType = 2
+)
+
+// Enum value maps for ConnectorType.
+var (
+	ConnectorType_name = map[int32]string{
+		0: "CONNECTOR_TYPE_UNSPECIFIED",
+		1: "CONNECTOR_TYPE_AC",
+		2: "CONNECTOR_TYPE_DC",
+	}
+	ConnectorType_value = map[string]int32{
+		"CONNECTOR_TYPE_UNSPECIFIED": 0,
+		"CONNECTOR_TYPE_AC":          1,
+		"CONNECTOR_TYPE_DC":          2,
+	}
+)
+
+func (x ConnectorType) Enum() *ConnectorType {
+	p := new(ConnectorType)
+	*p = x
+	return p
+}
+
+func (x ConnectorType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ConnectorType) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_poi_poi_proto_enumTypes[1].Descriptor()
+}
+
+func (ConnectorType) Type() protoreflect.EnumType {
+	return &file_v1_poi_poi_proto_enumTypes[1]
+}
+
+func (x ConnectorType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ConnectorType.Descriptor instead.
+func (ConnectorType) EnumDescriptor() ([]byte, []int) {
+	return file_v1_poi_poi_proto_rawDescGZIP(), []int{1}
+}
+
 type PoI struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id         string      `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Coordinate *Coordinate `protobuf:"bytes,2,opt,name=coordinate,proto3" json:"coordinate,omitempty"`
-	Entrance   *Coordinate `protobuf:"bytes,3,opt,name=entrance,proto3,oneof" json:"entrance,omitempty"`
-	Address    *Address    `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
-	Features   []string    `protobuf:"bytes,5,rep,name=features,proto3" json:"features,omitempty"`
+	Id                      string        `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Coordinate              *Coordinate   `protobuf:"bytes,2,opt,name=coordinate,proto3" json:"coordinate,omitempty"`
+	Entrance                *Coordinate   `protobuf:"bytes,3,opt,name=entrance,proto3,oneof" json:"entrance,omitempty"`
+	Address                 *Address      `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
+	Features                []string      `protobuf:"bytes,5,rep,name=features,proto3" json:"features,omitempty"`
+	DistanceMeters          *float64      `protobuf:"fixed64,6,opt,name=distance_meters,json=distanceMeters,proto3,oneof" json:"distance_meters,omitempty"`
+	BearingDegrees          *float64      `protobuf:"fixed64,7,opt,name=bearing_degrees,json=bearingDegrees,proto3,oneof" json:"bearing_degrees,omitempty"`
+	RouteFraction           *float64      `protobuf:"fixed64,8,opt,name=route_fraction,json=routeFraction,proto3,oneof" json:"route_fraction,omitempty"`
+	DistanceFromStartMeters *float64      `protobuf:"fixed64,9,opt,name=distance_from_start_meters,json=distanceFromStartMeters,proto3,oneof" json:"distance_from_start_meters,omitempty"`
+	Charging                *ChargingInfo `protobuf:"bytes,10,opt,name=charging,proto3" json:"charging,omitempty"`
+	Version                 int64         `protobuf:"varint,11,opt,name=version,proto3" json:"version,omitempty"`
 }
 
 func (x *PoI) Reset() {
@@ -101,6 +212,166 @@ func (x *PoI) GetFeatures() []string {
 	return nil
 }
 
+func (x *PoI) GetDistanceMeters() float64 {
+	if x != nil && x.DistanceMeters != nil {
+		return *x.DistanceMeters
+	}
+	return 0
+}
+
+func (x *PoI) GetBearingDegrees() float64 {
+	if x != nil && x.BearingDegrees != nil {
+		return *x.BearingDegrees
+	}
+	return 0
+}
+
+func (x *PoI) GetRouteFraction() float64 {
+	if x != nil && x.RouteFraction != nil {
+		return *x.RouteFraction
+	}
+	return 0
+}
+
+func (x *PoI) GetDistanceFromStartMeters() float64 {
+	if x != nil && x.DistanceFromStartMeters != nil {
+		return *x.DistanceFromStartMeters
+	}
+	return 0
+}
+
+func (x *PoI) GetCharging() *ChargingInfo {
+	if x != nil {
+		return x.Charging
+	}
+	return nil
+}
+
+func (x *PoI) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type ChargingInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MaxPowerKw       float64      `protobuf:"fixed64,1,opt,name=max_power_kw,json=maxPowerKw,proto3" json:"max_power_kw,omitempty"`
+	ChargePointCount int32        `protobuf:"varint,2,opt,name=charge_point_count,json=chargePointCount,proto3" json:"charge_point_count,omitempty"`
+	Connectors       []*Connector `protobuf:"bytes,3,rep,name=connectors,proto3" json:"connectors,omitempty"`
+}
+
+func (x *ChargingInfo) Reset() {
+	*x = ChargingInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_poi_poi_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChargingInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChargingInfo) ProtoMessage() {}
+
+func (x *ChargingInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_poi_poi_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChargingInfo.ProtoReflect.Descriptor instead.
+func (*ChargingInfo) Descriptor() ([]byte, []int) {
+	return file_v1_poi_poi_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ChargingInfo) GetMaxPowerKw() float64 {
+	if x != nil {
+		return x.MaxPowerKw
+	}
+	return 0
+}
+
+func (x *ChargingInfo) GetChargePointCount() int32 {
+	if x != nil {
+		return x.ChargePointCount
+	}
+	return 0
+}
+
+func (x *ChargingInfo) GetConnectors() []*Connector {
+	if x != nil {
+		return x.Connectors
+	}
+	return nil
+}
+
+type Connector struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlugType    string        `protobuf:"bytes,1,opt,name=plug_type,json=plugType,proto3" json:"plug_type,omitempty"`
+	CurrentType ConnectorType `protobuf:"varint,2,opt,name=current_type,json=currentType,proto3,enum=api.poi.v1.ConnectorType" json:"current_type,omitempty"`
+}
+
+func (x *Connector) Reset() {
+	*x = Connector{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_poi_poi_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Connector) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Connector) ProtoMessage() {}
+
+func (x *Connector) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_poi_poi_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Connector.ProtoReflect.Descriptor instead.
+func (*Connector) Descriptor() ([]byte, []int) {
+	return file_v1_poi_poi_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Connector) GetPlugType() string {
+	if x != nil {
+		return x.PlugType
+	}
+	return ""
+}
+
+func (x *Connector) GetCurrentType() ConnectorType {
+	if x != nil {
+		return x.CurrentType
+	}
+	return ConnectorType_CONNECTOR_TYPE_UNSPECIFIED
+}
+
 type Coordinate struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -113,7 +384,7 @@ type Coordinate struct {
 func (x *Coordinate) Reset() {
 	*x = Coordinate{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_poi_poi_proto_msgTypes[1]
+		mi := &file_v1_poi_poi_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -126,7 +397,7 @@ func (x *Coordinate) String() string {
 func (*Coordinate) ProtoMessage() {}
 
 func (x *Coordinate) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_poi_poi_proto_msgTypes[1]
+	mi := &file_v1_poi_poi_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -139,7 +410,7 @@ func (x *Coordinate) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Coordinate.ProtoReflect.Descriptor instead.
 func (*Coordinate) Descriptor() ([]byte, []int) {
-	return file_v1_poi_poi_proto_rawDescGZIP(), []int{1}
+	return file_v1_poi_poi_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *Coordinate) GetLon() float64 {
@@ -171,7 +442,7 @@ type Address struct {
 func (x *Address) Reset() {
 	*x = Address{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_poi_poi_proto_msgTypes[2]
+		mi := &file_v1_poi_poi_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -184,7 +455,7 @@ func (x *Address) String() string {
 func (*Address) ProtoMessage() {}
 
 func (x *Address) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_poi_poi_proto_msgTypes[2]
+	mi := &file_v1_poi_poi_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -197,7 +468,7 @@ func (x *Address) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Address.ProtoReflect.Descriptor instead.
 func (*Address) Descriptor() ([]byte, []int) {
-	return file_v1_poi_poi_proto_rawDescGZIP(), []int{2}
+	return file_v1_poi_poi_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *Address) GetStreet() string {
@@ -247,7 +518,7 @@ type BBox struct {
 func (x *BBox) Reset() {
 	*x = BBox{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_poi_poi_proto_msgTypes[3]
+		mi := &file_v1_poi_poi_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -260,7 +531,7 @@ func (x *BBox) String() string {
 func (*BBox) ProtoMessage() {}
 
 func (x *BBox) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_poi_poi_proto_msgTypes[3]
+	mi := &file_v1_poi_poi_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -273,7 +544,7 @@ func (x *BBox) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BBox.ProtoReflect.Descriptor instead.
 func (*BBox) Descriptor() ([]byte, []int) {
-	return file_v1_poi_poi_proto_rawDescGZIP(), []int{3}
+	return file_v1_poi_poi_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *BBox) GetSw() *Coordinate {
@@ -301,7 +572,7 @@ type PoIRequest struct {
 func (x *PoIRequest) Reset() {
 	*x = PoIRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_poi_poi_proto_msgTypes[4]
+		mi := &file_v1_poi_poi_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -314,7 +585,7 @@ func (x *PoIRequest) String() string {
 func (*PoIRequest) ProtoMessage() {}
 
 func (x *PoIRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_poi_poi_proto_msgTypes[4]
+	mi := &file_v1_poi_poi_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -327,7 +598,7 @@ func (x *PoIRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PoIRequest.ProtoReflect.Descriptor instead.
 func (*PoIRequest) Descriptor() ([]byte, []int) {
-	return file_v1_poi_poi_proto_rawDescGZIP(), []int{4}
+	return file_v1_poi_poi_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *PoIRequest) GetId() string {
@@ -342,13 +613,14 @@ type PoIResponse struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Poi *PoI `protobuf:"bytes,1,opt,name=poi,proto3" json:"poi,omitempty"`
+	Poi  *PoI   `protobuf:"bytes,1,opt,name=poi,proto3" json:"poi,omitempty"`
+	Etag string `protobuf:"bytes,2,opt,name=etag,proto3" json:"etag,omitempty"`
 }
 
 func (x *PoIResponse) Reset() {
 	*x = PoIResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_poi_poi_proto_msgTypes[5]
+		mi := &file_v1_poi_poi_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -361,7 +633,7 @@ func (x *PoIResponse) String() string {
 func (*PoIResponse) ProtoMessage() {}
 
 func (x *PoIResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_poi_poi_proto_msgTypes[5]
+	mi := &file_v1_poi_poi_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -374,7 +646,7 @@ func (x *PoIResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PoIResponse.ProtoReflect.Descriptor instead.
 func (*PoIResponse) Descriptor() ([]byte, []int) {
-	return file_v1_poi_poi_proto_rawDescGZIP(), []int{5}
+	return file_v1_poi_poi_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *PoIResponse) GetPoi() *PoI {
@@ -384,32 +656,38 @@ func (x *PoIResponse) GetPoi() *PoI {
 	return nil
 }
 
-type ProximityRequest struct {
+func (x *PoIResponse) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+type BatchGetPoIsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Center       *Coordinate `protobuf:"bytes,1,opt,name=center,proto3" json:"center,omitempty"`
-	RadiusMeters float64     `protobuf:"fixed64,2,opt,name=radius_meters,json=radiusMeters,proto3" json:"radius_meters,omitempty"`
+	Ids []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
 }
 
-func (x *ProximityRequest) Reset() {
-	*x = ProximityRequest{}
+func (x *BatchGetPoIsRequest) Reset() {
+	*x = BatchGetPoIsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_poi_poi_proto_msgTypes[6]
+		mi := &file_v1_poi_poi_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ProximityRequest) String() string {
+func (x *BatchGetPoIsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProximityRequest) ProtoMessage() {}
+func (*BatchGetPoIsRequest) ProtoMessage() {}
 
-func (x *ProximityRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_poi_poi_proto_msgTypes[6]
+func (x *BatchGetPoIsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_poi_poi_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -420,50 +698,45 @@ func (x *ProximityRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProximityRequest.ProtoReflect.Descriptor instead.
-func (*ProximityRequest) Descriptor() ([]byte, []int) {
-	return file_v1_poi_poi_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use BatchGetPoIsRequest.ProtoReflect.Descriptor instead.
+func (*BatchGetPoIsRequest) Descriptor() ([]byte, []int) {
+	return file_v1_poi_poi_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *ProximityRequest) GetCenter() *Coordinate {
+func (x *BatchGetPoIsRequest) GetIds() []string {
 	if x != nil {
-		return x.Center
+		return x.Ids
 	}
 	return nil
 }
 
-func (x *ProximityRequest) GetRadiusMeters() float64 {
-	if x != nil {
-		return x.RadiusMeters
-	}
-	return 0
-}
-
-type BBoxRequest struct {
+type BatchGetPoIsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Bbox *BBox `protobuf:"bytes,1,opt,name=bbox,proto3" json:"bbox,omitempty"`
+	Items      []*PoI   `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	MissingIds []string `protobuf:"bytes,2,rep,name=missing_ids,json=missingIds,proto3" json:"missing_ids,omitempty"`
+	Etags      []string `protobuf:"bytes,3,rep,name=etags,proto3" json:"etags,omitempty"`
 }
 
-func (x *BBoxRequest) Reset() {
-	*x = BBoxRequest{}
+func (x *BatchGetPoIsResponse) Reset() {
+	*x = BatchGetPoIsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_poi_poi_proto_msgTypes[7]
+		mi := &file_v1_poi_poi_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BBoxRequest) String() string {
+func (x *BatchGetPoIsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BBoxRequest) ProtoMessage() {}
+func (*BatchGetPoIsResponse) ProtoMessage() {}
 
-func (x *BBoxRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_poi_poi_proto_msgTypes[7]
+func (x *BatchGetPoIsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_poi_poi_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -474,43 +747,57 @@ func (x *BBoxRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BBoxRequest.ProtoReflect.Descriptor instead.
-func (*BBoxRequest) Descriptor() ([]byte, []int) {
-	return file_v1_poi_poi_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use BatchGetPoIsResponse.ProtoReflect.Descriptor instead.
+func (*BatchGetPoIsResponse) Descriptor() ([]byte, []int) {
+	return file_v1_poi_poi_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *BBoxRequest) GetBbox() *BBox {
+func (x *BatchGetPoIsResponse) GetItems() []*PoI {
 	if x != nil {
-		return x.Bbox
+		return x.Items
 	}
 	return nil
 }
 
-type RouteRequest struct {
+func (x *BatchGetPoIsResponse) GetMissingIds() []string {
+	if x != nil {
+		return x.MissingIds
+	}
+	return nil
+}
+
+func (x *BatchGetPoIsResponse) GetEtags() []string {
+	if x != nil {
+		return x.Etags
+	}
+	return nil
+}
+
+type CreatePoIRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Route []*Coordinate `protobuf:"bytes,1,rep,name=route,proto3" json:"route,omitempty"`
+	Poi *PoI `protobuf:"bytes,1,opt,name=poi,proto3" json:"poi,omitempty"`
 }
 
-func (x *RouteRequest) Reset() {
-	*x = RouteRequest{}
+func (x *CreatePoIRequest) Reset() {
+	*x = CreatePoIRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_poi_poi_proto_msgTypes[8]
+		mi := &file_v1_poi_poi_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RouteRequest) String() string {
+func (x *CreatePoIRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RouteRequest) ProtoMessage() {}
+func (*CreatePoIRequest) ProtoMessage() {}
 
-func (x *RouteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_poi_poi_proto_msgTypes[8]
+func (x *CreatePoIRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_poi_poi_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -521,30 +808,1382 @@ func (x *RouteRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RouteRequest.ProtoReflect.Descriptor instead.
-func (*RouteRequest) Descriptor() ([]byte, []int) {
-	return file_v1_poi_poi_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use CreatePoIRequest.ProtoReflect.Descriptor instead.
+func (*CreatePoIRequest) Descriptor() ([]byte, []int) {
+	return file_v1_poi_poi_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CreatePoIRequest) GetPoi() *PoI {
+	if x != nil {
+		return x.Poi
+	}
+	return nil
+}
+
+type UpdatePoIRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Poi        *PoI                   `protobuf:"bytes,1,opt,name=poi,proto3" json:"poi,omitempty"`
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	Etag       string                 `protobuf:"bytes,3,opt,name=etag,proto3" json:"etag,omitempty"`
+}
+
+func (x *UpdatePoIRequest) Reset() {
+	*x = UpdatePoIRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_poi_poi_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdatePoIRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePoIRequest) ProtoMessage() {}
+
+func (x *UpdatePoIRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_poi_poi_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePoIRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePoIRequest) Descriptor() ([]byte, []int) {
+	return file_v1_poi_poi_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *UpdatePoIRequest) GetPoi() *PoI {
+	if x != nil {
+		return x.Poi
+	}
+	return nil
+}
+
+func (x *UpdatePoIRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *UpdatePoIRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+type DeletePoIRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Etag string `protobuf:"bytes,2,opt,name=etag,proto3" json:"etag,omitempty"`
+}
+
+func (x *DeletePoIRequest) Reset() {
+	*x = DeletePoIRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_poi_poi_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeletePoIRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePoIRequest) ProtoMessage() {}
+
+func (x *DeletePoIRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_poi_poi_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePoIRequest.ProtoReflect.Descriptor instead.
+func (*DeletePoIRequest) Descriptor() ([]byte, []int) {
+	return file_v1_poi_poi_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *DeletePoIRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeletePoIRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+type DeletePoIResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeletePoIResponse) Reset() {
+	*x = DeletePoIResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_poi_poi_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeletePoIResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePoIResponse) ProtoMessage() {}
+
+func (x *DeletePoIResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_poi_poi_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePoIResponse.ProtoReflect.Descriptor instead.
+func (*DeletePoIResponse) Descriptor() ([]byte, []int) {
+	return file_v1_poi_poi_proto_rawDescGZIP(), []int{13}
+}
+
+type SearchFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Features       []string        `protobuf:"bytes,1,rep,name=features,proto3" json:"features,omitempty"`
+	MinPowerKw     float64         `protobuf:"fixed64,2,opt,name=min_power_kw,json=minPowerKw,proto3" json:"min_power_kw,omitempty"`
+	ConnectorTypes []ConnectorType `protobuf:"varint,3,rep,packed,name=connector_types,json=connectorTypes,proto3,enum=api.poi.v1.ConnectorType" json:"connector_types,omitempty"`
+	PlugTypes      []string        `protobuf:"bytes,4,rep,name=plug_types,json=plugTypes,proto3" json:"plug_types,omitempty"`
+	Countries      []string        `protobuf:"bytes,5,rep,name=countries,proto3" json:"countries,omitempty"`
+}
+
+func (x *SearchFilter) Reset() {
+	*x = SearchFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_poi_poi_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchFilter) ProtoMessage() {}
+
+func (x *SearchFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_poi_poi_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchFilter.ProtoReflect.Descriptor instead.
+func (*SearchFilter) Descriptor() ([]byte, []int) {
+	return file_v1_poi_poi_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *SearchFilter) GetFeatures() []string {
+	if x != nil {
+		return x.Features
+	}
+	return nil
+}
+
+func (x *SearchFilter) GetMinPowerKw() float64 {
+	if x != nil {
+		return x.MinPowerKw
+	}
+	return 0
+}
+
+func (x *SearchFilter) GetConnectorTypes() []ConnectorType {
+	if x != nil {
+		return x.ConnectorTypes
+	}
+	return nil
+}
+
+func (x *SearchFilter) GetPlugTypes() []string {
+	if x != nil {
+		return x.PlugTypes
+	}
+	return nil
+}
+
+func (x *SearchFilter) GetCountries() []string {
+	if x != nil {
+		return x.Countries
+	}
+	return nil
+}
+
+type ProximityRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Center       *Coordinate   `protobuf:"bytes,1,opt,name=center,proto3" json:"center,omitempty"`
+	RadiusMeters float64       `protobuf:"fixed64,2,opt,name=radius_meters,json=radiusMeters,proto3" json:"radius_meters,omitempty"`
+	Strict       bool          `protobuf:"varint,3,opt,name=strict,proto3" json:"strict,omitempty"`
+	SortBy       SortBy        `protobuf:"varint,4,opt,name=sort_by,json=sortBy,proto3,enum=api.poi.v1.SortBy" json:"sort_by,omitempty"`
+	PageSize     int32         `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken    string        `protobuf:"bytes,6,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	Filter       *SearchFilter `protobuf:"bytes,7,opt,name=filter,proto3" json:"filter,omitempty"`
+}
+
+func (x *ProximityRequest) Reset() {
+	*x = ProximityRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_poi_poi_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProximityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProximityRequest) ProtoMessage() {}
+
+func (x *ProximityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_poi_poi_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProximityRequest.ProtoReflect.Descriptor instead.
+func (*ProximityRequest) Descriptor() ([]byte, []int) {
+	return file_v1_poi_poi_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ProximityRequest) GetCenter() *Coordinate {
+	if x != nil {
+		return x.Center
+	}
+	return nil
+}
+
+func (x *ProximityRequest) GetRadiusMeters() float64 {
+	if x != nil {
+		return x.RadiusMeters
+	}
+	return 0
+}
+
+func (x *ProximityRequest) GetStrict() bool {
+	if x != nil {
+		return x.Strict
+	}
+	return false
+}
+
+func (x *ProximityRequest) GetSortBy() SortBy {
+	if x != nil {
+		return x.SortBy
+	}
+	return SortBy_SORT_BY_UNSPECIFIED
+}
+
+func (x *ProximityRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ProximityRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ProximityRequest) GetFilter() *SearchFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+type BBoxRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Bbox      *BBox         `protobuf:"bytes,1,opt,name=bbox,proto3" json:"bbox,omitempty"`
+	Strict    bool          `protobuf:"varint,2,opt,name=strict,proto3" json:"strict,omitempty"`
+	SortBy    SortBy        `protobuf:"varint,3,opt,name=sort_by,json=sortBy,proto3,enum=api.poi.v1.SortBy" json:"sort_by,omitempty"`
+	PageSize  int32         `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string        `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	Filter    *SearchFilter `protobuf:"bytes,6,opt,name=filter,proto3" json:"filter,omitempty"`
+}
+
+func (x *BBoxRequest) Reset() {
+	*x = BBoxRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_poi_poi_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BBoxRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BBoxRequest) ProtoMessage() {}
+
+func (x *BBoxRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_poi_poi_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BBoxRequest.ProtoReflect.Descriptor instead.
+func (*BBoxRequest) Descriptor() ([]byte, []int) {
+	return file_v1_poi_poi_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *BBoxRequest) GetBbox() *BBox {
+	if x != nil {
+		return x.Bbox
+	}
+	return nil
+}
+
+func (x *BBoxRequest) GetStrict() bool {
+	if x != nil {
+		return x.Strict
+	}
+	return false
+}
+
+func (x *BBoxRequest) GetSortBy() SortBy {
+	if x != nil {
+		return x.SortBy
+	}
+	return SortBy_SORT_BY_UNSPECIFIED
+}
+
+func (x *BBoxRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *BBoxRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *BBoxRequest) GetFilter() *SearchFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+type RouteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Route          []*Coordinate `protobuf:"bytes,1,rep,name=route,proto3" json:"route,omitempty"`
+	SortBy         SortBy        `protobuf:"varint,2,opt,name=sort_by,json=sortBy,proto3,enum=api.poi.v1.SortBy" json:"sort_by,omitempty"`
+	PageSize       int32         `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken      string        `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	CorridorMeters float64       `protobuf:"fixed64,5,opt,name=corridor_meters,json=corridorMeters,proto3" json:"corridor_meters,omitempty"`
+	Filter         *SearchFilter `protobuf:"bytes,6,opt,name=filter,proto3" json:"filter,omitempty"`
+}
+
+func (x *RouteRequest) Reset() {
+	*x = RouteRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_poi_poi_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RouteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RouteRequest) ProtoMessage() {}
+
+func (x *RouteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_poi_poi_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RouteRequest.ProtoReflect.Descriptor instead.
+func (*RouteRequest) Descriptor() ([]byte, []int) {
+	return file_v1_poi_poi_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *RouteRequest) GetRoute() []*Coordinate {
+	if x != nil {
+		return x.Route
+	}
+	return nil
+}
+
+func (x *RouteRequest) GetSortBy() SortBy {
+	if x != nil {
+		return x.SortBy
+	}
+	return SortBy_SORT_BY_UNSPECIFIED
+}
+
+func (x *RouteRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *RouteRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *RouteRequest) GetCorridorMeters() float64 {
+	if x != nil {
+		return x.CorridorMeters
+	}
+	return 0
+}
+
+func (x *RouteRequest) GetFilter() *SearchFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+type Ring struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Coordinates []*Coordinate `protobuf:"bytes,1,rep,name=coordinates,proto3" json:"coordinates,omitempty"`
+}
+
+func (x *Ring) Reset() {
+	*x = Ring{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_poi_poi_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Ring) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Ring) ProtoMessage() {}
+
+func (x *Ring) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_poi_poi_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Ring.ProtoReflect.Descriptor instead.
+func (*Ring) Descriptor() ([]byte, []int) {
+	return file_v1_poi_poi_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *Ring) GetCoordinates() []*Coordinate {
+	if x != nil {
+		return x.Coordinates
+	}
+	return nil
+}
+
+type PolygonRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rings     []*Ring       `protobuf:"bytes,1,rep,name=rings,proto3" json:"rings,omitempty"`
+	SortBy    SortBy        `protobuf:"varint,2,opt,name=sort_by,json=sortBy,proto3,enum=api.poi.v1.SortBy" json:"sort_by,omitempty"`
+	PageSize  int32         `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string        `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	Filter    *SearchFilter `protobuf:"bytes,5,opt,name=filter,proto3" json:"filter,omitempty"`
+}
+
+func (x *PolygonRequest) Reset() {
+	*x = PolygonRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_poi_poi_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PolygonRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PolygonRequest) ProtoMessage() {}
+
+func (x *PolygonRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_poi_poi_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PolygonRequest.ProtoReflect.Descriptor instead.
+func (*PolygonRequest) Descriptor() ([]byte, []int) {
+	return file_v1_poi_poi_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *PolygonRequest) GetRings() []*Ring {
+	if x != nil {
+		return x.Rings
+	}
+	return nil
+}
+
+func (x *PolygonRequest) GetSortBy() SortBy {
+	if x != nil {
+		return x.SortBy
+	}
+	return SortBy_SORT_BY_UNSPECIFIED
+}
+
+func (x *PolygonRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *PolygonRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *PolygonRequest) GetFilter() *SearchFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+type NearestRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Center          *Coordinate   `protobuf:"bytes,1,opt,name=center,proto3" json:"center,omitempty"`
+	K               int32         `protobuf:"varint,2,opt,name=k,proto3" json:"k,omitempty"`
+	MaxRadiusMeters float64       `protobuf:"fixed64,3,opt,name=max_radius_meters,json=maxRadiusMeters,proto3" json:"max_radius_meters,omitempty"`
+	SortBy          SortBy        `protobuf:"varint,4,opt,name=sort_by,json=sortBy,proto3,enum=api.poi.v1.SortBy" json:"sort_by,omitempty"`
+	Filter          *SearchFilter `protobuf:"bytes,5,opt,name=filter,proto3" json:"filter,omitempty"`
+}
+
+func (x *NearestRequest) Reset() {
+	*x = NearestRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_poi_poi_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NearestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NearestRequest) ProtoMessage() {}
+
+func (x *NearestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_poi_poi_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NearestRequest.ProtoReflect.Descriptor instead.
+func (*NearestRequest) Descriptor() ([]byte, []int) {
+	return file_v1_poi_poi_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *NearestRequest) GetCenter() *Coordinate {
+	if x != nil {
+		return x.Center
+	}
+	return nil
+}
+
+func (x *NearestRequest) GetK() int32 {
+	if x != nil {
+		return x.K
+	}
+	return 0
+}
+
+func (x *NearestRequest) GetMaxRadiusMeters() float64 {
+	if x != nil {
+		return x.MaxRadiusMeters
+	}
+	return 0
+}
+
+func (x *NearestRequest) GetSortBy() SortBy {
+	if x != nil {
+		return x.SortBy
+	}
+	return SortBy_SORT_BY_UNSPECIFIED
+}
+
+func (x *NearestRequest) GetFilter() *SearchFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+type PlanChargingStopsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Route                []*Coordinate `protobuf:"bytes,1,rep,name=route,proto3" json:"route,omitempty"`
+	StateOfChargePercent float64       `protobuf:"fixed64,2,opt,name=state_of_charge_percent,json=stateOfChargePercent,proto3" json:"state_of_charge_percent,omitempty"`
+	UsableRangeMeters    float64       `protobuf:"fixed64,3,opt,name=usable_range_meters,json=usableRangeMeters,proto3" json:"usable_range_meters,omitempty"`
+	ReservePercent       float64       `protobuf:"fixed64,4,opt,name=reserve_percent,json=reservePercent,proto3" json:"reserve_percent,omitempty"`
+	TargetPercent        float64       `protobuf:"fixed64,5,opt,name=target_percent,json=targetPercent,proto3" json:"target_percent,omitempty"`
+	MinPowerKw           float64       `protobuf:"fixed64,6,opt,name=min_power_kw,json=minPowerKw,proto3" json:"min_power_kw,omitempty"`
+	Connector            ConnectorType `protobuf:"varint,7,opt,name=connector,proto3,enum=api.poi.v1.ConnectorType" json:"connector,omitempty"`
+	CorridorMeters       float64       `protobuf:"fixed64,8,opt,name=corridor_meters,json=corridorMeters,proto3" json:"corridor_meters,omitempty"`
+}
+
+func (x *PlanChargingStopsRequest) Reset() {
+	*x = PlanChargingStopsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_poi_poi_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlanChargingStopsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanChargingStopsRequest) ProtoMessage() {}
+
+func (x *PlanChargingStopsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_poi_poi_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanChargingStopsRequest.ProtoReflect.Descriptor instead.
+func (*PlanChargingStopsRequest) Descriptor() ([]byte, []int) {
+	return file_v1_poi_poi_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *PlanChargingStopsRequest) GetRoute() []*Coordinate {
+	if x != nil {
+		return x.Route
+	}
+	return nil
+}
+
+func (x *PlanChargingStopsRequest) GetStateOfChargePercent() float64 {
+	if x != nil {
+		return x.StateOfChargePercent
+	}
+	return 0
+}
+
+func (x *PlanChargingStopsRequest) GetUsableRangeMeters() float64 {
+	if x != nil {
+		return x.UsableRangeMeters
+	}
+	return 0
+}
+
+func (x *PlanChargingStopsRequest) GetReservePercent() float64 {
+	if x != nil {
+		return x.ReservePercent
+	}
+	return 0
+}
+
+func (x *PlanChargingStopsRequest) GetTargetPercent() float64 {
+	if x != nil {
+		return x.TargetPercent
+	}
+	return 0
+}
+
+func (x *PlanChargingStopsRequest) GetMinPowerKw() float64 {
+	if x != nil {
+		return x.MinPowerKw
+	}
+	return 0
+}
+
+func (x *PlanChargingStopsRequest) GetConnector() ConnectorType {
+	if x != nil {
+		return x.Connector
+	}
+	return ConnectorType_CONNECTOR_TYPE_UNSPECIFIED
+}
+
+func (x *PlanChargingStopsRequest) GetCorridorMeters() float64 {
+	if x != nil {
+		return x.CorridorMeters
+	}
+	return 0
+}
+
+type ChargingStop struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Station                       *PoI    `protobuf:"bytes,1,opt,name=station,proto3" json:"station,omitempty"`
+	ArrivalStateOfChargePercent   float64 `protobuf:"fixed64,2,opt,name=arrival_state_of_charge_percent,json=arrivalStateOfChargePercent,proto3" json:"arrival_state_of_charge_percent,omitempty"`
+	DepartureStateOfChargePercent float64 `protobuf:"fixed64,3,opt,name=departure_state_of_charge_percent,json=departureStateOfChargePercent,proto3" json:"departure_state_of_charge_percent,omitempty"`
+	Alternates                    []*PoI  `protobuf:"bytes,4,rep,name=alternates,proto3" json:"alternates,omitempty"`
+}
+
+func (x *ChargingStop) Reset() {
+	*x = ChargingStop{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_poi_poi_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChargingStop) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChargingStop) ProtoMessage() {}
+
+func (x *ChargingStop) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_poi_poi_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChargingStop.ProtoReflect.Descriptor instead.
+func (*ChargingStop) Descriptor() ([]byte, []int) {
+	return file_v1_poi_poi_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ChargingStop) GetStation() *PoI {
+	if x != nil {
+		return x.Station
+	}
+	return nil
+}
+
+func (x *ChargingStop) GetArrivalStateOfChargePercent() float64 {
+	if x != nil {
+		return x.ArrivalStateOfChargePercent
+	}
+	return 0
+}
+
+func (x *ChargingStop) GetDepartureStateOfChargePercent() float64 {
+	if x != nil {
+		return x.DepartureStateOfChargePercent
+	}
+	return 0
+}
+
+func (x *ChargingStop) GetAlternates() []*PoI {
+	if x != nil {
+		return x.Alternates
+	}
+	return nil
+}
+
+type PlanChargingStopsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Stops                       []*ChargingStop `protobuf:"bytes,1,rep,name=stops,proto3" json:"stops,omitempty"`
+	ArrivalStateOfChargePercent float64         `protobuf:"fixed64,2,opt,name=arrival_state_of_charge_percent,json=arrivalStateOfChargePercent,proto3" json:"arrival_state_of_charge_percent,omitempty"`
+	RouteLengthMeters           float64         `protobuf:"fixed64,3,opt,name=route_length_meters,json=routeLengthMeters,proto3" json:"route_length_meters,omitempty"`
+}
+
+func (x *PlanChargingStopsResponse) Reset() {
+	*x = PlanChargingStopsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_poi_poi_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlanChargingStopsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanChargingStopsResponse) ProtoMessage() {}
+
+func (x *PlanChargingStopsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_poi_poi_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanChargingStopsResponse.ProtoReflect.Descriptor instead.
+func (*PlanChargingStopsResponse) Descriptor() ([]byte, []int) {
+	return file_v1_poi_poi_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *PlanChargingStopsResponse) GetStops() []*ChargingStop {
+	if x != nil {
+		return x.Stops
+	}
+	return nil
+}
+
+func (x *PlanChargingStopsResponse) GetArrivalStateOfChargePercent() float64 {
+	if x != nil {
+		return x.ArrivalStateOfChargePercent
+	}
+	return 0
+}
+
+func (x *PlanChargingStopsResponse) GetRouteLengthMeters() float64 {
+	if x != nil {
+		return x.RouteLengthMeters
+	}
+	return 0
+}
+
+type ClustersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Bbox *BBox `protobuf:"bytes,1,opt,name=bbox,proto3" json:"bbox,omitempty"`
+	Zoom int32 `protobuf:"varint,2,opt,name=zoom,proto3" json:"zoom,omitempty"`
+}
+
+func (x *ClustersRequest) Reset() {
+	*x = ClustersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_poi_poi_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClustersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClustersRequest) ProtoMessage() {}
+
+func (x *ClustersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_poi_poi_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClustersRequest.ProtoReflect.Descriptor instead.
+func (*ClustersRequest) Descriptor() ([]byte, []int) {
+	return file_v1_poi_poi_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ClustersRequest) GetBbox() *BBox {
+	if x != nil {
+		return x.Bbox
+	}
+	return nil
+}
+
+func (x *ClustersRequest) GetZoom() int32 {
+	if x != nil {
+		return x.Zoom
+	}
+	return 0
+}
+
+type Cluster struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Centroid *Coordinate `protobuf:"bytes,1,opt,name=centroid,proto3" json:"centroid,omitempty"`
+	Count    int32       `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Features []string    `protobuf:"bytes,3,rep,name=features,proto3" json:"features,omitempty"`
 }
 
-func (x *RouteRequest) GetRoute() []*Coordinate {
+func (x *Cluster) Reset() {
+	*x = Cluster{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_poi_poi_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Cluster) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Cluster) ProtoMessage() {}
+
+func (x *Cluster) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_poi_poi_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Cluster.ProtoReflect.Descriptor instead.
+func (*Cluster) Descriptor() ([]byte, []int) {
+	return file_v1_poi_poi_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *Cluster) GetCentroid() *Coordinate {
 	if x != nil {
-		return x.Route
+		return x.Centroid
+	}
+	return nil
+}
+
+func (x *Cluster) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *Cluster) GetFeatures() []string {
+	if x != nil {
+		return x.Features
+	}
+	return nil
+}
+
+type ClustersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Clusters        []*Cluster `protobuf:"bytes,1,rep,name=clusters,proto3" json:"clusters,omitempty"`
+	Truncated       bool       `protobuf:"varint,2,opt,name=truncated,proto3" json:"truncated,omitempty"`
+	CoveredFraction float64    `protobuf:"fixed64,3,opt,name=covered_fraction,json=coveredFraction,proto3" json:"covered_fraction,omitempty"`
+}
+
+func (x *ClustersResponse) Reset() {
+	*x = ClustersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_poi_poi_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClustersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClustersResponse) ProtoMessage() {}
+
+func (x *ClustersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_poi_poi_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClustersResponse.ProtoReflect.Descriptor instead.
+func (*ClustersResponse) Descriptor() ([]byte, []int) {
+	return file_v1_poi_poi_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ClustersResponse) GetClusters() []*Cluster {
+	if x != nil {
+		return x.Clusters
+	}
+	return nil
+}
+
+func (x *ClustersResponse) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+func (x *ClustersResponse) GetCoveredFraction() float64 {
+	if x != nil {
+		return x.CoveredFraction
+	}
+	return 0
+}
+
+type DensityRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Bbox  *BBox   `protobuf:"bytes,1,opt,name=bbox,proto3" json:"bbox,omitempty"`
+	Rings []*Ring `protobuf:"bytes,2,rep,name=rings,proto3" json:"rings,omitempty"`
+	Level int32   `protobuf:"varint,3,opt,name=level,proto3" json:"level,omitempty"`
+}
+
+func (x *DensityRequest) Reset() {
+	*x = DensityRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_poi_poi_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DensityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DensityRequest) ProtoMessage() {}
+
+func (x *DensityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_poi_poi_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DensityRequest.ProtoReflect.Descriptor instead.
+func (*DensityRequest) Descriptor() ([]byte, []int) {
+	return file_v1_poi_poi_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *DensityRequest) GetBbox() *BBox {
+	if x != nil {
+		return x.Bbox
+	}
+	return nil
+}
+
+func (x *DensityRequest) GetRings() []*Ring {
+	if x != nil {
+		return x.Rings
+	}
+	return nil
+}
+
+func (x *DensityRequest) GetLevel() int32 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+type DensityCell struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token        string      `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Center       *Coordinate `protobuf:"bytes,2,opt,name=center,proto3" json:"center,omitempty"`
+	Count        int32       `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	ChargePoints int32       `protobuf:"varint,4,opt,name=charge_points,json=chargePoints,proto3" json:"charge_points,omitempty"`
+	PowerKw      float64     `protobuf:"fixed64,5,opt,name=power_kw,json=powerKw,proto3" json:"power_kw,omitempty"`
+}
+
+func (x *DensityCell) Reset() {
+	*x = DensityCell{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_poi_poi_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DensityCell) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DensityCell) ProtoMessage() {}
+
+func (x *DensityCell) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_poi_poi_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DensityCell.ProtoReflect.Descriptor instead.
+func (*DensityCell) Descriptor() ([]byte, []int) {
+	return file_v1_poi_poi_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *DensityCell) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *DensityCell) GetCenter() *Coordinate {
+	if x != nil {
+		return x.Center
+	}
+	return nil
+}
+
+func (x *DensityCell) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *DensityCell) GetChargePoints() int32 {
+	if x != nil {
+		return x.ChargePoints
+	}
+	return 0
+}
+
+func (x *DensityCell) GetPowerKw() float64 {
+	if x != nil {
+		return x.PowerKw
+	}
+	return 0
+}
+
+type DensityResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Level           int32          `protobuf:"varint,1,opt,name=level,proto3" json:"level,omitempty"`
+	Cells           []*DensityCell `protobuf:"bytes,2,rep,name=cells,proto3" json:"cells,omitempty"`
+	Precomputed     bool           `protobuf:"varint,3,opt,name=precomputed,proto3" json:"precomputed,omitempty"`
+	Truncated       bool           `protobuf:"varint,4,opt,name=truncated,proto3" json:"truncated,omitempty"`
+	CoveredFraction float64        `protobuf:"fixed64,5,opt,name=covered_fraction,json=coveredFraction,proto3" json:"covered_fraction,omitempty"`
+}
+
+func (x *DensityResponse) Reset() {
+	*x = DensityResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_poi_poi_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DensityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DensityResponse) ProtoMessage() {}
+
+func (x *DensityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_poi_poi_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DensityResponse.ProtoReflect.Descriptor instead.
+func (*DensityResponse) Descriptor() ([]byte, []int) {
+	return file_v1_poi_poi_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *DensityResponse) GetLevel() int32 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *DensityResponse) GetCells() []*DensityCell {
+	if x != nil {
+		return x.Cells
 	}
 	return nil
 }
 
+func (x *DensityResponse) GetPrecomputed() bool {
+	if x != nil {
+		return x.Precomputed
+	}
+	return false
+}
+
+func (x *DensityResponse) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+func (x *DensityResponse) GetCoveredFraction() float64 {
+	if x != nil {
+		return x.CoveredFraction
+	}
+	return 0
+}
+
 type PoISearchResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Items []*PoI `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Items           []*PoI  `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	DiscardedCount  int32   `protobuf:"varint,2,opt,name=discarded_count,json=discardedCount,proto3" json:"discarded_count,omitempty"`
+	NextPageToken   string  `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	Truncated       bool    `protobuf:"varint,4,opt,name=truncated,proto3" json:"truncated,omitempty"`
+	CoveredFraction float64 `protobuf:"fixed64,5,opt,name=covered_fraction,json=coveredFraction,proto3" json:"covered_fraction,omitempty"`
 }
 
 func (x *PoISearchResponse) Reset() {
 	*x = PoISearchResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_poi_poi_proto_msgTypes[9]
+		mi := &file_v1_poi_poi_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -557,7 +2196,7 @@ func (x *PoISearchResponse) String() string {
 func (*PoISearchResponse) ProtoMessage() {}
 
 func (x *PoISearchResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_poi_poi_proto_msgTypes[9]
+	mi := &file_v1_poi_poi_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -570,7 +2209,7 @@ func (x *PoISearchResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PoISearchResponse.ProtoReflect.Descriptor instead.
 func (*PoISearchResponse) Descriptor() ([]byte, []int) {
-	return file_v1_poi_poi_proto_rawDescGZIP(), []int{9}
+	return file_v1_poi_poi_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *PoISearchResponse) GetItems() []*PoI {
@@ -580,6 +2219,34 @@ func (x *PoISearchResponse) GetItems() []*PoI {
 	return nil
 }
 
+func (x *PoISearchResponse) GetDiscardedCount() int32 {
+	if x != nil {
+		return x.DiscardedCount
+	}
+	return 0
+}
+
+func (x *PoISearchResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *PoISearchResponse) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+func (x *PoISearchResponse) GetCoveredFraction() float64 {
+	if x != nil {
+		return x.CoveredFraction
+	}
+	return 0
+}
+
 type ErrorResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -593,7 +2260,7 @@ type ErrorResponse struct {
 func (x *ErrorResponse) Reset() {
 	*x = ErrorResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_poi_poi_proto_msgTypes[10]
+		mi := &file_v1_poi_poi_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -606,7 +2273,7 @@ func (x *ErrorResponse) String() string {
 func (*ErrorResponse) ProtoMessage() {}
 
 func (x *ErrorResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_poi_poi_proto_msgTypes[10]
+	mi := &file_v1_poi_poi_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -619,7 +2286,7 @@ func (x *ErrorResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ErrorResponse.ProtoReflect.Descriptor instead.
 func (*ErrorResponse) Descriptor() ([]byte, []int) {
-	return file_v1_poi_poi_proto_rawDescGZIP(), []int{10}
+	return file_v1_poi_poi_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *ErrorResponse) GetCode() int32 {
@@ -652,7 +2319,7 @@ type ErrorObject struct {
 func (x *ErrorObject) Reset() {
 	*x = ErrorObject{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_poi_poi_proto_msgTypes[11]
+		mi := &file_v1_poi_poi_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -665,7 +2332,7 @@ func (x *ErrorObject) String() string {
 func (*ErrorObject) ProtoMessage() {}
 
 func (x *ErrorObject) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_poi_poi_proto_msgTypes[11]
+	mi := &file_v1_poi_poi_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -678,7 +2345,7 @@ func (x *ErrorObject) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ErrorObject.ProtoReflect.Descriptor instead.
 func (*ErrorObject) Descriptor() ([]byte, []int) {
-	return file_v1_poi_poi_proto_rawDescGZIP(), []int{11}
+	return file_v1_poi_poi_proto_rawDescGZIP(), []int{32}
 }
 
 var File_v1_poi_poi_proto protoreflect.FileDescriptor
@@ -689,298 +2356,1394 @@ var file_v1_poi_poi_proto_rawDesc = []byte{
 	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74,
 	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f,
 	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x62,
-	0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70,
-	0x69, 0x76, 0x32, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x6e, 0x6e, 0x6f,
-	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xbe, 0x06,
-	0x0a, 0x03, 0x50, 0x6f, 0x49, 0x12, 0x6b, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x5b, 0x92, 0x41, 0x58, 0x32, 0x2f, 0x54, 0x68, 0x65, 0x20, 0x75, 0x6e, 0x69, 0x71,
+	0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x6f, 0x70, 0x65, 0x6e,
+	0x61, 0x70, 0x69, 0x76, 0x32, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x6e,
+	0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0xa0, 0x10, 0x0a, 0x03, 0x50, 0x6f, 0x49, 0x12, 0x6b, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x5b, 0x92, 0x41, 0x58, 0x32, 0x2f, 0x54, 0x68, 0x65, 0x20, 0x75, 0x6e,
+	0x69, 0x71, 0x75, 0x65, 0x20, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x20, 0x69, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x66, 0x69, 0x65, 0x72, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x4a, 0x1d, 0x22, 0x32, 0x6a, 0x73, 0x58,
+	0x41, 0x4e, 0x6a, 0x42, 0x71, 0x42, 0x75, 0x39, 0x30, 0x76, 0x61, 0x43, 0x37, 0x75, 0x50, 0x6c,
+	0x34, 0x67, 0x4e, 0x31, 0x79, 0x55, 0x6e, 0x22, 0xa2, 0x02, 0x05, 0x6b, 0x73, 0x75, 0x69, 0x64,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0xb2, 0x01, 0x0a, 0x0a, 0x63, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e,
+	0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x70, 0x6f, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74,
+	0x65, 0x42, 0x7a, 0x92, 0x41, 0x77, 0x32, 0x4e, 0x54, 0x68, 0x65, 0x20, 0x57, 0x47, 0x53, 0x20,
+	0x63, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x20, 0x72, 0x65, 0x70, 0x72, 0x65,
+	0x73, 0x65, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x65, 0x6e, 0x74,
+	0x65, 0x72, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72,
+	0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x50, 0x6f, 0x49, 0x73, 0x27, 0x20, 0x6c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4a, 0x25, 0x7b, 0x22, 0x6c, 0x61, 0x74, 0x22, 0x3a, 0x20,
+	0x34, 0x38, 0x2e, 0x31, 0x33, 0x37, 0x31, 0x35, 0x34, 0x2c, 0x20, 0x22, 0x6c, 0x6f, 0x6e, 0x22,
+	0x3a, 0x20, 0x31, 0x31, 0x2e, 0x35, 0x37, 0x36, 0x31, 0x32, 0x34, 0x20, 0x7d, 0x52, 0x0a, 0x63,
+	0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x12, 0xa7, 0x01, 0x0a, 0x08, 0x65, 0x6e,
+	0x74, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x70, 0x6f, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6f, 0x72, 0x64, 0x69,
+	0x6e, 0x61, 0x74, 0x65, 0x42, 0x6e, 0x92, 0x41, 0x6b, 0x32, 0x42, 0x54, 0x68, 0x65, 0x20, 0x57,
+	0x47, 0x53, 0x20, 0x63, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x20, 0x6d, 0x61,
+	0x72, 0x6b, 0x69, 0x6e, 0x67, 0x20, 0x74, 0x68, 0x65, 0x20, 0x72, 0x6f, 0x61, 0x64, 0x20, 0x65,
+	0x6e, 0x74, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x20, 0x74, 0x6f, 0x20, 0x74, 0x68, 0x65, 0x20, 0x50,
+	0x6f, 0x49, 0x73, 0x27, 0x20, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4a, 0x25, 0x7b,
+	0x22, 0x6c, 0x61, 0x74, 0x22, 0x3a, 0x20, 0x34, 0x38, 0x2e, 0x31, 0x33, 0x37, 0x31, 0x35, 0x34,
+	0x2c, 0x20, 0x22, 0x6c, 0x6f, 0x6e, 0x22, 0x3a, 0x20, 0x31, 0x31, 0x2e, 0x35, 0x37, 0x36, 0x31,
+	0x32, 0x34, 0x20, 0x7d, 0x48, 0x00, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x72, 0x61, 0x6e, 0x63, 0x65,
+	0x88, 0x01, 0x01, 0x12, 0xb8, 0x01, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6f, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x42, 0x88, 0x01, 0x92, 0x41, 0x84,
+	0x01, 0x32, 0x16, 0x54, 0x68, 0x65, 0x20, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x20, 0x6f,
+	0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x50, 0x6f, 0x49, 0x4a, 0x6a, 0x7b, 0x22, 0x73, 0x74, 0x72,
+	0x65, 0x65, 0x74, 0x22, 0x3a, 0x20, 0x22, 0x4d, 0x79, 0x20, 0x53, 0x74, 0x72, 0x65, 0x65, 0x74,
+	0x22, 0x2c, 0x20, 0x22, 0x73, 0x74, 0x72, 0x65, 0x65, 0x74, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x22, 0x3a, 0x20, 0x22, 0x31, 0x62, 0x22, 0x2c, 0x20, 0x22, 0x7a, 0x69, 0x70, 0x5f, 0x63,
+	0x6f, 0x64, 0x65, 0x22, 0x3a, 0x20, 0x38, 0x30, 0x33, 0x33, 0x31, 0x2c, 0x20, 0x22, 0x63, 0x69,
+	0x74, 0x79, 0x22, 0x3a, 0x20, 0x20, 0x22, 0x4d, 0x75, 0x6e, 0x69, 0x63, 0x68, 0x22, 0x2c, 0x20,
+	0x22, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x22, 0x3a, 0x20, 0x22, 0x47, 0x65, 0x72, 0x6d,
+	0x61, 0x6e, 0x79, 0x22, 0x7d, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0xa2,
+	0x01, 0x0a, 0x08, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x09, 0x42, 0x85, 0x01, 0x92, 0x41, 0x81, 0x01, 0x32, 0x4f, 0x41, 0x20, 0x6c, 0x69, 0x73, 0x74,
+	0x20, 0x6f, 0x66, 0x20, 0x66, 0x72, 0x65, 0x65, 0x20, 0x66, 0x6f, 0x72, 0x6d, 0x20, 0x74, 0x65,
+	0x78, 0x74, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x20,
+	0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x61, 0x6d, 0x65,
+	0x6e, 0x69, 0x74, 0x69, 0x65, 0x73, 0x20, 0x61, 0x74, 0x20, 0x67, 0x69, 0x76, 0x65, 0x6e, 0x20,
+	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x4a, 0x2e, 0x5b, 0x22, 0x63, 0x68, 0x61,
+	0x72, 0x67, 0x69, 0x6e, 0x67, 0x22, 0x2c, 0x20, 0x22, 0x72, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67,
+	0x22, 0x2c, 0x20, 0x22, 0x73, 0x68, 0x6f, 0x77, 0x65, 0x72, 0x22, 0x2c, 0x20, 0x22, 0x67, 0x72,
+	0x6f, 0x63, 0x65, 0x72, 0x69, 0x65, 0x73, 0x22, 0x5d, 0x52, 0x08, 0x66, 0x65, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x73, 0x12, 0xdd, 0x01, 0x0a, 0x0f, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x5f, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x42, 0xae, 0x01,
+	0x92, 0x41, 0xaa, 0x01, 0x32, 0x9f, 0x01, 0x54, 0x68, 0x65, 0x20, 0x67, 0x72, 0x65, 0x61, 0x74,
+	0x2d, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x20, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x20, 0x69, 0x6e, 0x20, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x20, 0x74, 0x6f, 0x20, 0x74, 0x68,
+	0x65, 0x20, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x20, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2c,
+	0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x20, 0x6f, 0x66, 0x20, 0x74,
+	0x68, 0x65, 0x20, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x20, 0x62, 0x6f, 0x78, 0x20,
+	0x6f, 0x72, 0x20, 0x70, 0x6f, 0x6c, 0x79, 0x67, 0x6f, 0x6e, 0x2c, 0x20, 0x6f, 0x72, 0x20, 0x74,
+	0x68, 0x65, 0x20, 0x6e, 0x65, 0x61, 0x72, 0x65, 0x73, 0x74, 0x20, 0x72, 0x6f, 0x75, 0x74, 0x65,
+	0x20, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x20, 0x4f, 0x6e, 0x6c, 0x79, 0x20, 0x73,
+	0x65, 0x74, 0x20, 0x69, 0x6e, 0x20, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x20, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x4a, 0x06, 0x31, 0x32, 0x33, 0x34, 0x2e, 0x35, 0x48, 0x01,
+	0x52, 0x0e, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4d, 0x65, 0x74, 0x65, 0x72, 0x73,
+	0x88, 0x01, 0x01, 0x12, 0xbb, 0x01, 0x0a, 0x0f, 0x62, 0x65, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x5f,
+	0x64, 0x65, 0x67, 0x72, 0x65, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x42, 0x8c, 0x01,
+	0x92, 0x41, 0x88, 0x01, 0x32, 0x76, 0x54, 0x68, 0x65, 0x20, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61,
+	0x6c, 0x20, 0x62, 0x65, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x20, 0x69, 0x6e, 0x20, 0x64, 0x65, 0x67,
+	0x72, 0x65, 0x65, 0x73, 0x20, 0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x77, 0x69, 0x73, 0x65, 0x20, 0x66,
+	0x72, 0x6f, 0x6d, 0x20, 0x6e, 0x6f, 0x72, 0x74, 0x68, 0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20, 0x74,
+	0x68, 0x65, 0x20, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x20, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x20, 0x74, 0x6f, 0x20, 0x74, 0x68, 0x65, 0x20, 0x50, 0x6f, 0x49, 0x2e, 0x20,
+	0x4f, 0x6e, 0x6c, 0x79, 0x20, 0x73, 0x65, 0x74, 0x20, 0x69, 0x6e, 0x20, 0x73, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x20, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x4a, 0x05, 0x32, 0x37,
+	0x30, 0x2e, 0x30, 0x59, 0x00, 0x00, 0x00, 0x00, 0x00, 0x80, 0x76, 0x40, 0x48, 0x02, 0x52, 0x0e,
+	0x62, 0x65, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x44, 0x65, 0x67, 0x72, 0x65, 0x65, 0x73, 0x88, 0x01,
+	0x01, 0x12, 0xdc, 0x01, 0x0a, 0x0e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x66, 0x72, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x42, 0xaf, 0x01, 0x92, 0x41, 0xab,
+	0x01, 0x32, 0x99, 0x01, 0x54, 0x68, 0x65, 0x20, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x50, 0x6f, 0x49, 0x20, 0x70, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x20, 0x6f, 0x6e, 0x74, 0x6f, 0x20, 0x74, 0x68, 0x65, 0x20, 0x72,
+	0x6f, 0x75, 0x74, 0x65, 0x20, 0x61, 0x73, 0x20, 0x66, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x20, 0x6c, 0x65,
+	0x6e, 0x67, 0x74, 0x68, 0x2c, 0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20, 0x30, 0x20, 0x61, 0x74, 0x20,
+	0x74, 0x68, 0x65, 0x20, 0x73, 0x74, 0x61, 0x72, 0x74, 0x20, 0x74, 0x6f, 0x20, 0x31, 0x20, 0x61,
+	0x74, 0x20, 0x74, 0x68, 0x65, 0x20, 0x65, 0x6e, 0x64, 0x2e, 0x20, 0x4f, 0x6e, 0x6c, 0x79, 0x20,
+	0x73, 0x65, 0x74, 0x20, 0x69, 0x6e, 0x20, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x20, 0x73, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x20, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x4a, 0x04, 0x30,
+	0x2e, 0x32, 0x35, 0x59, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f, 0x48, 0x03, 0x52, 0x0d,
+	0x72, 0x6f, 0x75, 0x74, 0x65, 0x46, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01,
+	0x12, 0xcf, 0x01, 0x0a, 0x1a, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x66, 0x72,
+	0x6f, 0x6d, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x01, 0x42, 0x8c, 0x01, 0x92, 0x41, 0x88, 0x01, 0x32, 0x7d, 0x54, 0x68,
+	0x65, 0x20, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x20, 0x69, 0x6e, 0x20, 0x6d, 0x65,
+	0x74, 0x65, 0x72, 0x73, 0x20, 0x61, 0x6c, 0x6f, 0x6e, 0x67, 0x20, 0x74, 0x68, 0x65, 0x20, 0x72,
+	0x6f, 0x75, 0x74, 0x65, 0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20, 0x69, 0x74, 0x73, 0x20, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x20, 0x74, 0x6f, 0x20, 0x74, 0x68, 0x65, 0x20, 0x50, 0x6f, 0x49, 0x20, 0x70,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x20, 0x6f, 0x6e, 0x74, 0x6f, 0x20, 0x74, 0x68,
+	0x65, 0x20, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x20, 0x4f, 0x6e, 0x6c, 0x79, 0x20, 0x73, 0x65,
+	0x74, 0x20, 0x69, 0x6e, 0x20, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x20, 0x73, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x20, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x4a, 0x07, 0x32, 0x33, 0x30,
+	0x30, 0x30, 0x2e, 0x30, 0x48, 0x04, 0x52, 0x17, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x46, 0x72, 0x6f, 0x6d, 0x53, 0x74, 0x61, 0x72, 0x74, 0x4d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x88,
+	0x01, 0x01, 0x12, 0xc6, 0x01, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x67, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6f, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x42,
+	0x8f, 0x01, 0x92, 0x41, 0x8b, 0x01, 0x32, 0x88, 0x01, 0x54, 0x68, 0x65, 0x20, 0x73, 0x74, 0x72,
+	0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x64, 0x20, 0x63, 0x68, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x67,
+	0x20, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x20, 0x6f, 0x66,
+	0x20, 0x74, 0x68, 0x65, 0x20, 0x50, 0x6f, 0x49, 0x2e, 0x20, 0x4e, 0x6f, 0x74, 0x20, 0x73, 0x65,
+	0x74, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x50, 0x6f, 0x49, 0x73, 0x20, 0x69, 0x6e, 0x67, 0x65, 0x73,
+	0x74, 0x65, 0x64, 0x20, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x20, 0x63, 0x68, 0x61, 0x72, 0x67,
+	0x69, 0x6e, 0x67, 0x20, 0x69, 0x6e, 0x66, 0x6f, 0x20, 0x77, 0x61, 0x73, 0x20, 0x69, 0x6e, 0x74,
+	0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x64, 0x2c, 0x20, 0x75, 0x73, 0x65, 0x20, 0x74, 0x68, 0x65,
+	0x20, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x20, 0x69, 0x6e, 0x73, 0x74, 0x65, 0x61,
+	0x64, 0x52, 0x08, 0x63, 0x68, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x67, 0x12, 0x6e, 0x0a, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x42, 0x54, 0x92, 0x41,
+	0x4e, 0x32, 0x49, 0x54, 0x68, 0x65, 0x20, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x20, 0x6f,
+	0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x50, 0x6f, 0x49, 0x20, 0x69, 0x6e, 0x63, 0x72, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x65, 0x64, 0x20, 0x62, 0x79, 0x20, 0x65, 0x61, 0x63, 0x68, 0x20, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x2c, 0x20, 0x7a, 0x65, 0x72, 0x6f, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x69,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x20, 0x50, 0x6f, 0x49, 0x73, 0x4a, 0x01, 0x33, 0xe0,
+	0x41, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x0b, 0x0a, 0x09, 0x5f,
+	0x65, 0x6e, 0x74, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x64, 0x69, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x42, 0x12, 0x0a, 0x10,
+	0x5f, 0x62, 0x65, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x65, 0x67, 0x72, 0x65, 0x65, 0x73,
+	0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x66, 0x72, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x42, 0x1d, 0x0a, 0x1b, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x6d, 0x65, 0x74, 0x65,
+	0x72, 0x73, 0x22, 0x92, 0x02, 0x0a, 0x0c, 0x43, 0x68, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x67, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x57, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x6f, 0x77, 0x65, 0x72,
+	0x5f, 0x6b, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x42, 0x35, 0x92, 0x41, 0x32, 0x32, 0x2b,
+	0x54, 0x68, 0x65, 0x20, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x20, 0x63, 0x68, 0x61, 0x72,
+	0x67, 0x69, 0x6e, 0x67, 0x20, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68,
+	0x65, 0x20, 0x50, 0x6f, 0x49, 0x20, 0x69, 0x6e, 0x20, 0x6b, 0x57, 0x4a, 0x03, 0x31, 0x35, 0x30,
+	0x52, 0x0a, 0x6d, 0x61, 0x78, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x4b, 0x77, 0x12, 0x72, 0x0a, 0x12,
+	0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x44, 0x92, 0x41, 0x41, 0x32, 0x3c, 0x54,
+	0x68, 0x65, 0x20, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x20, 0x6f, 0x66, 0x20, 0x76, 0x65, 0x68,
+	0x69, 0x63, 0x6c, 0x65, 0x73, 0x20, 0x77, 0x68, 0x69, 0x63, 0x68, 0x20, 0x63, 0x61, 0x6e, 0x20,
+	0x62, 0x65, 0x20, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x64, 0x20, 0x61, 0x74, 0x20, 0x74, 0x68,
+	0x65, 0x20, 0x73, 0x61, 0x6d, 0x65, 0x20, 0x74, 0x69, 0x6d, 0x65, 0x4a, 0x01, 0x32, 0x52, 0x10,
+	0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x35, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6f, 0x69, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x0a, 0x63, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x22, 0x82, 0x02, 0x0a, 0x09, 0x43, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x5d, 0x0a, 0x09, 0x70, 0x6c, 0x75, 0x67, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x40, 0x92, 0x41, 0x3d, 0x32, 0x26, 0x54,
+	0x68, 0x65, 0x20, 0x70, 0x6c, 0x75, 0x67, 0x20, 0x74, 0x79, 0x70, 0x65, 0x20, 0x61, 0x73, 0x20,
+	0x6e, 0x61, 0x6d, 0x65, 0x64, 0x20, 0x62, 0x79, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x6f, 0x72, 0x4a, 0x13, 0x22, 0x44, 0x43, 0x20, 0x4b, 0x75, 0x70, 0x70, 0x6c,
+	0x75, 0x6e, 0x67, 0x20, 0x43, 0x6f, 0x6d, 0x62, 0x6f, 0x22, 0x52, 0x08, 0x70, 0x6c, 0x75, 0x67,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x95, 0x01, 0x0a, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x70, 0x6f, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x54, 0x79, 0x70, 0x65, 0x42, 0x57, 0x92, 0x41, 0x54, 0x32, 0x3d, 0x54, 0x68, 0x65,
+	0x20, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x20, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x64, 0x20, 0x62, 0x79, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x2c, 0x20, 0x75, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x64, 0x20,
+	0x69, 0x66, 0x20, 0x75, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x4a, 0x13, 0x22, 0x43, 0x4f, 0x4e,
+	0x4e, 0x45, 0x43, 0x54, 0x4f, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x44, 0x43, 0x22, 0x52,
+	0x0b, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x22, 0x8d, 0x01, 0x0a,
+	0x0a, 0x43, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x12, 0x3f, 0x0a, 0x03, 0x6c,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x42, 0x2d, 0x92, 0x41, 0x27, 0x32, 0x1e, 0x4c,
+	0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x20, 0x57, 0x47,
+	0x53, 0x20, 0x63, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x2e, 0x4a, 0x05, 0x31,
+	0x31, 0x2e, 0x35, 0x37, 0xe0, 0x41, 0x02, 0x52, 0x03, 0x6c, 0x6f, 0x6e, 0x12, 0x3e, 0x0a, 0x03,
+	0x6c, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x42, 0x2c, 0x92, 0x41, 0x26, 0x32, 0x1d,
+	0x4c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x20, 0x57, 0x47,
+	0x53, 0x20, 0x63, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x2e, 0x4a, 0x05, 0x34,
+	0x38, 0x2e, 0x31, 0x33, 0xe0, 0x41, 0x02, 0x52, 0x03, 0x6c, 0x61, 0x74, 0x22, 0xea, 0x02, 0x0a,
+	0x07, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x45, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x65,
+	0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2d, 0x92, 0x41, 0x2a, 0x32, 0x16, 0x54,
+	0x68, 0x65, 0x20, 0x6e, 0x61, 0x6d, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x73,
+	0x74, 0x72, 0x65, 0x65, 0x74, 0x4a, 0x10, 0x22, 0x42, 0x69, 0x65, 0x72, 0x67, 0x72, 0x61, 0x74,
+	0x65, 0x6e, 0x73, 0x74, 0x72, 0x2e, 0x22, 0x52, 0x06, 0x73, 0x74, 0x72, 0x65, 0x65, 0x74, 0x12,
+	0x58, 0x0a, 0x0d, 0x73, 0x74, 0x72, 0x65, 0x65, 0x74, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x32, 0x92, 0x41, 0x2f, 0x32, 0x26, 0x54, 0x68, 0x65,
+	0x20, 0x73, 0x74, 0x72, 0x65, 0x65, 0x74, 0x20, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x20, 0x6f,
+	0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x67, 0x69, 0x76, 0x65, 0x6e, 0x20, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x4a, 0x05, 0x22, 0x31, 0x31, 0x61, 0x22, 0x52, 0x0d, 0x73, 0x74, 0x72, 0x65,
+	0x65, 0x74, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x4a, 0x0a, 0x08, 0x7a, 0x69, 0x70,
+	0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2e, 0x92, 0x41, 0x2b,
+	0x32, 0x22, 0x54, 0x68, 0x65, 0x20, 0x7a, 0x69, 0x70, 0x20, 0x63, 0x6f, 0x64, 0x65, 0x20, 0x66,
+	0x6f, 0x72, 0x20, 0x74, 0x68, 0x65, 0x20, 0x67, 0x69, 0x76, 0x65, 0x6e, 0x20, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x4a, 0x05, 0x38, 0x30, 0x33, 0x33, 0x31, 0x52, 0x08, 0x7a, 0x69, 0x70,
+	0x5f, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x37, 0x0a, 0x04, 0x63, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x23, 0x92, 0x41, 0x20, 0x32, 0x14, 0x54, 0x68, 0x65, 0x20, 0x6e, 0x61,
+	0x6d, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x69, 0x74, 0x79, 0x4a, 0x08,
+	0x22, 0x42, 0x65, 0x72, 0x6c, 0x69, 0x6e, 0x22, 0x52, 0x04, 0x63, 0x69, 0x74, 0x79, 0x12, 0x39,
+	0x0a, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x1f, 0x92, 0x41, 0x1c, 0x32, 0x13, 0x41, 0x6c, 0x70, 0x68, 0x61, 0x33, 0x20, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x72, 0x79, 0x20, 0x63, 0x6f, 0x64, 0x65, 0x4a, 0x05, 0x22, 0x44, 0x45, 0x55, 0x22,
+	0x52, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x22, 0xcc, 0x02, 0x0a, 0x04, 0x42, 0x42,
+	0x6f, 0x78, 0x12, 0xa0, 0x01, 0x0a, 0x02, 0x73, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6f, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6f,
+	0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x42, 0x78, 0x92, 0x41, 0x75, 0x32, 0x44, 0x54, 0x68,
+	0x65, 0x20, 0x57, 0x47, 0x53, 0x20, 0x63, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65,
+	0x20, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x20, 0x74, 0x68, 0x65, 0x20, 0x73, 0x6f,
+	0x75, 0x74, 0x68, 0x20, 0x77, 0x65, 0x73, 0x74, 0x20, 0x63, 0x6f, 0x72, 0x6e, 0x65, 0x72, 0x20,
+	0x6f, 0x66, 0x20, 0x61, 0x20, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x20, 0x62, 0x6f,
+	0x78, 0x2e, 0x4a, 0x25, 0x7b, 0x22, 0x6c, 0x61, 0x74, 0x22, 0x3a, 0x20, 0x34, 0x38, 0x2e, 0x31,
+	0x33, 0x37, 0x31, 0x35, 0x34, 0x2c, 0x20, 0x22, 0x6c, 0x6f, 0x6e, 0x22, 0x3a, 0x20, 0x31, 0x31,
+	0x2e, 0x35, 0x37, 0x36, 0x31, 0x32, 0x34, 0x20, 0x7d, 0xca, 0x3e, 0x05, 0xfa, 0x02, 0x02, 0x73,
+	0x77, 0x52, 0x02, 0x73, 0x77, 0x12, 0xa0, 0x01, 0x0a, 0x02, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6f, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x42, 0x78, 0x92, 0x41, 0x75, 0x32,
+	0x44, 0x54, 0x68, 0x65, 0x20, 0x57, 0x47, 0x53, 0x20, 0x63, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e,
+	0x61, 0x74, 0x65, 0x20, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x20, 0x74, 0x68, 0x65,
+	0x20, 0x6e, 0x6f, 0x72, 0x74, 0x68, 0x20, 0x65, 0x61, 0x73, 0x74, 0x20, 0x63, 0x6f, 0x72, 0x6e,
+	0x65, 0x72, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x20, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x20, 0x62, 0x6f, 0x78, 0x2e, 0x4a, 0x25, 0x7b, 0x22, 0x6c, 0x61, 0x74, 0x22, 0x3a, 0x20, 0x34,
+	0x38, 0x2e, 0x31, 0x33, 0x37, 0x31, 0x35, 0x34, 0x2c, 0x20, 0x22, 0x6c, 0x6f, 0x6e, 0x22, 0x3a,
+	0x20, 0x31, 0x31, 0x2e, 0x35, 0x37, 0x36, 0x31, 0x32, 0x34, 0x20, 0x7d, 0xca, 0x3e, 0x05, 0xfa,
+	0x02, 0x02, 0x6e, 0x65, 0x52, 0x02, 0x6e, 0x65, 0x22, 0xbc, 0x01, 0x0a, 0x0a, 0x50, 0x6f, 0x49,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0xad, 0x01, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x9c, 0x01, 0x92, 0x41, 0x98, 0x01, 0x32, 0x1e, 0x54, 0x68, 0x65,
+	0x20, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x20, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
+	0x65, 0x72, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x20, 0x50, 0x6f, 0x49, 0x4a, 0x26, 0x22, 0x32, 0x34,
+	0x33, 0x38, 0x61, 0x63, 0x33, 0x63, 0x2d, 0x33, 0x37, 0x65, 0x62, 0x2d, 0x34, 0x39, 0x30, 0x32,
+	0x2d, 0x61, 0x64, 0x65, 0x66, 0x2d, 0x65, 0x64, 0x31, 0x36, 0x62, 0x34, 0x34, 0x33, 0x31, 0x30,
+	0x33, 0x30, 0x22, 0x8a, 0x01, 0x46, 0x5e, 0x5b, 0x30, 0x2d, 0x39, 0x41, 0x2d, 0x46, 0x5d, 0x7b,
+	0x38, 0x7d, 0x2d, 0x5b, 0x30, 0x2d, 0x39, 0x41, 0x2d, 0x46, 0x5d, 0x7b, 0x34, 0x7d, 0x2d, 0x34,
+	0x5b, 0x30, 0x2d, 0x39, 0x41, 0x2d, 0x46, 0x5d, 0x7b, 0x33, 0x7d, 0x2d, 0x5b, 0x38, 0x39, 0x41,
+	0x42, 0x5d, 0x5b, 0x30, 0x2d, 0x39, 0x41, 0x2d, 0x46, 0x5d, 0x7b, 0x33, 0x7d, 0x2d, 0x5b, 0x30,
+	0x2d, 0x77, 0x39, 0x41, 0x2d, 0x46, 0x5d, 0x7b, 0x31, 0x32, 0x7d, 0x24, 0xa2, 0x02, 0x04, 0x75,
+	0x75, 0x69, 0x64, 0x52, 0x02, 0x69, 0x64, 0x22, 0xcf, 0x01, 0x0a, 0x0b, 0x50, 0x6f, 0x49, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x03, 0x70, 0x6f, 0x69, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6f, 0x69, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x6f, 0x49, 0x52, 0x03, 0x70, 0x6f, 0x69, 0x12, 0x9c, 0x01, 0x0a, 0x04, 0x65,
+	0x74, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x87, 0x01, 0x92, 0x41, 0x83, 0x01,
+	0x32, 0x80, 0x01, 0x54, 0x68, 0x65, 0x20, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x20, 0x74, 0x61,
+	0x67, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x50, 0x6f, 0x49, 0x2c, 0x20, 0x70, 0x61, 0x73,
+	0x73, 0x20, 0x69, 0x74, 0x20, 0x74, 0x6f, 0x20, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x20,
+	0x61, 0x6e, 0x64, 0x20, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x73, 0x20, 0x74, 0x6f, 0x20, 0x66,
+	0x61, 0x69, 0x6c, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x41, 0x42, 0x4f, 0x52, 0x54, 0x45, 0x44,
+	0x20, 0x69, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x50, 0x6f, 0x49, 0x20, 0x68, 0x61, 0x73, 0x20,
+	0x62, 0x65, 0x65, 0x6e, 0x20, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x20, 0x73, 0x69,
+	0x6e, 0x63, 0x65, 0x52, 0x04, 0x65, 0x74, 0x61, 0x67, 0x22, 0x96, 0x01, 0x0a, 0x13, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x49, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x7f, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x42, 0x6d,
+	0x92, 0x41, 0x6a, 0x32, 0x40, 0x54, 0x68, 0x65, 0x20, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x20,
+	0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x74,
+	0x68, 0x65, 0x20, 0x50, 0x6f, 0x49, 0x73, 0x2c, 0x20, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x65, 0x73, 0x20, 0x61, 0x72, 0x65, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64,
+	0x20, 0x6f, 0x6e, 0x63, 0x65, 0x4a, 0x1f, 0x5b, 0x22, 0x32, 0x6a, 0x73, 0x58, 0x41, 0x4e, 0x6a,
+	0x42, 0x71, 0x42, 0x75, 0x39, 0x30, 0x76, 0x61, 0x43, 0x37, 0x75, 0x50, 0x6c, 0x34, 0x67, 0x4e,
+	0x31, 0x79, 0x55, 0x6e, 0x22, 0x5d, 0xa0, 0x01, 0xfa, 0x01, 0xa8, 0x01, 0x01, 0x52, 0x03, 0x69,
+	0x64, 0x73, 0x22, 0x95, 0x03, 0x0a, 0x14, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x50,
+	0x6f, 0x49, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x05, 0x69,
+	0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x70, 0x6f, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x49, 0x42, 0x35, 0x92, 0x41, 0x32,
+	0x32, 0x30, 0x54, 0x68, 0x65, 0x20, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x20, 0x50, 0x6f, 0x49, 0x73,
+	0x20, 0x69, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x20, 0x6f, 0x66,
+	0x20, 0x74, 0x68, 0x65, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x20, 0x69,
+	0x64, 0x73, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x73, 0x0a, 0x0b, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x42, 0x52,
+	0x92, 0x41, 0x4f, 0x32, 0x2c, 0x54, 0x68, 0x65, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x65, 0x64, 0x20, 0x69, 0x64, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x50, 0x6f, 0x49, 0x73, 0x20, 0x77,
+	0x68, 0x69, 0x63, 0x68, 0x20, 0x64, 0x6f, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x65, 0x78, 0x69, 0x73,
+	0x74, 0x4a, 0x1f, 0x5b, 0x22, 0x32, 0x6a, 0x73, 0x58, 0x41, 0x4e, 0x6a, 0x42, 0x71, 0x42, 0x75,
+	0x39, 0x30, 0x76, 0x61, 0x43, 0x37, 0x75, 0x50, 0x6c, 0x34, 0x67, 0x4e, 0x31, 0x79, 0x55, 0x6e,
+	0x22, 0x5d, 0x52, 0x0a, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x73, 0x12, 0xa9,
+	0x01, 0x0a, 0x05, 0x65, 0x74, 0x61, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x42, 0x92,
+	0x01, 0x92, 0x41, 0x8e, 0x01, 0x32, 0x8b, 0x01, 0x54, 0x68, 0x65, 0x20, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x20, 0x74, 0x61, 0x67, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x66,
+	0x6f, 0x75, 0x6e, 0x64, 0x20, 0x50, 0x6f, 0x49, 0x73, 0x20, 0x69, 0x6e, 0x20, 0x74, 0x68, 0x65,
+	0x20, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x69, 0x74,
+	0x65, 0x6d, 0x73, 0x2c, 0x20, 0x70, 0x61, 0x73, 0x73, 0x20, 0x74, 0x68, 0x65, 0x6d, 0x20, 0x74,
+	0x6f, 0x20, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x64, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x73, 0x20, 0x6c, 0x69, 0x6b, 0x65, 0x20, 0x74, 0x68, 0x65, 0x20, 0x65,
+	0x74, 0x61, 0x67, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x20, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x20,
+	0x50, 0x6f, 0x49, 0x52, 0x05, 0x65, 0x74, 0x61, 0x67, 0x73, 0x22, 0xc7, 0x01, 0x0a, 0x10, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x49, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0xb2, 0x01, 0x0a, 0x03, 0x70, 0x6f, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x70, 0x6f, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x49, 0x42, 0x8e,
+	0x01, 0x92, 0x41, 0x87, 0x01, 0x32, 0x84, 0x01, 0x54, 0x68, 0x65, 0x20, 0x50, 0x6f, 0x49, 0x20,
+	0x74, 0x6f, 0x20, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x2e, 0x20, 0x54, 0x68, 0x65, 0x20, 0x69,
+	0x64, 0x20, 0x69, 0x73, 0x20, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x20, 0x61, 0x6e,
+	0x64, 0x20, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x20, 0x69, 0x66, 0x20, 0x6e,
+	0x6f, 0x74, 0x20, 0x73, 0x65, 0x74, 0x2c, 0x20, 0x74, 0x68, 0x65, 0x20, 0x65, 0x6e, 0x74, 0x72,
+	0x61, 0x6e, 0x63, 0x65, 0x20, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x20, 0x74, 0x6f,
+	0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x2e,
+	0x20, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x20, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x73,
+	0x20, 0x61, 0x72, 0x65, 0x20, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x64, 0xe0, 0x41, 0x02, 0x52,
+	0x03, 0x70, 0x6f, 0x69, 0x22, 0xb2, 0x04, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50,
+	0x6f, 0x49, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x51, 0x0a, 0x03, 0x70, 0x6f, 0x69,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6f, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x49, 0x42, 0x2e, 0x92, 0x41, 0x28, 0x32, 0x26, 0x54, 0x68,
+	0x65, 0x20, 0x50, 0x6f, 0x49, 0x20, 0x74, 0x6f, 0x20, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x20,
+	0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x64, 0x20, 0x62, 0x79, 0x20, 0x69, 0x74,
+	0x73, 0x20, 0x69, 0x64, 0xe0, 0x41, 0x02, 0x52, 0x03, 0x70, 0x6f, 0x69, 0x12, 0xba, 0x02, 0x0a,
+	0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x42, 0xfc,
+	0x01, 0x92, 0x41, 0xf8, 0x01, 0x32, 0xcd, 0x01, 0x54, 0x68, 0x65, 0x20, 0x66, 0x69, 0x65, 0x6c,
+	0x64, 0x73, 0x20, 0x74, 0x6f, 0x20, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x2c, 0x20, 0x6f, 0x6e,
+	0x65, 0x20, 0x6f, 0x66, 0x20, 0x63, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x2c,
+	0x20, 0x65, 0x6e, 0x74, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x2c, 0x20, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x2c, 0x20, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x2e, 0x73, 0x74, 0x72, 0x65,
+	0x65, 0x74, 0x2c, 0x20, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x2e, 0x73, 0x74, 0x72, 0x65,
+	0x65, 0x74, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x2c, 0x20, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x2e, 0x7a, 0x69, 0x70, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x2c, 0x20, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x2e, 0x63, 0x69, 0x74, 0x79, 0x2c, 0x20, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x2e, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x2c, 0x20, 0x66, 0x65, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x73, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x63, 0x68, 0x61, 0x72, 0x67, 0x69, 0x6e,
+	0x67, 0x2e, 0x20, 0x41, 0x6e, 0x20, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x20, 0x6d, 0x61, 0x73, 0x6b,
+	0x20, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x20, 0x61, 0x6c, 0x6c, 0x20, 0x6f, 0x66,
+	0x20, 0x74, 0x68, 0x65, 0x6d, 0x4a, 0x26, 0x22, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x2e,
+	0x73, 0x74, 0x72, 0x65, 0x65, 0x74, 0x2c, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x2e, 0x73,
+	0x74, 0x72, 0x65, 0x65, 0x74, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x52, 0x0a, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x12, 0x8d, 0x01, 0x0a, 0x04, 0x65, 0x74,
+	0x61, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x79, 0x92, 0x41, 0x76, 0x32, 0x74, 0x54,
+	0x68, 0x65, 0x20, 0x65, 0x74, 0x61, 0x67, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x50,
+	0x6f, 0x49, 0x20, 0x74, 0x68, 0x65, 0x20, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x20, 0x69, 0x73,
+	0x20, 0x62, 0x61, 0x73, 0x65, 0x64, 0x20, 0x6f, 0x6e, 0x2e, 0x20, 0x49, 0x66, 0x20, 0x73, 0x65,
+	0x74, 0x2c, 0x20, 0x74, 0x68, 0x65, 0x20, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x20, 0x66, 0x61,
+	0x69, 0x6c, 0x73, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x41, 0x42, 0x4f, 0x52, 0x54, 0x45, 0x44,
+	0x20, 0x69, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x50, 0x6f, 0x49, 0x20, 0x68, 0x61, 0x73, 0x20,
+	0x62, 0x65, 0x65, 0x6e, 0x20, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x20, 0x73, 0x69,
+	0x6e, 0x63, 0x65, 0x52, 0x04, 0x65, 0x74, 0x61, 0x67, 0x22, 0xf1, 0x01, 0x0a, 0x10, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x49, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x5a,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x4a, 0x92, 0x41, 0x47, 0x32,
+	0x1e, 0x54, 0x68, 0x65, 0x20, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x20, 0x69, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x20, 0x50, 0x6f, 0x49, 0x4a,
+	0x1d, 0x22, 0x32, 0x6a, 0x73, 0x58, 0x41, 0x4e, 0x6a, 0x42, 0x71, 0x42, 0x75, 0x39, 0x30, 0x76,
+	0x61, 0x43, 0x37, 0x75, 0x50, 0x6c, 0x34, 0x67, 0x4e, 0x31, 0x79, 0x55, 0x6e, 0x22, 0xa2, 0x02,
+	0x05, 0x6b, 0x73, 0x75, 0x69, 0x64, 0x52, 0x02, 0x69, 0x64, 0x12, 0x80, 0x01, 0x0a, 0x04, 0x65,
+	0x74, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x6c, 0x92, 0x41, 0x69, 0x32, 0x67,
+	0x54, 0x68, 0x65, 0x20, 0x65, 0x74, 0x61, 0x67, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20,
+	0x50, 0x6f, 0x49, 0x20, 0x74, 0x6f, 0x20, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x2e, 0x20, 0x49,
+	0x66, 0x20, 0x73, 0x65, 0x74, 0x2c, 0x20, 0x74, 0x68, 0x65, 0x20, 0x64, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x20, 0x66, 0x61, 0x69, 0x6c, 0x73, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x41, 0x42, 0x4f,
+	0x52, 0x54, 0x45, 0x44, 0x20, 0x69, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x50, 0x6f, 0x49, 0x20,
+	0x68, 0x61, 0x73, 0x20, 0x62, 0x65, 0x65, 0x6e, 0x20, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65,
+	0x64, 0x20, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x52, 0x04, 0x65, 0x74, 0x61, 0x67, 0x22, 0x13, 0x0a,
+	0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x49, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0xee, 0x04, 0x0a, 0x0c, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x46, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x12, 0x5f, 0x0a, 0x08, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x09, 0x42, 0x43, 0x92, 0x41, 0x40, 0x32, 0x2d, 0x54, 0x68, 0x65, 0x20,
+	0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x20, 0x61, 0x20, 0x50, 0x6f, 0x49, 0x20, 0x69,
+	0x73, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x20, 0x74, 0x6f, 0x20, 0x68, 0x61,
+	0x76, 0x65, 0x20, 0x61, 0x6c, 0x6c, 0x20, 0x6f, 0x66, 0x4a, 0x0f, 0x5b, 0x22, 0x44, 0x43, 0x5f,
+	0x43, 0x48, 0x41, 0x52, 0x47, 0x49, 0x4e, 0x47, 0x22, 0x5d, 0x52, 0x08, 0x66, 0x65, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x73, 0x12, 0x60, 0x0a, 0x0c, 0x6d, 0x69, 0x6e, 0x5f, 0x70, 0x6f, 0x77, 0x65,
+	0x72, 0x5f, 0x6b, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x42, 0x3e, 0x92, 0x41, 0x3b, 0x32,
+	0x35, 0x54, 0x68, 0x65, 0x20, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x20, 0x63, 0x68, 0x61,
+	0x72, 0x67, 0x69, 0x6e, 0x67, 0x20, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x20, 0x69, 0x6e, 0x20, 0x6b,
+	0x57, 0x20, 0x61, 0x20, 0x50, 0x6f, 0x49, 0x20, 0x68, 0x61, 0x73, 0x20, 0x74, 0x6f, 0x20, 0x70,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x4a, 0x02, 0x35, 0x30, 0x52, 0x0a, 0x6d, 0x69, 0x6e, 0x50,
+	0x6f, 0x77, 0x65, 0x72, 0x4b, 0x77, 0x12, 0x90, 0x01, 0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0e,
+	0x32, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6f, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x54, 0x79, 0x70, 0x65, 0x42, 0x4c, 0x92, 0x41, 0x49,
+	0x32, 0x30, 0x54, 0x68, 0x65, 0x20, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x73, 0x20, 0x61,
+	0x20, 0x50, 0x6f, 0x49, 0x20, 0x69, 0x73, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64,
+	0x20, 0x74, 0x6f, 0x20, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x20, 0x61, 0x6e, 0x79, 0x20,
+	0x6f, 0x66, 0x4a, 0x15, 0x5b, 0x22, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x4f, 0x52, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x44, 0x43, 0x22, 0x5d, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x93, 0x01, 0x0a, 0x0a, 0x70, 0x6c,
+	0x75, 0x67, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x42, 0x74,
+	0x92, 0x41, 0x71, 0x32, 0x64, 0x54, 0x68, 0x65, 0x20, 0x70, 0x6c, 0x75, 0x67, 0x20, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x20, 0x61, 0x20, 0x50, 0x6f, 0x49, 0x20, 0x69, 0x73, 0x20, 0x72, 0x65, 0x71,
+	0x75, 0x69, 0x72, 0x65, 0x64, 0x20, 0x74, 0x6f, 0x20, 0x68, 0x61, 0x76, 0x65, 0x20, 0x61, 0x6e,
+	0x79, 0x20, 0x6f, 0x66, 0x2c, 0x20, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x20, 0x63, 0x61,
+	0x73, 0x65, 0x2d, 0x69, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x6c, 0x79,
+	0x20, 0x61, 0x73, 0x20, 0x70, 0x61, 0x72, 0x74, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20,
+	0x70, 0x6c, 0x75, 0x67, 0x20, 0x74, 0x79, 0x70, 0x65, 0x4a, 0x09, 0x5b, 0x22, 0x63, 0x6f, 0x6d,
+	0x62, 0x6f, 0x22, 0x5d, 0x52, 0x09, 0x70, 0x6c, 0x75, 0x67, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12,
+	0x72, 0x0a, 0x09, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x09, 0x42, 0x54, 0x92, 0x41, 0x51, 0x32, 0x46, 0x54, 0x68, 0x65, 0x20, 0x49, 0x53, 0x4f,
+	0x20, 0x33, 0x31, 0x36, 0x36, 0x2d, 0x31, 0x20, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2d, 0x33, 0x20,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x20, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x20, 0x61, 0x20,
+	0x50, 0x6f, 0x49, 0x20, 0x69, 0x73, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x20,
+	0x74, 0x6f, 0x20, 0x62, 0x65, 0x20, 0x69, 0x6e, 0x20, 0x61, 0x6e, 0x79, 0x20, 0x6f, 0x66, 0x4a,
+	0x07, 0x5b, 0x22, 0x44, 0x45, 0x55, 0x22, 0x5d, 0x52, 0x09, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72,
+	0x69, 0x65, 0x73, 0x22, 0x84, 0x0a, 0x0a, 0x10, 0x50, 0x72, 0x6f, 0x78, 0x69, 0x6d, 0x69, 0x74,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x33, 0x0a, 0x06, 0x63, 0x65, 0x6e, 0x74,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70,
+	0x6f, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65,
+	0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x06, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x12, 0xdb, 0x01,
+	0x0a, 0x0d, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x5f, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x01, 0x42, 0xb5, 0x01, 0x92, 0x41, 0xb1, 0x01, 0x32, 0x95, 0x01, 0x54,
+	0x68, 0x65, 0x20, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x20, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73,
+	0x20, 0x69, 0x6e, 0x20, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x20, 0x74, 0x6f, 0x20, 0x70, 0x65,
+	0x72, 0x66, 0x6f, 0x72, 0x6d, 0x20, 0x61, 0x20, 0x70, 0x72, 0x78, 0x6f, 0x6d, 0x69, 0x74, 0x79,
+	0x20, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2e, 0x20, 0x54, 0x68, 0x65, 0x20, 0x72, 0x61, 0x64,
+	0x69, 0x75, 0x73, 0x20, 0x69, 0x73, 0x20, 0x6c, 0x69, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64,
+	0x20, 0x64, 0x75, 0x65, 0x20, 0x74, 0x6f, 0x20, 0x70, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61,
+	0x6e, 0x63, 0x65, 0x20, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x73, 0x20,
+	0x61, 0x6e, 0x64, 0x20, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x69, 0x76, 0x6e, 0x65, 0x73,
+	0x73, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x4a, 0x05, 0x35, 0x30, 0x30, 0x30, 0x30, 0x59, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x6a, 0xf8, 0x40, 0x69, 0x00, 0x00, 0x00, 0x00, 0x00, 0x40, 0x8f, 0x40, 0x52, 0x0c, 0x72,
+	0x61, 0x64, 0x69, 0x75, 0x73, 0x4d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0xc9, 0x01, 0x0a, 0x06,
+	0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x42, 0xb0, 0x01, 0x92,
+	0x41, 0xac, 0x01, 0x32, 0xa3, 0x01, 0x49, 0x66, 0x20, 0x74, 0x72, 0x75, 0x65, 0x2c, 0x20, 0x6f,
+	0x6e, 0x6c, 0x79, 0x20, 0x50, 0x6f, 0x49, 0x73, 0x20, 0x77, 0x69, 0x74, 0x68, 0x69, 0x6e, 0x20,
+	0x74, 0x68, 0x65, 0x20, 0x65, 0x78, 0x61, 0x63, 0x74, 0x20, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x20, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x20, 0x61, 0x72, 0x65, 0x20, 0x72, 0x65, 0x74, 0x75,
+	0x72, 0x6e, 0x65, 0x64, 0x2e, 0x20, 0x4f, 0x74, 0x68, 0x65, 0x72, 0x77, 0x69, 0x73, 0x65, 0x20,
+	0x61, 0x6c, 0x6c, 0x20, 0x50, 0x6f, 0x49, 0x73, 0x20, 0x69, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20,
+	0x63, 0x6f, 0x76, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x20, 0x67, 0x65, 0x6f, 0x20, 0x63, 0x65, 0x6c,
+	0x6c, 0x73, 0x20, 0x61, 0x72, 0x65, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x20,
+	0x77, 0x68, 0x69, 0x63, 0x68, 0x20, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x73, 0x20, 0x50,
+	0x6f, 0x49, 0x73, 0x20, 0x6f, 0x75, 0x74, 0x73, 0x69, 0x64, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x74,
+	0x68, 0x65, 0x20, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x4a, 0x04, 0x74, 0x72, 0x75, 0x65, 0x52,
+	0x06, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x12, 0xee, 0x01, 0x0a, 0x07, 0x73, 0x6f, 0x72, 0x74,
+	0x5f, 0x62, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x70, 0x6f, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x42, 0xc0, 0x01,
+	0x92, 0x41, 0xbc, 0x01, 0x32, 0xa5, 0x01, 0x54, 0x68, 0x65, 0x20, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64,
+	0x20, 0x50, 0x6f, 0x49, 0x73, 0x2c, 0x20, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x20,
+	0x74, 0x6f, 0x20, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x20, 0x57, 0x68, 0x65,
+	0x6e, 0x20, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x2c, 0x20, 0x6f, 0x6e,
+	0x6c, 0x79, 0x20, 0x74, 0x68, 0x65, 0x20, 0x50, 0x6f, 0x49, 0x73, 0x20, 0x77, 0x69, 0x74, 0x68,
+	0x69, 0x6e, 0x20, 0x65, 0x61, 0x63, 0x68, 0x20, 0x70, 0x61, 0x67, 0x65, 0x20, 0x61, 0x72, 0x65,
+	0x20, 0x73, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x2c, 0x20, 0x74, 0x68, 0x65, 0x20, 0x70, 0x61, 0x67,
+	0x65, 0x73, 0x20, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x67, 0x65, 0x6f, 0x20, 0x63,
+	0x65, 0x6c, 0x6c, 0x73, 0x20, 0x69, 0x6e, 0x73, 0x74, 0x65, 0x61, 0x64, 0x4a, 0x12, 0x22, 0x53,
+	0x4f, 0x52, 0x54, 0x5f, 0x42, 0x59, 0x5f, 0x44, 0x49, 0x53, 0x54, 0x41, 0x4e, 0x43, 0x45, 0x22,
+	0x52, 0x06, 0x73, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x12, 0xbe, 0x01, 0x0a, 0x09, 0x70, 0x61, 0x67,
+	0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x42, 0xa0, 0x01, 0x92,
+	0x41, 0x9c, 0x01, 0x32, 0x8c, 0x01, 0x54, 0x68, 0x65, 0x20, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75,
+	0x6d, 0x20, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x20, 0x6f, 0x66, 0x20, 0x50, 0x6f, 0x49, 0x73,
+	0x20, 0x70, 0x65, 0x72, 0x20, 0x70, 0x61, 0x67, 0x65, 0x2c, 0x20, 0x7a, 0x65, 0x72, 0x6f, 0x20,
+	0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x20, 0x61, 0x6c, 0x6c, 0x20, 0x50, 0x6f, 0x49, 0x73,
+	0x20, 0x61, 0x74, 0x20, 0x6f, 0x6e, 0x63, 0x65, 0x2e, 0x20, 0x57, 0x68, 0x65, 0x6e, 0x20, 0x70,
+	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x2c, 0x20, 0x73, 0x6f, 0x72, 0x74, 0x5f,
+	0x62, 0x79, 0x20, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x73, 0x20, 0x77, 0x69, 0x74, 0x68, 0x69,
+	0x6e, 0x20, 0x65, 0x61, 0x63, 0x68, 0x20, 0x70, 0x61, 0x67, 0x65, 0x20, 0x6f, 0x6e, 0x6c, 0x79,
+	0x2c, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x61, 0x63, 0x72, 0x6f, 0x73, 0x73, 0x20, 0x70, 0x61, 0x67,
+	0x65, 0x73, 0x4a, 0x02, 0x35, 0x30, 0x59, 0x00, 0x00, 0x00, 0x00, 0x00, 0x40, 0x7f, 0x40, 0x52,
+	0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0xf6, 0x01, 0x0a, 0x0a, 0x70, 0x61,
+	0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0xd6,
+	0x01, 0x92, 0x41, 0xd2, 0x01, 0x32, 0xcf, 0x01, 0x54, 0x68, 0x65, 0x20, 0x6e, 0x65, 0x78, 0x74,
+	0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x20, 0x6f, 0x66, 0x20, 0x74,
+	0x68, 0x65, 0x20, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x20, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x20, 0x74, 0x6f, 0x20, 0x63, 0x6f, 0x6e, 0x74, 0x69, 0x6e, 0x75, 0x65,
+	0x20, 0x74, 0x68, 0x65, 0x20, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x20, 0x77, 0x69, 0x74, 0x68,
+	0x20, 0x74, 0x68, 0x65, 0x20, 0x6e, 0x65, 0x78, 0x74, 0x20, 0x70, 0x61, 0x67, 0x65, 0x2e, 0x20,
+	0x54, 0x68, 0x65, 0x20, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x20, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65,
+	0x74, 0x65, 0x72, 0x73, 0x20, 0x65, 0x78, 0x63, 0x65, 0x70, 0x74, 0x20, 0x70, 0x61, 0x67, 0x65,
+	0x5f, 0x73, 0x69, 0x7a, 0x65, 0x20, 0x6d, 0x75, 0x73, 0x74, 0x20, 0x62, 0x65, 0x20, 0x74, 0x68,
+	0x65, 0x20, 0x73, 0x61, 0x6d, 0x65, 0x20, 0x61, 0x73, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x74, 0x68,
+	0x65, 0x20, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x20, 0x70, 0x61, 0x67, 0x65, 0x2c,
+	0x20, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x77, 0x69, 0x73, 0x65, 0x20, 0x49, 0x4e, 0x56, 0x41, 0x4c,
+	0x49, 0x44, 0x5f, 0x41, 0x52, 0x47, 0x55, 0x4d, 0x45, 0x4e, 0x54, 0x20, 0x69, 0x73, 0x20, 0x72,
+	0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x12, 0x66, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6f, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x42, 0x34, 0x92, 0x41,
+	0x31, 0x32, 0x2f, 0x52, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x73, 0x20, 0x74, 0x68, 0x65,
+	0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x20, 0x50, 0x6f, 0x49, 0x73, 0x20, 0x62,
+	0x79, 0x20, 0x74, 0x68, 0x65, 0x69, 0x72, 0x20, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x65, 0x73, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0x9c, 0x08, 0x0a, 0x0b, 0x42,
+	0x42, 0x6f, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x04, 0x62, 0x62,
+	0x6f, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70,
+	0x6f, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x42, 0x6f, 0x78, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52,
+	0x04, 0x62, 0x62, 0x6f, 0x78, 0x12, 0xce, 0x01, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x42, 0xb5, 0x01, 0x92, 0x41, 0xb1, 0x01, 0x32, 0xa8, 0x01,
+	0x49, 0x66, 0x20, 0x74, 0x72, 0x75, 0x65, 0x2c, 0x20, 0x6f, 0x6e, 0x6c, 0x79, 0x20, 0x50, 0x6f,
+	0x49, 0x73, 0x20, 0x77, 0x69, 0x74, 0x68, 0x69, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x65, 0x78,
+	0x61, 0x63, 0x74, 0x20, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x20, 0x62, 0x6f, 0x78,
+	0x20, 0x61, 0x72, 0x65, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x2e, 0x20, 0x4f,
+	0x74, 0x68, 0x65, 0x72, 0x77, 0x69, 0x73, 0x65, 0x20, 0x61, 0x6c, 0x6c, 0x20, 0x50, 0x6f, 0x49,
+	0x73, 0x20, 0x69, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x69, 0x6e,
+	0x67, 0x20, 0x67, 0x65, 0x6f, 0x20, 0x63, 0x65, 0x6c, 0x6c, 0x73, 0x20, 0x61, 0x72, 0x65, 0x20,
+	0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x20, 0x77, 0x68, 0x69, 0x63, 0x68, 0x20, 0x69,
+	0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x73, 0x20, 0x50, 0x6f, 0x49, 0x73, 0x20, 0x6f, 0x75, 0x74,
+	0x73, 0x69, 0x64, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x62, 0x6f, 0x75, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x20, 0x62, 0x6f, 0x78, 0x4a, 0x04, 0x74, 0x72, 0x75, 0x65, 0x52, 0x06,
+	0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x12, 0xee, 0x01, 0x0a, 0x07, 0x73, 0x6f, 0x72, 0x74, 0x5f,
+	0x62, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70,
+	0x6f, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x42, 0xc0, 0x01, 0x92,
+	0x41, 0xbc, 0x01, 0x32, 0xa5, 0x01, 0x54, 0x68, 0x65, 0x20, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x20,
+	0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x20,
+	0x50, 0x6f, 0x49, 0x73, 0x2c, 0x20, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x20, 0x74,
+	0x6f, 0x20, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x20, 0x57, 0x68, 0x65, 0x6e,
+	0x20, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x2c, 0x20, 0x6f, 0x6e, 0x6c,
+	0x79, 0x20, 0x74, 0x68, 0x65, 0x20, 0x50, 0x6f, 0x49, 0x73, 0x20, 0x77, 0x69, 0x74, 0x68, 0x69,
+	0x6e, 0x20, 0x65, 0x61, 0x63, 0x68, 0x20, 0x70, 0x61, 0x67, 0x65, 0x20, 0x61, 0x72, 0x65, 0x20,
+	0x73, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x2c, 0x20, 0x74, 0x68, 0x65, 0x20, 0x70, 0x61, 0x67, 0x65,
+	0x73, 0x20, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x67, 0x65, 0x6f, 0x20, 0x63, 0x65,
+	0x6c, 0x6c, 0x73, 0x20, 0x69, 0x6e, 0x73, 0x74, 0x65, 0x61, 0x64, 0x4a, 0x12, 0x22, 0x53, 0x4f,
+	0x52, 0x54, 0x5f, 0x42, 0x59, 0x5f, 0x44, 0x49, 0x53, 0x54, 0x41, 0x4e, 0x43, 0x45, 0x22, 0x52,
+	0x06, 0x73, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x12, 0xbe, 0x01, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65,
+	0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x42, 0xa0, 0x01, 0x92, 0x41,
+	0x9c, 0x01, 0x32, 0x8c, 0x01, 0x54, 0x68, 0x65, 0x20, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d,
+	0x20, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x20, 0x6f, 0x66, 0x20, 0x50, 0x6f, 0x49, 0x73, 0x20,
+	0x70, 0x65, 0x72, 0x20, 0x70, 0x61, 0x67, 0x65, 0x2c, 0x20, 0x7a, 0x65, 0x72, 0x6f, 0x20, 0x72,
+	0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x20, 0x61, 0x6c, 0x6c, 0x20, 0x50, 0x6f, 0x49, 0x73, 0x20,
+	0x61, 0x74, 0x20, 0x6f, 0x6e, 0x63, 0x65, 0x2e, 0x20, 0x57, 0x68, 0x65, 0x6e, 0x20, 0x70, 0x61,
+	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x2c, 0x20, 0x73, 0x6f, 0x72, 0x74, 0x5f, 0x62,
+	0x79, 0x20, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x73, 0x20, 0x77, 0x69, 0x74, 0x68, 0x69, 0x6e,
+	0x20, 0x65, 0x61, 0x63, 0x68, 0x20, 0x70, 0x61, 0x67, 0x65, 0x20, 0x6f, 0x6e, 0x6c, 0x79, 0x2c,
+	0x20, 0x6e, 0x6f, 0x74, 0x20, 0x61, 0x63, 0x72, 0x6f, 0x73, 0x73, 0x20, 0x70, 0x61, 0x67, 0x65,
+	0x73, 0x4a, 0x02, 0x35, 0x30, 0x59, 0x00, 0x00, 0x00, 0x00, 0x00, 0x40, 0x7f, 0x40, 0x52, 0x08,
+	0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0xf6, 0x01, 0x0a, 0x0a, 0x70, 0x61, 0x67,
+	0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0xd6, 0x01,
+	0x92, 0x41, 0xd2, 0x01, 0x32, 0xcf, 0x01, 0x54, 0x68, 0x65, 0x20, 0x6e, 0x65, 0x78, 0x74, 0x5f,
+	0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68,
+	0x65, 0x20, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x20, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x20, 0x74, 0x6f, 0x20, 0x63, 0x6f, 0x6e, 0x74, 0x69, 0x6e, 0x75, 0x65, 0x20,
+	0x74, 0x68, 0x65, 0x20, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20,
+	0x74, 0x68, 0x65, 0x20, 0x6e, 0x65, 0x78, 0x74, 0x20, 0x70, 0x61, 0x67, 0x65, 0x2e, 0x20, 0x54,
+	0x68, 0x65, 0x20, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x20, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74,
+	0x65, 0x72, 0x73, 0x20, 0x65, 0x78, 0x63, 0x65, 0x70, 0x74, 0x20, 0x70, 0x61, 0x67, 0x65, 0x5f,
+	0x73, 0x69, 0x7a, 0x65, 0x20, 0x6d, 0x75, 0x73, 0x74, 0x20, 0x62, 0x65, 0x20, 0x74, 0x68, 0x65,
+	0x20, 0x73, 0x61, 0x6d, 0x65, 0x20, 0x61, 0x73, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x74, 0x68, 0x65,
+	0x20, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x20, 0x70, 0x61, 0x67, 0x65, 0x2c, 0x20,
+	0x6f, 0x74, 0x68, 0x65, 0x72, 0x77, 0x69, 0x73, 0x65, 0x20, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49,
+	0x44, 0x5f, 0x41, 0x52, 0x47, 0x55, 0x4d, 0x45, 0x4e, 0x54, 0x20, 0x69, 0x73, 0x20, 0x72, 0x65,
+	0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x12, 0x66, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6f, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x42, 0x34, 0x92, 0x41, 0x31,
+	0x32, 0x2f, 0x52, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20,
+	0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x20, 0x50, 0x6f, 0x49, 0x73, 0x20, 0x62, 0x79,
+	0x20, 0x74, 0x68, 0x65, 0x69, 0x72, 0x20, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
+	0x73, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0x9e, 0x09, 0x0a, 0x0c, 0x52, 0x6f,
+	0x75, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x7e, 0x0a, 0x05, 0x72, 0x6f,
+	0x75, 0x74, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x70, 0x6f, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74,
+	0x65, 0x42, 0x50, 0x92, 0x41, 0x4d, 0x32, 0x46, 0x54, 0x68, 0x65, 0x20, 0x63, 0x6f, 0x6f, 0x72,
+	0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x20, 0x70, 0x61, 0x74, 0x68, 0x20, 0x74, 0x6f, 0x20, 0x73,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x63, 0x68, 0x61, 0x72, 0x67, 0x69,
+	0x6e, 0x67, 0x20, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x20, 0x69, 0x6e, 0x20, 0x63,
+	0x6c, 0x6f, 0x73, 0x65, 0x20, 0x70, 0x72, 0x6f, 0x78, 0x69, 0x6d, 0x69, 0x74, 0x79, 0x78, 0x64,
+	0x80, 0x01, 0x02, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x88, 0x02, 0x0a, 0x07, 0x73,
+	0x6f, 0x72, 0x74, 0x5f, 0x62, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x70, 0x6f, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f, 0x72, 0x74, 0x42, 0x79,
+	0x42, 0xda, 0x01, 0x92, 0x41, 0xd6, 0x01, 0x32, 0xb9, 0x01, 0x54, 0x68, 0x65, 0x20, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72,
+	0x6e, 0x65, 0x64, 0x20, 0x50, 0x6f, 0x49, 0x73, 0x2c, 0x20, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c,
+	0x74, 0x73, 0x20, 0x74, 0x6f, 0x20, 0x74, 0x68, 0x65, 0x20, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x20, 0x61, 0x6c, 0x6f, 0x6e, 0x67, 0x20, 0x74, 0x68, 0x65, 0x20, 0x72, 0x6f, 0x75,
+	0x74, 0x65, 0x2e, 0x20, 0x57, 0x68, 0x65, 0x6e, 0x20, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6e, 0x67, 0x2c, 0x20, 0x6f, 0x6e, 0x6c, 0x79, 0x20, 0x74, 0x68, 0x65, 0x20, 0x50, 0x6f,
+	0x49, 0x73, 0x20, 0x77, 0x69, 0x74, 0x68, 0x69, 0x6e, 0x20, 0x65, 0x61, 0x63, 0x68, 0x20, 0x70,
+	0x61, 0x67, 0x65, 0x20, 0x61, 0x72, 0x65, 0x20, 0x73, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x2c, 0x20,
+	0x74, 0x68, 0x65, 0x20, 0x70, 0x61, 0x67, 0x65, 0x73, 0x20, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77,
+	0x20, 0x74, 0x68, 0x65, 0x20, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68,
+	0x65, 0x20, 0x67, 0x65, 0x6f, 0x20, 0x63, 0x65, 0x6c, 0x6c, 0x73, 0x20, 0x69, 0x6e, 0x73, 0x74,
+	0x65, 0x61, 0x64, 0x4a, 0x18, 0x22, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x42, 0x59, 0x5f, 0x52, 0x4f,
+	0x55, 0x54, 0x45, 0x5f, 0x50, 0x4f, 0x53, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x22, 0x52, 0x06, 0x73,
+	0x6f, 0x72, 0x74, 0x42, 0x79, 0x12, 0xbe, 0x01, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73,
+	0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0xa0, 0x01, 0x92, 0x41, 0x9c, 0x01,
+	0x32, 0x8c, 0x01, 0x54, 0x68, 0x65, 0x20, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x20, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x20, 0x6f, 0x66, 0x20, 0x50, 0x6f, 0x49, 0x73, 0x20, 0x70, 0x65,
+	0x72, 0x20, 0x70, 0x61, 0x67, 0x65, 0x2c, 0x20, 0x7a, 0x65, 0x72, 0x6f, 0x20, 0x72, 0x65, 0x74,
+	0x75, 0x72, 0x6e, 0x73, 0x20, 0x61, 0x6c, 0x6c, 0x20, 0x50, 0x6f, 0x49, 0x73, 0x20, 0x61, 0x74,
+	0x20, 0x6f, 0x6e, 0x63, 0x65, 0x2e, 0x20, 0x57, 0x68, 0x65, 0x6e, 0x20, 0x70, 0x61, 0x67, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x2c, 0x20, 0x73, 0x6f, 0x72, 0x74, 0x5f, 0x62, 0x79, 0x20,
+	0x61, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x73, 0x20, 0x77, 0x69, 0x74, 0x68, 0x69, 0x6e, 0x20, 0x65,
+	0x61, 0x63, 0x68, 0x20, 0x70, 0x61, 0x67, 0x65, 0x20, 0x6f, 0x6e, 0x6c, 0x79, 0x2c, 0x20, 0x6e,
+	0x6f, 0x74, 0x20, 0x61, 0x63, 0x72, 0x6f, 0x73, 0x73, 0x20, 0x70, 0x61, 0x67, 0x65, 0x73, 0x4a,
+	0x02, 0x35, 0x30, 0x59, 0x00, 0x00, 0x00, 0x00, 0x00, 0x40, 0x7f, 0x40, 0x52, 0x08, 0x70, 0x61,
+	0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0xf6, 0x01, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0xd6, 0x01, 0x92, 0x41,
+	0xd2, 0x01, 0x32, 0xcf, 0x01, 0x54, 0x68, 0x65, 0x20, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61,
+	0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20,
+	0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x20, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x20, 0x74, 0x6f, 0x20, 0x63, 0x6f, 0x6e, 0x74, 0x69, 0x6e, 0x75, 0x65, 0x20, 0x74, 0x68,
+	0x65, 0x20, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x74, 0x68,
+	0x65, 0x20, 0x6e, 0x65, 0x78, 0x74, 0x20, 0x70, 0x61, 0x67, 0x65, 0x2e, 0x20, 0x54, 0x68, 0x65,
+	0x20, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x20, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72,
+	0x73, 0x20, 0x65, 0x78, 0x63, 0x65, 0x70, 0x74, 0x20, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69,
+	0x7a, 0x65, 0x20, 0x6d, 0x75, 0x73, 0x74, 0x20, 0x62, 0x65, 0x20, 0x74, 0x68, 0x65, 0x20, 0x73,
+	0x61, 0x6d, 0x65, 0x20, 0x61, 0x73, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x74, 0x68, 0x65, 0x20, 0x70,
+	0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x20, 0x70, 0x61, 0x67, 0x65, 0x2c, 0x20, 0x6f, 0x74,
+	0x68, 0x65, 0x72, 0x77, 0x69, 0x73, 0x65, 0x20, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f,
+	0x41, 0x52, 0x47, 0x55, 0x4d, 0x45, 0x4e, 0x54, 0x20, 0x69, 0x73, 0x20, 0x72, 0x65, 0x74, 0x75,
+	0x72, 0x6e, 0x65, 0x64, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0xe0, 0x01, 0x0a, 0x0f, 0x63, 0x6f, 0x72, 0x72, 0x69, 0x64, 0x6f, 0x72, 0x5f, 0x6d, 0x65, 0x74,
+	0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x42, 0xb6, 0x01, 0x92, 0x41, 0xb2, 0x01,
+	0x32, 0xa0, 0x01, 0x54, 0x68, 0x65, 0x20, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x20, 0x64,
+	0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x72,
+	0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x20, 0x50, 0x6f, 0x49, 0x73, 0x20, 0x74, 0x6f, 0x20,
+	0x74, 0x68, 0x65, 0x20, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x20, 0x69, 0x6e, 0x20, 0x6d, 0x65, 0x74,
+	0x65, 0x72, 0x73, 0x2c, 0x20, 0x62, 0x65, 0x74, 0x77, 0x65, 0x65, 0x6e, 0x20, 0x31, 0x30, 0x30,
+	0x20, 0x6d, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x35, 0x30, 0x30, 0x30, 0x30, 0x20, 0x6d, 0x20, 0x28,
+	0x35, 0x30, 0x20, 0x6b, 0x6d, 0x29, 0x2e, 0x20, 0x5a, 0x65, 0x72, 0x6f, 0x20, 0x72, 0x65, 0x74,
+	0x75, 0x72, 0x6e, 0x73, 0x20, 0x61, 0x6c, 0x6c, 0x20, 0x50, 0x6f, 0x49, 0x73, 0x20, 0x69, 0x6e,
+	0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x65, 0x6c, 0x6c, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x72,
+	0x6f, 0x75, 0x74, 0x65, 0x20, 0x70, 0x61, 0x73, 0x73, 0x65, 0x73, 0x20, 0x74, 0x68, 0x72, 0x6f,
+	0x75, 0x67, 0x68, 0x4a, 0x04, 0x32, 0x30, 0x30, 0x30, 0x59, 0x00, 0x00, 0x00, 0x00, 0x00, 0x6a,
+	0xe8, 0x40, 0x52, 0x0e, 0x63, 0x6f, 0x72, 0x72, 0x69, 0x64, 0x6f, 0x72, 0x4d, 0x65, 0x74, 0x65,
+	0x72, 0x73, 0x12, 0x66, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6f, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x42, 0x34, 0x92, 0x41,
+	0x31, 0x32, 0x2f, 0x52, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x73, 0x20, 0x74, 0x68, 0x65,
+	0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x20, 0x50, 0x6f, 0x49, 0x73, 0x20, 0x62,
+	0x79, 0x20, 0x74, 0x68, 0x65, 0x69, 0x72, 0x20, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x65, 0x73, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0xd5, 0x01, 0x0a, 0x04, 0x52,
+	0x69, 0x6e, 0x67, 0x12, 0xcc, 0x01, 0x0a, 0x0b, 0x63, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61,
+	0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x70, 0x6f, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74,
+	0x65, 0x42, 0x91, 0x01, 0x92, 0x41, 0x8d, 0x01, 0x32, 0x84, 0x01, 0x54, 0x68, 0x65, 0x20, 0x63,
+	0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x20,
+	0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x20, 0x72, 0x69, 0x6e, 0x67, 0x2c, 0x20, 0x72, 0x65, 0x70,
+	0x65, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x20, 0x74, 0x68, 0x65, 0x20, 0x66, 0x69, 0x72, 0x73, 0x74,
+	0x20, 0x63, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x20, 0x61, 0x74, 0x20, 0x74,
+	0x68, 0x65, 0x20, 0x65, 0x6e, 0x64, 0x20, 0x69, 0x73, 0x20, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x61, 0x6c, 0x2e, 0x20, 0x54, 0x68, 0x65, 0x20, 0x6f, 0x72, 0x69, 0x65, 0x6e, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x72, 0x69, 0x6e, 0x67, 0x20,
+	0x64, 0x6f, 0x65, 0x73, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x6d, 0x61, 0x74, 0x74, 0x65, 0x72, 0x78,
+	0xe8, 0x07, 0x80, 0x01, 0x03, 0x52, 0x0b, 0x63, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74,
+	0x65, 0x73, 0x22, 0xed, 0x07, 0x0a, 0x0e, 0x50, 0x6f, 0x6c, 0x79, 0x67, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0xc7, 0x01, 0x0a, 0x05, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6f, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x69, 0x6e, 0x67, 0x42, 0x9e, 0x01, 0x92, 0x41, 0x9a, 0x01, 0x32, 0x92,
+	0x01, 0x54, 0x68, 0x65, 0x20, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68,
+	0x65, 0x20, 0x70, 0x6f, 0x6c, 0x79, 0x67, 0x6f, 0x6e, 0x20, 0x74, 0x6f, 0x20, 0x73, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x50, 0x6f, 0x49, 0x73, 0x20, 0x69, 0x6e, 0x73,
+	0x69, 0x64, 0x65, 0x2e, 0x20, 0x54, 0x68, 0x65, 0x20, 0x66, 0x69, 0x72, 0x73, 0x74, 0x20, 0x72,
+	0x69, 0x6e, 0x67, 0x20, 0x69, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6f, 0x75, 0x74, 0x65, 0x72,
+	0x20, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x79, 0x2c, 0x20, 0x61, 0x6c, 0x6c, 0x20, 0x66,
+	0x75, 0x72, 0x74, 0x68, 0x65, 0x72, 0x20, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x20, 0x61, 0x72, 0x65,
+	0x20, 0x68, 0x6f, 0x6c, 0x65, 0x73, 0x20, 0x69, 0x6e, 0x73, 0x69, 0x64, 0x65, 0x20, 0x6f, 0x66,
+	0x20, 0x74, 0x68, 0x65, 0x20, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x20, 0x62, 0x6f, 0x75, 0x6e, 0x64,
+	0x61, 0x72, 0x79, 0x78, 0x0a, 0x80, 0x01, 0x01, 0x52, 0x05, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x12,
+	0xee, 0x01, 0x0a, 0x07, 0x73, 0x6f, 0x72, 0x74, 0x5f, 0x62, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x12, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6f, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x6f, 0x72, 0x74, 0x42, 0x79, 0x42, 0xc0, 0x01, 0x92, 0x41, 0xbc, 0x01, 0x32, 0xa5, 0x01, 0x54,
+	0x68, 0x65, 0x20, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20,
+	0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x20, 0x50, 0x6f, 0x49, 0x73, 0x2c, 0x20, 0x64,
+	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x20, 0x74, 0x6f, 0x20, 0x64, 0x69, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x2e, 0x20, 0x57, 0x68, 0x65, 0x6e, 0x20, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6e, 0x67, 0x2c, 0x20, 0x6f, 0x6e, 0x6c, 0x79, 0x20, 0x74, 0x68, 0x65, 0x20, 0x50,
+	0x6f, 0x49, 0x73, 0x20, 0x77, 0x69, 0x74, 0x68, 0x69, 0x6e, 0x20, 0x65, 0x61, 0x63, 0x68, 0x20,
+	0x70, 0x61, 0x67, 0x65, 0x20, 0x61, 0x72, 0x65, 0x20, 0x73, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x2c,
+	0x20, 0x74, 0x68, 0x65, 0x20, 0x70, 0x61, 0x67, 0x65, 0x73, 0x20, 0x66, 0x6f, 0x6c, 0x6c, 0x6f,
+	0x77, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x20, 0x6f, 0x66, 0x20, 0x74,
+	0x68, 0x65, 0x20, 0x67, 0x65, 0x6f, 0x20, 0x63, 0x65, 0x6c, 0x6c, 0x73, 0x20, 0x69, 0x6e, 0x73,
+	0x74, 0x65, 0x61, 0x64, 0x4a, 0x12, 0x22, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x42, 0x59, 0x5f, 0x44,
+	0x49, 0x53, 0x54, 0x41, 0x4e, 0x43, 0x45, 0x22, 0x52, 0x06, 0x73, 0x6f, 0x72, 0x74, 0x42, 0x79,
+	0x12, 0xbe, 0x01, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x42, 0xa0, 0x01, 0x92, 0x41, 0x9c, 0x01, 0x32, 0x8c, 0x01, 0x54, 0x68,
+	0x65, 0x20, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x20, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x20, 0x6f, 0x66, 0x20, 0x50, 0x6f, 0x49, 0x73, 0x20, 0x70, 0x65, 0x72, 0x20, 0x70, 0x61, 0x67,
+	0x65, 0x2c, 0x20, 0x7a, 0x65, 0x72, 0x6f, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x20,
+	0x61, 0x6c, 0x6c, 0x20, 0x50, 0x6f, 0x49, 0x73, 0x20, 0x61, 0x74, 0x20, 0x6f, 0x6e, 0x63, 0x65,
+	0x2e, 0x20, 0x57, 0x68, 0x65, 0x6e, 0x20, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6e,
+	0x67, 0x2c, 0x20, 0x73, 0x6f, 0x72, 0x74, 0x5f, 0x62, 0x79, 0x20, 0x61, 0x70, 0x70, 0x6c, 0x69,
+	0x65, 0x73, 0x20, 0x77, 0x69, 0x74, 0x68, 0x69, 0x6e, 0x20, 0x65, 0x61, 0x63, 0x68, 0x20, 0x70,
+	0x61, 0x67, 0x65, 0x20, 0x6f, 0x6e, 0x6c, 0x79, 0x2c, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x61, 0x63,
+	0x72, 0x6f, 0x73, 0x73, 0x20, 0x70, 0x61, 0x67, 0x65, 0x73, 0x4a, 0x02, 0x35, 0x30, 0x59, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x40, 0x7f, 0x40, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a,
+	0x65, 0x12, 0xf6, 0x01, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0xd6, 0x01, 0x92, 0x41, 0xd2, 0x01, 0x32, 0xcf, 0x01,
+	0x54, 0x68, 0x65, 0x20, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x70, 0x72, 0x65, 0x76, 0x69,
+	0x6f, 0x75, 0x73, 0x20, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x20, 0x74, 0x6f, 0x20,
+	0x63, 0x6f, 0x6e, 0x74, 0x69, 0x6e, 0x75, 0x65, 0x20, 0x74, 0x68, 0x65, 0x20, 0x73, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6e, 0x65, 0x78,
+	0x74, 0x20, 0x70, 0x61, 0x67, 0x65, 0x2e, 0x20, 0x54, 0x68, 0x65, 0x20, 0x6f, 0x74, 0x68, 0x65,
+	0x72, 0x20, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x20, 0x65, 0x78, 0x63,
+	0x65, 0x70, 0x74, 0x20, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x20, 0x6d, 0x75,
+	0x73, 0x74, 0x20, 0x62, 0x65, 0x20, 0x74, 0x68, 0x65, 0x20, 0x73, 0x61, 0x6d, 0x65, 0x20, 0x61,
+	0x73, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x74, 0x68, 0x65, 0x20, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f,
+	0x75, 0x73, 0x20, 0x70, 0x61, 0x67, 0x65, 0x2c, 0x20, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x77, 0x69,
+	0x73, 0x65, 0x20, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x41, 0x52, 0x47, 0x55, 0x4d,
+	0x45, 0x4e, 0x54, 0x20, 0x69, 0x73, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x52,
+	0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x66, 0x0a, 0x06, 0x66, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x70, 0x6f, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x46, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x42, 0x34, 0x92, 0x41, 0x31, 0x32, 0x2f, 0x52, 0x65, 0x73, 0x74, 0x72,
+	0x69, 0x63, 0x74, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65,
+	0x64, 0x20, 0x50, 0x6f, 0x49, 0x73, 0x20, 0x62, 0x79, 0x20, 0x74, 0x68, 0x65, 0x69, 0x72, 0x20,
+	0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x22, 0xe2, 0x04, 0x0a, 0x0e, 0x4e, 0x65, 0x61, 0x72, 0x65, 0x73, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x33, 0x0a, 0x06, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6f, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x42, 0x03, 0xe0,
+	0x41, 0x02, 0x52, 0x06, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x12, 0x7c, 0x0a, 0x01, 0x6b, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x6e, 0x92, 0x41, 0x6b, 0x32, 0x54, 0x54, 0x68, 0x65, 0x20,
+	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x20, 0x6f, 0x66, 0x20, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x73,
+	0x74, 0x20, 0x50, 0x6f, 0x49, 0x73, 0x20, 0x74, 0x6f, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e,
+	0x2c, 0x20, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x65, 0x64, 0x20, 0x62, 0x79, 0x20, 0x67, 0x72, 0x65,
+	0x61, 0x74, 0x2d, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x20, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x20, 0x74, 0x6f, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72,
+	0x4a, 0x01, 0x35, 0x59, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x59, 0x40, 0x69, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0xf0, 0x3f, 0x52, 0x01, 0x6b, 0x12, 0xb8, 0x01, 0x0a, 0x11, 0x6d, 0x61, 0x78,
+	0x5f, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x5f, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x01, 0x42, 0x8b, 0x01, 0x92, 0x41, 0x87, 0x01, 0x32, 0x6c, 0x54, 0x68, 0x65,
+	0x20, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x20, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x20,
+	0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x20, 0x69, 0x6e, 0x20, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73,
+	0x2e, 0x20, 0x49, 0x66, 0x20, 0x6c, 0x65, 0x73, 0x73, 0x20, 0x74, 0x68, 0x61, 0x6e, 0x20, 0x6b,
+	0x20, 0x50, 0x6f, 0x49, 0x73, 0x20, 0x61, 0x72, 0x65, 0x20, 0x77, 0x69, 0x74, 0x68, 0x69, 0x6e,
+	0x20, 0x74, 0x68, 0x65, 0x20, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x20, 0x6f, 0x6e, 0x6c, 0x79,
+	0x20, 0x74, 0x68, 0x6f, 0x73, 0x65, 0x20, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x20, 0x61, 0x72, 0x65,
+	0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x4a, 0x05, 0x35, 0x30, 0x30, 0x30, 0x30,
+	0x59, 0x00, 0x00, 0x00, 0x00, 0x00, 0x6a, 0xf8, 0x40, 0x69, 0x00, 0x00, 0x00, 0x00, 0x00, 0x40,
+	0x8f, 0x40, 0x52, 0x0f, 0x6d, 0x61, 0x78, 0x52, 0x61, 0x64, 0x69, 0x75, 0x73, 0x4d, 0x65, 0x74,
+	0x65, 0x72, 0x73, 0x12, 0x7a, 0x0a, 0x07, 0x73, 0x6f, 0x72, 0x74, 0x5f, 0x62, 0x79, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6f, 0x69, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x42, 0x4d, 0x92, 0x41, 0x4a, 0x32, 0x34, 0x54,
+	0x68, 0x65, 0x20, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20,
+	0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x20, 0x50, 0x6f, 0x49, 0x73, 0x2c, 0x20, 0x64,
+	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x20, 0x74, 0x6f, 0x20, 0x64, 0x69, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x4a, 0x12, 0x22, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x42, 0x59, 0x5f, 0x44, 0x49,
+	0x53, 0x54, 0x41, 0x4e, 0x43, 0x45, 0x22, 0x52, 0x06, 0x73, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x12,
+	0x66, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6f, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x42, 0x34, 0x92, 0x41, 0x31, 0x32, 0x2f,
+	0x52, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x72, 0x65,
+	0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x20, 0x50, 0x6f, 0x49, 0x73, 0x20, 0x62, 0x79, 0x20, 0x74,
+	0x68, 0x65, 0x69, 0x72, 0x20, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x52,
+	0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0xc7, 0x09, 0x0a, 0x18, 0x50, 0x6c, 0x61, 0x6e,
+	0x43, 0x68, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x6f, 0x70, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x76, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6f, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x42, 0x48, 0x92, 0x41, 0x45,
+	0x32, 0x3e, 0x54, 0x68, 0x65, 0x20, 0x63, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65,
+	0x20, 0x70, 0x61, 0x74, 0x68, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x74, 0x72, 0x69,
+	0x70, 0x20, 0x74, 0x6f, 0x20, 0x70, 0x6c, 0x61, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x68,
+	0x61, 0x72, 0x67, 0x69, 0x6e, 0x67, 0x20, 0x73, 0x74, 0x6f, 0x70, 0x73, 0x20, 0x66, 0x6f, 0x72,
+	0x78, 0x64, 0x80, 0x01, 0x02, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x90, 0x01, 0x0a,
+	0x17, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x6f, 0x66, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65,
+	0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x42, 0x59,
+	0x92, 0x41, 0x56, 0x32, 0x47, 0x54, 0x68, 0x65, 0x20, 0x73, 0x74, 0x61, 0x74, 0x65, 0x20, 0x6f,
+	0x66, 0x20, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20,
+	0x76, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x20, 0x61, 0x74, 0x20, 0x74, 0x68, 0x65, 0x20, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x72, 0x6f, 0x75, 0x74,
+	0x65, 0x20, 0x69, 0x6e, 0x20, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x4a, 0x02, 0x36, 0x35,
+	0x59, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x59, 0x40, 0x52, 0x14, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x4f, 0x66, 0x43, 0x68, 0x61, 0x72, 0x67, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12,
+	0x98, 0x01, 0x0a, 0x13, 0x75, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65,
+	0x5f, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x42, 0x68, 0x92,
+	0x41, 0x65, 0x32, 0x49, 0x54, 0x68, 0x65, 0x20, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x20, 0x69, 0x6e, 0x20, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x76,
+	0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x20, 0x63, 0x61, 0x6e, 0x20, 0x64, 0x72, 0x69, 0x76, 0x65,
+	0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x61, 0x20, 0x66, 0x75, 0x6c, 0x6c, 0x79, 0x20, 0x63, 0x68,
+	0x61, 0x72, 0x67, 0x65, 0x64, 0x20, 0x62, 0x61, 0x74, 0x74, 0x65, 0x72, 0x79, 0x4a, 0x06, 0x33,
+	0x35, 0x30, 0x30, 0x30, 0x30, 0x59, 0x00, 0x00, 0x00, 0x00, 0x80, 0x84, 0x3e, 0x41, 0x69, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x88, 0xc3, 0x40, 0x52, 0x11, 0x75, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x52,
+	0x61, 0x6e, 0x67, 0x65, 0x4d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x7b, 0x0a, 0x0f, 0x72, 0x65,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x01, 0x42, 0x52, 0x92, 0x41, 0x4f, 0x32, 0x40, 0x54, 0x68, 0x65, 0x20, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x20, 0x69, 0x6e,
+	0x20, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x20, 0x74, 0x68, 0x65, 0x20, 0x76, 0x65, 0x68,
+	0x69, 0x63, 0x6c, 0x65, 0x20, 0x6d, 0x75, 0x73, 0x74, 0x20, 0x6e, 0x65, 0x76, 0x65, 0x72, 0x20,
+	0x66, 0x61, 0x6c, 0x6c, 0x20, 0x62, 0x65, 0x6c, 0x6f, 0x77, 0x4a, 0x02, 0x31, 0x30, 0x59, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x49, 0x40, 0x52, 0x0e, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0xba, 0x01, 0x0a, 0x0e, 0x74, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01,
+	0x42, 0x92, 0x01, 0x92, 0x41, 0x8e, 0x01, 0x32, 0x7f, 0x54, 0x68, 0x65, 0x20, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x20, 0x69, 0x6e, 0x20,
+	0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x20, 0x74, 0x68, 0x65, 0x20, 0x76, 0x65, 0x68, 0x69,
+	0x63, 0x6c, 0x65, 0x20, 0x69, 0x73, 0x20, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x64, 0x20, 0x74,
+	0x6f, 0x20, 0x61, 0x74, 0x20, 0x65, 0x61, 0x63, 0x68, 0x20, 0x73, 0x74, 0x6f, 0x70, 0x2c, 0x20,
+	0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x20, 0x74, 0x6f, 0x20, 0x38, 0x30, 0x20, 0x70,
+	0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x2e, 0x20, 0x4d, 0x75, 0x73, 0x74, 0x20, 0x62, 0x65, 0x20,
+	0x67, 0x72, 0x65, 0x61, 0x74, 0x65, 0x72, 0x20, 0x74, 0x68, 0x61, 0x6e, 0x20, 0x74, 0x68, 0x65,
+	0x20, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x4a, 0x02, 0x38, 0x30, 0x59, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x59, 0x40, 0x52, 0x0d, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x50, 0x65, 0x72,
+	0x63, 0x65, 0x6e, 0x74, 0x12, 0x7f, 0x0a, 0x0c, 0x6d, 0x69, 0x6e, 0x5f, 0x70, 0x6f, 0x77, 0x65,
+	0x72, 0x5f, 0x6b, 0x77, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x42, 0x5d, 0x92, 0x41, 0x5a, 0x32,
+	0x54, 0x54, 0x68, 0x65, 0x20, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x20, 0x63, 0x68, 0x61,
+	0x72, 0x67, 0x69, 0x6e, 0x67, 0x20, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x20, 0x69, 0x6e, 0x20, 0x6b,
+	0x57, 0x20, 0x61, 0x20, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x68, 0x61, 0x73, 0x20,
+	0x74, 0x6f, 0x20, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x2c, 0x20, 0x7a, 0x65, 0x72, 0x6f,
+	0x20, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x73, 0x20, 0x61, 0x6c, 0x6c, 0x20, 0x73, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x4a, 0x02, 0x35, 0x30, 0x52, 0x0a, 0x6d, 0x69, 0x6e, 0x50, 0x6f,
+	0x77, 0x65, 0x72, 0x4b, 0x77, 0x12, 0x95, 0x01, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x70, 0x6f, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x54, 0x79, 0x70, 0x65, 0x42, 0x5c, 0x92, 0x41, 0x59, 0x32, 0x42, 0x54, 0x68, 0x65, 0x20, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x20, 0x74, 0x68, 0x65, 0x20, 0x76, 0x65, 0x68, 0x69, 0x63,
+	0x6c, 0x65, 0x20, 0x63, 0x61, 0x6e, 0x20, 0x62, 0x65, 0x20, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65,
+	0x64, 0x20, 0x77, 0x69, 0x74, 0x68, 0x2c, 0x20, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73,
+	0x20, 0x74, 0x6f, 0x20, 0x41, 0x43, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x44, 0x43, 0x4a, 0x13, 0x22,
+	0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x4f, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x44,
+	0x43, 0x22, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0xb1, 0x01,
+	0x0a, 0x0f, 0x63, 0x6f, 0x72, 0x72, 0x69, 0x64, 0x6f, 0x72, 0x5f, 0x6d, 0x65, 0x74, 0x65, 0x72,
+	0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x42, 0x87, 0x01, 0x92, 0x41, 0x83, 0x01, 0x32, 0x72,
+	0x54, 0x68, 0x65, 0x20, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x20, 0x64, 0x69, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x73, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x20, 0x74, 0x6f, 0x20, 0x74, 0x68, 0x65, 0x20, 0x72, 0x6f, 0x75, 0x74,
+	0x65, 0x20, 0x69, 0x6e, 0x20, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x2c, 0x20, 0x62, 0x65, 0x74,
+	0x77, 0x65, 0x65, 0x6e, 0x20, 0x31, 0x30, 0x30, 0x20, 0x6d, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x35,
+	0x30, 0x30, 0x30, 0x30, 0x20, 0x6d, 0x20, 0x28, 0x35, 0x30, 0x20, 0x6b, 0x6d, 0x29, 0x2e, 0x20,
+	0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x20, 0x74, 0x6f, 0x20, 0x35, 0x30, 0x30, 0x30,
+	0x20, 0x6d, 0x4a, 0x04, 0x35, 0x30, 0x30, 0x30, 0x59, 0x00, 0x00, 0x00, 0x00, 0x00, 0x6a, 0xe8,
+	0x40, 0x52, 0x0e, 0x63, 0x6f, 0x72, 0x72, 0x69, 0x64, 0x6f, 0x72, 0x4d, 0x65, 0x74, 0x65, 0x72,
+	0x73, 0x22, 0x9a, 0x04, 0x0a, 0x0c, 0x43, 0x68, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x67, 0x53, 0x74,
+	0x6f, 0x70, 0x12, 0x29, 0x0a, 0x07, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6f, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x6f, 0x49, 0x52, 0x07, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x8c, 0x01,
+	0x0a, 0x1f, 0x61, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f,
+	0x6f, 0x66, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x42, 0x46, 0x92, 0x41, 0x43, 0x32, 0x3b, 0x54, 0x68,
+	0x65, 0x20, 0x73, 0x74, 0x61, 0x74, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x63, 0x68, 0x61, 0x72, 0x67,
+	0x65, 0x20, 0x69, 0x6e, 0x20, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x20, 0x77, 0x68, 0x65,
+	0x6e, 0x20, 0x61, 0x72, 0x72, 0x69, 0x76, 0x69, 0x6e, 0x67, 0x20, 0x61, 0x74, 0x20, 0x74, 0x68,
+	0x65, 0x20, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4a, 0x04, 0x31, 0x34, 0x2e, 0x35, 0x52,
+	0x1b, 0x61, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x4f, 0x66, 0x43,
+	0x68, 0x61, 0x72, 0x67, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x8a, 0x01, 0x0a,
+	0x21, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x5f, 0x6f, 0x66, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65,
+	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x42, 0x40, 0x92, 0x41, 0x3d, 0x32, 0x37, 0x54,
+	0x68, 0x65, 0x20, 0x73, 0x74, 0x61, 0x74, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x63, 0x68, 0x61, 0x72,
+	0x67, 0x65, 0x20, 0x69, 0x6e, 0x20, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x20, 0x77, 0x68,
+	0x65, 0x6e, 0x20, 0x6c, 0x65, 0x61, 0x76, 0x69, 0x6e, 0x67, 0x20, 0x74, 0x68, 0x65, 0x20, 0x73,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4a, 0x02, 0x38, 0x30, 0x52, 0x1d, 0x64, 0x65, 0x70, 0x61,
+	0x72, 0x74, 0x75, 0x72, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x4f, 0x66, 0x43, 0x68, 0x61, 0x72,
+	0x67, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0xc2, 0x01, 0x0a, 0x0a, 0x61, 0x6c,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6f, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x49, 0x42,
+	0x90, 0x01, 0x92, 0x41, 0x8c, 0x01, 0x32, 0x89, 0x01, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x20, 0x72, 0x65, 0x61, 0x63, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x20, 0x61, 0x62, 0x6f, 0x76,
+	0x65, 0x20, 0x74, 0x68, 0x65, 0x20, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x20, 0x69, 0x6e,
+	0x73, 0x74, 0x65, 0x61, 0x64, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x73, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x2c, 0x20, 0x65, 0x2e, 0x67, 0x2e, 0x20, 0x69, 0x66, 0x20, 0x69, 0x74,
+	0x20, 0x69, 0x73, 0x20, 0x6f, 0x63, 0x63, 0x75, 0x70, 0x69, 0x65, 0x64, 0x2c, 0x20, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x65, 0x64, 0x20, 0x62, 0x79, 0x20, 0x74, 0x68, 0x65, 0x69, 0x72, 0x20, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x61, 0x6c, 0x6f, 0x6e, 0x67, 0x20, 0x74, 0x68,
+	0x65, 0x20, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x20, 0x64, 0x65, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x52, 0x0a, 0x61, 0x6c, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x74, 0x65, 0x73, 0x22, 0xca,
+	0x03, 0x0a, 0x19, 0x50, 0x6c, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x67, 0x53,
+	0x74, 0x6f, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x8f, 0x01, 0x0a,
+	0x05, 0x73, 0x74, 0x6f, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x70, 0x6f, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x72, 0x67, 0x69,
+	0x6e, 0x67, 0x53, 0x74, 0x6f, 0x70, 0x42, 0x5f, 0x92, 0x41, 0x5c, 0x32, 0x5a, 0x54, 0x68, 0x65,
+	0x20, 0x63, 0x68, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x67, 0x20, 0x73, 0x74, 0x6f, 0x70, 0x73, 0x20,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x65, 0x64, 0x20, 0x62, 0x79, 0x20, 0x74, 0x68, 0x65, 0x69, 0x72,
+	0x20, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x61, 0x6c, 0x6f, 0x6e, 0x67, 0x20,
+	0x74, 0x68, 0x65, 0x20, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2c, 0x20, 0x65, 0x6d, 0x70, 0x74, 0x79,
+	0x20, 0x69, 0x66, 0x20, 0x6e, 0x6f, 0x20, 0x73, 0x74, 0x6f, 0x70, 0x20, 0x69, 0x73, 0x20, 0x72,
+	0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x52, 0x05, 0x73, 0x74, 0x6f, 0x70, 0x73, 0x12, 0x95,
+	0x01, 0x0a, 0x1f, 0x61, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x5f, 0x6f, 0x66, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x42, 0x4f, 0x92, 0x41, 0x4c, 0x32, 0x44, 0x54,
+	0x68, 0x65, 0x20, 0x73, 0x74, 0x61, 0x74, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x63, 0x68, 0x61, 0x72,
+	0x67, 0x65, 0x20, 0x69, 0x6e, 0x20, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x20, 0x77, 0x68,
+	0x65, 0x6e, 0x20, 0x61, 0x72, 0x72, 0x69, 0x76, 0x69, 0x6e, 0x67, 0x20, 0x61, 0x74, 0x20, 0x74,
+	0x68, 0x65, 0x20, 0x65, 0x6e, 0x64, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x72, 0x6f,
+	0x75, 0x74, 0x65, 0x4a, 0x04, 0x32, 0x33, 0x2e, 0x31, 0x52, 0x1b, 0x61, 0x72, 0x72, 0x69, 0x76,
+	0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x4f, 0x66, 0x43, 0x68, 0x61, 0x72, 0x67, 0x65, 0x50,
+	0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x82, 0x01, 0x0a, 0x13, 0x72, 0x6f, 0x75, 0x74, 0x65,
+	0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x5f, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x01, 0x42, 0x52, 0x92, 0x41, 0x4f, 0x32, 0x45, 0x54, 0x68, 0x65, 0x20, 0x6c,
+	0x65, 0x6e, 0x67, 0x74, 0x68, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x72, 0x6f, 0x75,
+	0x74, 0x65, 0x20, 0x69, 0x6e, 0x20, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x20, 0x77, 0x69, 0x74,
+	0x68, 0x6f, 0x75, 0x74, 0x20, 0x74, 0x68, 0x65, 0x20, 0x64, 0x65, 0x74, 0x6f, 0x75, 0x72, 0x73,
+	0x20, 0x74, 0x6f, 0x20, 0x74, 0x68, 0x65, 0x20, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x4a, 0x06, 0x36, 0x31, 0x32, 0x30, 0x30, 0x30, 0x52, 0x11, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x4c,
+	0x65, 0x6e, 0x67, 0x74, 0x68, 0x4d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x22, 0xe8, 0x01, 0x0a, 0x0f,
+	0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x29, 0x0a, 0x04, 0x62, 0x62, 0x6f, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x70, 0x6f, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x42, 0x6f, 0x78, 0x42,
+	0x03, 0xe0, 0x41, 0x02, 0x52, 0x04, 0x62, 0x62, 0x6f, 0x78, 0x12, 0xa9, 0x01, 0x0a, 0x04, 0x7a,
+	0x6f, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x94, 0x01, 0x92, 0x41, 0x90, 0x01,
+	0x32, 0x81, 0x01, 0x54, 0x68, 0x65, 0x20, 0x7a, 0x6f, 0x6f, 0x6d, 0x20, 0x6c, 0x65, 0x76, 0x65,
+	0x6c, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6d, 0x61, 0x70, 0x2e, 0x20, 0x54, 0x68,
+	0x65, 0x20, 0x50, 0x6f, 0x49, 0x73, 0x20, 0x61, 0x72, 0x65, 0x20, 0x61, 0x67, 0x67, 0x72, 0x65,
+	0x67, 0x61, 0x74, 0x65, 0x64, 0x20, 0x69, 0x6e, 0x74, 0x6f, 0x20, 0x67, 0x65, 0x6f, 0x20, 0x63,
+	0x65, 0x6c, 0x6c, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x62, 0x6f, 0x75, 0x74, 0x20, 0x61, 0x20,
+	0x71, 0x75, 0x61, 0x72, 0x74, 0x65, 0x72, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x77,
+	0x69, 0x64, 0x74, 0x68, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x20, 0x6d, 0x61, 0x70, 0x20, 0x74, 0x69,
+	0x6c, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x7a, 0x6f, 0x6f, 0x6d, 0x20, 0x6c,
+	0x65, 0x76, 0x65, 0x6c, 0x4a, 0x01, 0x38, 0x59, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x36, 0x40,
+	0x52, 0x04, 0x7a, 0x6f, 0x6f, 0x6d, 0x22, 0xcc, 0x04, 0x0a, 0x07, 0x43, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x12, 0xfd, 0x01, 0x0a, 0x08, 0x63, 0x65, 0x6e, 0x74, 0x72, 0x6f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6f, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x42, 0xc8, 0x01,
+	0x92, 0x41, 0xc4, 0x01, 0x32, 0xc1, 0x01, 0x54, 0x68, 0x65, 0x20, 0x6d, 0x65, 0x61, 0x6e, 0x20,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20,
+	0x50, 0x6f, 0x49, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x2e, 0x20, 0x46, 0x6f, 0x72, 0x20, 0x7a, 0x6f, 0x6f, 0x6d, 0x20, 0x6c, 0x65,
+	0x76, 0x65, 0x6c, 0x73, 0x20, 0x75, 0x70, 0x20, 0x74, 0x6f, 0x20, 0x31, 0x30, 0x20, 0x74, 0x68,
+	0x65, 0x20, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x20, 0x61, 0x72, 0x65, 0x20, 0x73,
+	0x75, 0x6d, 0x6d, 0x65, 0x64, 0x20, 0x75, 0x70, 0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20, 0x74, 0x68,
+	0x65, 0x20, 0x70, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x64, 0x20, 0x64, 0x65,
+	0x6e, 0x73, 0x69, 0x74, 0x79, 0x20, 0x6f, 0x66, 0x20, 0x67, 0x65, 0x6f, 0x20, 0x63, 0x65, 0x6c,
+	0x6c, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x62, 0x6f, 0x75, 0x74, 0x20, 0x38, 0x20, 0x6b, 0x6d,
+	0x2c, 0x20, 0x68, 0x65, 0x6e, 0x63, 0x65, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x65, 0x6e, 0x74,
+	0x72, 0x6f, 0x69, 0x64, 0x20, 0x69, 0x73, 0x20, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x78, 0x69, 0x6d,
+	0x61, 0x74, 0x65, 0x20, 0x74, 0x68, 0x65, 0x6e, 0x52, 0x08, 0x63, 0x65, 0x6e, 0x74, 0x72, 0x6f,
+	0x69, 0x64, 0x12, 0x40, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x42, 0x2a, 0x92, 0x41, 0x27, 0x32, 0x21, 0x54, 0x68, 0x65, 0x20, 0x6e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x20, 0x6f, 0x66, 0x20, 0x50, 0x6f, 0x49, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68,
+	0x65, 0x20, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4a, 0x02, 0x34, 0x32, 0x52, 0x05, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0xfe, 0x01, 0x0a, 0x08, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x42, 0xe1, 0x01, 0x92, 0x41, 0xdd, 0x01, 0x32, 0xb7,
+	0x01, 0x54, 0x68, 0x65, 0x20, 0x6d, 0x6f, 0x73, 0x74, 0x20, 0x66, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x6e, 0x74, 0x20, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x74,
+	0x68, 0x65, 0x20, 0x50, 0x6f, 0x49, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2c, 0x20, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x65, 0x64, 0x20,
+	0x64, 0x65, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x20, 0x62, 0x79, 0x20, 0x74, 0x68,
+	0x65, 0x69, 0x72, 0x20, 0x66, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x2e, 0x20, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x7a, 0x6f, 0x6f, 0x6d, 0x20, 0x6c, 0x65,
+	0x76, 0x65, 0x6c, 0x73, 0x20, 0x75, 0x70, 0x20, 0x74, 0x6f, 0x20, 0x31, 0x30, 0x2c, 0x20, 0x77,
+	0x68, 0x6f, 0x73, 0x65, 0x20, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x20, 0x61, 0x72,
+	0x65, 0x20, 0x73, 0x75, 0x6d, 0x6d, 0x65, 0x64, 0x20, 0x75, 0x70, 0x20, 0x66, 0x72, 0x6f, 0x6d,
+	0x20, 0x74, 0x68, 0x65, 0x20, 0x70, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x64,
+	0x20, 0x64, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x79, 0x4a, 0x21, 0x5b, 0x22, 0x41, 0x43, 0x5f, 0x43,
+	0x48, 0x41, 0x52, 0x47, 0x49, 0x4e, 0x47, 0x22, 0x2c, 0x20, 0x22, 0x32, 0x32, 0x5f, 0x4b, 0x57,
+	0x5f, 0x43, 0x48, 0x41, 0x52, 0x47, 0x49, 0x4e, 0x47, 0x22, 0x5d, 0x52, 0x08, 0x66, 0x65, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x73, 0x22, 0x97, 0x03, 0x0a, 0x10, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x08, 0x63, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x70, 0x6f, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x52, 0x08, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x12, 0xb2, 0x01, 0x0a, 0x09,
+	0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x42,
+	0x93, 0x01, 0x92, 0x41, 0x8f, 0x01, 0x32, 0x8c, 0x01, 0x54, 0x72, 0x75, 0x65, 0x20, 0x69, 0x66,
+	0x20, 0x74, 0x68, 0x65, 0x20, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x20, 0x62, 0x6f,
+	0x78, 0x20, 0x77, 0x61, 0x73, 0x20, 0x74, 0x6f, 0x6f, 0x20, 0x6c, 0x61, 0x72, 0x67, 0x65, 0x20,
+	0x74, 0x6f, 0x20, 0x62, 0x65, 0x20, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x64, 0x20, 0x63,
+	0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x6c, 0x79, 0x2e, 0x20, 0x4f, 0x6e, 0x6c, 0x79, 0x20,
+	0x74, 0x68, 0x65, 0x20, 0x70, 0x61, 0x72, 0x74, 0x20, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x73, 0x74,
+	0x20, 0x74, 0x6f, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x20, 0x6f,
+	0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x20, 0x62,
+	0x6f, 0x78, 0x20, 0x68, 0x61, 0x73, 0x20, 0x62, 0x65, 0x65, 0x6e, 0x20, 0x63, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x65, 0x64, 0x52, 0x09, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64,
+	0x12, 0x9c, 0x01, 0x0a, 0x10, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x65, 0x64, 0x5f, 0x66, 0x72, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x42, 0x71, 0x92, 0x41, 0x6e,
+	0x32, 0x66, 0x54, 0x68, 0x65, 0x20, 0x73, 0x68, 0x61, 0x72, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x74,
+	0x68, 0x65, 0x20, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x20, 0x62, 0x6f, 0x78, 0x20,
+	0x77, 0x68, 0x69, 0x63, 0x68, 0x20, 0x68, 0x61, 0x73, 0x20, 0x62, 0x65, 0x65, 0x6e, 0x20, 0x73,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x64, 0x20, 0x62, 0x65, 0x74, 0x77, 0x65, 0x65, 0x6e, 0x20,
+	0x30, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x31, 0x2e, 0x20, 0x4f, 0x6e, 0x6c, 0x79, 0x20, 0x73, 0x65,
+	0x74, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x20,
+	0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x73, 0x4a, 0x04, 0x30, 0x2e, 0x37, 0x35, 0x52, 0x0f,
+	0x63, 0x6f, 0x76, 0x65, 0x72, 0x65, 0x64, 0x46, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0xbb, 0x04, 0x0a, 0x0e, 0x44, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x90, 0x01, 0x0a, 0x04, 0x62, 0x62, 0x6f, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x10, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6f, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x42,
+	0x42, 0x6f, 0x78, 0x42, 0x6a, 0x92, 0x41, 0x67, 0x32, 0x65, 0x54, 0x68, 0x65, 0x20, 0x62, 0x6f,
+	0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x20, 0x62, 0x6f, 0x78, 0x20, 0x74, 0x6f, 0x20, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x20, 0x74, 0x68, 0x65, 0x20, 0x50, 0x6f, 0x49, 0x73, 0x20, 0x6f, 0x66, 0x2c,
+	0x20, 0x65, 0x69, 0x74, 0x68, 0x65, 0x72, 0x20, 0x74, 0x68, 0x65, 0x20, 0x62, 0x6f, 0x75, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x20, 0x62, 0x6f, 0x78, 0x20, 0x6f, 0x72, 0x20, 0x74, 0x68, 0x65, 0x20,
+	0x72, 0x69, 0x6e, 0x67, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x20, 0x70, 0x6f, 0x6c, 0x79, 0x67,
+	0x6f, 0x6e, 0x20, 0x61, 0x72, 0x65, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x52,
+	0x04, 0x62, 0x62, 0x6f, 0x78, 0x12, 0xbf, 0x01, 0x0a, 0x05, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6f, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x69, 0x6e, 0x67, 0x42, 0x96, 0x01, 0x92, 0x41, 0x92, 0x01, 0x32, 0x8d,
+	0x01, 0x54, 0x68, 0x65, 0x20, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68,
+	0x65, 0x20, 0x70, 0x6f, 0x6c, 0x79, 0x67, 0x6f, 0x6e, 0x20, 0x74, 0x6f, 0x20, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x20, 0x74, 0x68, 0x65, 0x20, 0x50, 0x6f, 0x49, 0x73, 0x20, 0x6f, 0x66, 0x2e, 0x20,
+	0x54, 0x68, 0x65, 0x20, 0x66, 0x69, 0x72, 0x73, 0x74, 0x20, 0x72, 0x69, 0x6e, 0x67, 0x20, 0x69,
+	0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x20, 0x62, 0x6f, 0x75, 0x6e,
+	0x64, 0x61, 0x72, 0x79, 0x2c, 0x20, 0x61, 0x6c, 0x6c, 0x20, 0x66, 0x75, 0x72, 0x74, 0x68, 0x65,
+	0x72, 0x20, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x20, 0x61, 0x72, 0x65, 0x20, 0x68, 0x6f, 0x6c, 0x65,
+	0x73, 0x20, 0x69, 0x6e, 0x73, 0x69, 0x64, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20,
+	0x6f, 0x75, 0x74, 0x65, 0x72, 0x20, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x79, 0x78, 0x0a,
+	0x52, 0x05, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x12, 0xd3, 0x01, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65,
+	0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0xbc, 0x01, 0x92, 0x41, 0xb8, 0x01, 0x32, 0xa9,
+	0x01, 0x54, 0x68, 0x65, 0x20, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68,
+	0x65, 0x20, 0x53, 0x32, 0x20, 0x63, 0x65, 0x6c, 0x6c, 0x73, 0x20, 0x74, 0x6f, 0x20, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x20, 0x74, 0x68, 0x65, 0x20, 0x50, 0x6f, 0x49, 0x73, 0x20, 0x70, 0x65, 0x72,
+	0x2e, 0x20, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x73, 0x20, 0x75, 0x70, 0x20, 0x74, 0x6f, 0x20, 0x31,
+	0x30, 0x2c, 0x20, 0x61, 0x62, 0x6f, 0x75, 0x74, 0x20, 0x31, 0x30, 0x20, 0x6b, 0x6d, 0x2c, 0x20,
+	0x61, 0x72, 0x65, 0x20, 0x73, 0x75, 0x6d, 0x6d, 0x65, 0x64, 0x20, 0x75, 0x70, 0x20, 0x66, 0x72,
+	0x6f, 0x6d, 0x20, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x73, 0x20, 0x6d, 0x61,
+	0x69, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x64, 0x20, 0x6f, 0x6e, 0x20, 0x77, 0x72, 0x69, 0x74,
+	0x65, 0x73, 0x2c, 0x20, 0x66, 0x69, 0x6e, 0x65, 0x72, 0x20, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x73,
+	0x20, 0x61, 0x72, 0x65, 0x20, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x64, 0x20, 0x66, 0x72, 0x6f,
+	0x6d, 0x20, 0x74, 0x68, 0x65, 0x20, 0x50, 0x6f, 0x49, 0x73, 0x4a, 0x01, 0x38, 0x59, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x3e, 0x40, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x22, 0xad, 0x03,
+	0x0a, 0x0b, 0x44, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x79, 0x43, 0x65, 0x6c, 0x6c, 0x12, 0x3b, 0x0a,
+	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x25, 0x92, 0x41,
+	0x22, 0x32, 0x18, 0x54, 0x68, 0x65, 0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x20, 0x6f, 0x66, 0x20,
+	0x74, 0x68, 0x65, 0x20, 0x53, 0x32, 0x20, 0x63, 0x65, 0x6c, 0x6c, 0x4a, 0x06, 0x22, 0x34, 0x37,
+	0x39, 0x39, 0x22, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x4b, 0x0a, 0x06, 0x63, 0x65,
+	0x6e, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x70, 0x6f, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61,
+	0x74, 0x65, 0x42, 0x1b, 0x92, 0x41, 0x18, 0x32, 0x16, 0x54, 0x68, 0x65, 0x20, 0x63, 0x65, 0x6e,
+	0x74, 0x65, 0x72, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x65, 0x6c, 0x6c, 0x52,
+	0x06, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x12, 0x41, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x2b, 0x92, 0x41, 0x28, 0x32, 0x22, 0x54, 0x68, 0x65,
+	0x20, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x20, 0x6f, 0x66, 0x20, 0x50, 0x6f, 0x49, 0x73, 0x20,
+	0x77, 0x69, 0x74, 0x68, 0x69, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x65, 0x6c, 0x6c, 0x4a,
+	0x02, 0x34, 0x32, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x6b, 0x0a, 0x0d, 0x63, 0x68,
+	0x61, 0x72, 0x67, 0x65, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x05, 0x42, 0x46, 0x92, 0x41, 0x43, 0x32, 0x3d, 0x54, 0x68, 0x65, 0x20, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x20, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x20, 0x6f, 0x66, 0x20, 0x63, 0x68, 0x61, 0x72,
+	0x67, 0x65, 0x20, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65,
+	0x20, 0x50, 0x6f, 0x49, 0x73, 0x20, 0x77, 0x69, 0x74, 0x68, 0x69, 0x6e, 0x20, 0x74, 0x68, 0x65,
+	0x20, 0x63, 0x65, 0x6c, 0x6c, 0x4a, 0x02, 0x39, 0x36, 0x52, 0x0c, 0x63, 0x68, 0x61, 0x72, 0x67,
+	0x65, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x64, 0x0a, 0x08, 0x70, 0x6f, 0x77, 0x65, 0x72,
+	0x5f, 0x6b, 0x77, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x42, 0x49, 0x92, 0x41, 0x46, 0x32, 0x3e,
+	0x54, 0x68, 0x65, 0x20, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x20, 0x6d, 0x61, 0x78, 0x20, 0x63, 0x68,
+	0x61, 0x72, 0x67, 0x69, 0x6e, 0x67, 0x20, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x20, 0x6f, 0x66, 0x20,
+	0x74, 0x68, 0x65, 0x20, 0x50, 0x6f, 0x49, 0x73, 0x20, 0x77, 0x69, 0x74, 0x68, 0x69, 0x6e, 0x20,
+	0x74, 0x68, 0x65, 0x20, 0x63, 0x65, 0x6c, 0x6c, 0x20, 0x69, 0x6e, 0x20, 0x6b, 0x57, 0x4a, 0x04,
+	0x32, 0x31, 0x35, 0x30, 0x52, 0x07, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x4b, 0x77, 0x22, 0xc4, 0x05,
+	0x0a, 0x0f, 0x44, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x34, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x42, 0x1e, 0x92, 0x41, 0x1b, 0x32, 0x16, 0x54, 0x68, 0x65, 0x20, 0x6c, 0x65, 0x76, 0x65, 0x6c,
+	0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x65, 0x6c, 0x6c, 0x73, 0x4a, 0x01, 0x38,
+	0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0xcc, 0x01, 0x0a, 0x05, 0x63, 0x65, 0x6c, 0x6c,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6f,
+	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x79, 0x43, 0x65, 0x6c, 0x6c,
+	0x42, 0x9c, 0x01, 0x92, 0x41, 0x98, 0x01, 0x32, 0x95, 0x01, 0x54, 0x68, 0x65, 0x20, 0x63, 0x65,
+	0x6c, 0x6c, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6c, 0x65, 0x76, 0x65, 0x6c,
+	0x20, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6e, 0x67, 0x20, 0x74, 0x68,
+	0x65, 0x20, 0x61, 0x72, 0x65, 0x61, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x61, 0x6e, 0x79, 0x20,
+	0x50, 0x6f, 0x49, 0x2c, 0x20, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x65, 0x64, 0x20, 0x62, 0x79, 0x20,
+	0x74, 0x68, 0x65, 0x69, 0x72, 0x20, 0x63, 0x65, 0x6c, 0x6c, 0x20, 0x69, 0x64, 0x2e, 0x20, 0x45,
+	0x61, 0x63, 0x68, 0x20, 0x63, 0x65, 0x6c, 0x6c, 0x20, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x20,
+	0x61, 0x6c, 0x6c, 0x20, 0x6f, 0x66, 0x20, 0x69, 0x74, 0x73, 0x20, 0x50, 0x6f, 0x49, 0x73, 0x2c,
+	0x20, 0x61, 0x6c, 0x73, 0x6f, 0x20, 0x74, 0x68, 0x6f, 0x73, 0x65, 0x20, 0x6f, 0x75, 0x74, 0x73,
+	0x69, 0x64, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x61, 0x72, 0x65, 0x61, 0x52,
+	0x05, 0x63, 0x65, 0x6c, 0x6c, 0x73, 0x12, 0x75, 0x0a, 0x0b, 0x70, 0x72, 0x65, 0x63, 0x6f, 0x6d,
+	0x70, 0x75, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x42, 0x53, 0x92, 0x41, 0x50,
+	0x32, 0x4e, 0x54, 0x72, 0x75, 0x65, 0x20, 0x69, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x65,
+	0x6c, 0x6c, 0x73, 0x20, 0x68, 0x61, 0x76, 0x65, 0x20, 0x62, 0x65, 0x65, 0x6e, 0x20, 0x73, 0x75,
+	0x6d, 0x6d, 0x65, 0x64, 0x20, 0x75, 0x70, 0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20, 0x74, 0x68, 0x65,
+	0x20, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x73, 0x20, 0x6d, 0x61, 0x69, 0x6e,
+	0x74, 0x61, 0x69, 0x6e, 0x65, 0x64, 0x20, 0x6f, 0x6e, 0x20, 0x77, 0x72, 0x69, 0x74, 0x65, 0x73,
+	0x52, 0x0b, 0x70, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x64, 0x12, 0x9d, 0x01,
+	0x0a, 0x09, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x08, 0x42, 0x7f, 0x92, 0x41, 0x7c, 0x32, 0x7a, 0x54, 0x72, 0x75, 0x65, 0x20, 0x69, 0x66, 0x20,
+	0x74, 0x68, 0x65, 0x20, 0x61, 0x72, 0x65, 0x61, 0x20, 0x77, 0x61, 0x73, 0x20, 0x74, 0x6f, 0x6f,
+	0x20, 0x6c, 0x61, 0x72, 0x67, 0x65, 0x20, 0x74, 0x6f, 0x20, 0x62, 0x65, 0x20, 0x73, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x65, 0x64, 0x20, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x6c, 0x79,
+	0x2e, 0x20, 0x4f, 0x6e, 0x6c, 0x79, 0x20, 0x74, 0x68, 0x65, 0x20, 0x70, 0x61, 0x72, 0x74, 0x20,
+	0x63, 0x6c, 0x6f, 0x73, 0x65, 0x73, 0x74, 0x20, 0x74, 0x6f, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63,
+	0x65, 0x6e, 0x74, 0x65, 0x72, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x61, 0x72, 0x65,
+	0x61, 0x20, 0x68, 0x61, 0x73, 0x20, 0x62, 0x65, 0x65, 0x6e, 0x20, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x65, 0x64, 0x52, 0x09, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x12, 0x94, 0x01,
+	0x0a, 0x10, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x65, 0x64, 0x5f, 0x66, 0x72, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x42, 0x69, 0x92, 0x41, 0x66, 0x32, 0x5e, 0x54,
+	0x68, 0x65, 0x20, 0x73, 0x68, 0x61, 0x72, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20,
+	0x61, 0x72, 0x65, 0x61, 0x20, 0x77, 0x68, 0x69, 0x63, 0x68, 0x20, 0x68, 0x61, 0x73, 0x20, 0x62,
+	0x65, 0x65, 0x6e, 0x20, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x64, 0x20, 0x62, 0x65, 0x74,
+	0x77, 0x65, 0x65, 0x6e, 0x20, 0x30, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x31, 0x2e, 0x20, 0x4f, 0x6e,
+	0x6c, 0x79, 0x20, 0x73, 0x65, 0x74, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x74, 0x72, 0x75, 0x6e, 0x63,
+	0x61, 0x74, 0x65, 0x64, 0x20, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x73, 0x4a, 0x04, 0x30,
+	0x2e, 0x37, 0x35, 0x52, 0x0f, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x65, 0x64, 0x46, 0x72, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0xde, 0x06, 0x0a, 0x11, 0x50, 0x6f, 0x49, 0x53, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x05, 0x69, 0x74,
+	0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x70, 0x6f, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x49, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d,
+	0x73, 0x12, 0xc3, 0x01, 0x0a, 0x0f, 0x64, 0x69, 0x73, 0x63, 0x61, 0x72, 0x64, 0x65, 0x64, 0x5f,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x99, 0x01, 0x92, 0x41,
+	0x95, 0x01, 0x32, 0x8e, 0x01, 0x54, 0x68, 0x65, 0x20, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x20,
+	0x6f, 0x66, 0x20, 0x50, 0x6f, 0x49, 0x73, 0x20, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x20, 0x69, 0x6e,
+	0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x20, 0x67, 0x65,
+	0x6f, 0x20, 0x63, 0x65, 0x6c, 0x6c, 0x73, 0x20, 0x62, 0x75, 0x74, 0x20, 0x64, 0x69, 0x73, 0x63,
+	0x61, 0x72, 0x64, 0x65, 0x64, 0x20, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x20, 0x74, 0x68, 0x65, 0x79,
+	0x20, 0x61, 0x72, 0x65, 0x20, 0x6f, 0x75, 0x74, 0x73, 0x69, 0x64, 0x65, 0x20, 0x6f, 0x66, 0x20,
+	0x74, 0x68, 0x65, 0x20, 0x65, 0x78, 0x61, 0x63, 0x74, 0x20, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x20, 0x61, 0x72, 0x65, 0x61, 0x2e, 0x20, 0x4f, 0x6e, 0x6c, 0x79, 0x20, 0x73, 0x65, 0x74, 0x20,
+	0x66, 0x6f, 0x72, 0x20, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x20, 0x73, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x65, 0x73, 0x4a, 0x02, 0x31, 0x32, 0x52, 0x0e, 0x64, 0x69, 0x73, 0x63, 0x61, 0x72, 0x64,
+	0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0xf4, 0x01, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74,
+	0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0xcb, 0x01, 0x92, 0x41, 0xc7, 0x01, 0x32, 0xc4, 0x01, 0x4f, 0x70, 0x61, 0x71, 0x75,
+	0x65, 0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x20, 0x74, 0x6f, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6e, 0x65, 0x78, 0x74, 0x20, 0x70, 0x61, 0x67, 0x65,
+	0x20, 0x6f, 0x66, 0x20, 0x61, 0x20, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x20,
+	0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2e, 0x20, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x20, 0x69, 0x66,
+	0x20, 0x74, 0x68, 0x65, 0x72, 0x65, 0x20, 0x61, 0x72, 0x65, 0x20, 0x6e, 0x6f, 0x20, 0x6d, 0x6f,
+	0x72, 0x65, 0x20, 0x50, 0x6f, 0x49, 0x73, 0x2e, 0x20, 0x50, 0x61, 0x67, 0x65, 0x73, 0x20, 0x77,
+	0x69, 0x74, 0x68, 0x20, 0x61, 0x20, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x20, 0x61, 0x72, 0x65, 0x20, 0x6e, 0x65, 0x76, 0x65, 0x72, 0x20,
+	0x65, 0x6d, 0x70, 0x74, 0x79, 0x2c, 0x20, 0x61, 0x20, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x20,
+	0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x20, 0x6d, 0x61, 0x79, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72,
+	0x6e, 0x20, 0x66, 0x65, 0x77, 0x65, 0x72, 0x20, 0x50, 0x6f, 0x49, 0x73, 0x20, 0x74, 0x68, 0x61,
+	0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x52,
+	0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0xc6,
+	0x01, 0x0a, 0x09, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x42, 0xa7, 0x01, 0x92, 0x41, 0xa3, 0x01, 0x32, 0xa0, 0x01, 0x54, 0x72, 0x75, 0x65,
+	0x20, 0x69, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x20, 0x61,
+	0x72, 0x65, 0x61, 0x20, 0x77, 0x61, 0x73, 0x20, 0x74, 0x6f, 0x6f, 0x20, 0x6c, 0x61, 0x72, 0x67,
+	0x65, 0x20, 0x74, 0x6f, 0x20, 0x62, 0x65, 0x20, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x64,
+	0x20, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x6c, 0x79, 0x2e, 0x20, 0x4f, 0x6e, 0x6c,
+	0x79, 0x20, 0x74, 0x68, 0x65, 0x20, 0x70, 0x61, 0x72, 0x74, 0x20, 0x63, 0x6c, 0x6f, 0x73, 0x65,
+	0x73, 0x74, 0x20, 0x74, 0x6f, 0x20, 0x74, 0x68, 0x65, 0x20, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x20, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2c, 0x20, 0x65, 0x2e, 0x67, 0x2e,
+	0x20, 0x74, 0x68, 0x65, 0x20, 0x62, 0x65, 0x67, 0x69, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x20, 0x6f,
+	0x66, 0x20, 0x61, 0x20, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2c, 0x20, 0x68, 0x61, 0x73, 0x20, 0x62,
+	0x65, 0x65, 0x6e, 0x20, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x64, 0x52, 0x09, 0x74, 0x72,
+	0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x12, 0x9b, 0x01, 0x0a, 0x10, 0x63, 0x6f, 0x76, 0x65,
+	0x72, 0x65, 0x64, 0x5f, 0x66, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x01, 0x42, 0x70, 0x92, 0x41, 0x6d, 0x32, 0x65, 0x54, 0x68, 0x65, 0x20, 0x73, 0x68, 0x61,
+	0x72, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x20, 0x61, 0x72, 0x65, 0x61, 0x20, 0x77, 0x68, 0x69, 0x63, 0x68, 0x20, 0x68, 0x61, 0x73, 0x20,
+	0x62, 0x65, 0x65, 0x6e, 0x20, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x64, 0x20, 0x62, 0x65,
+	0x74, 0x77, 0x65, 0x65, 0x6e, 0x20, 0x30, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x31, 0x2e, 0x20, 0x4f,
+	0x6e, 0x6c, 0x79, 0x20, 0x73, 0x65, 0x74, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x74, 0x72, 0x75, 0x6e,
+	0x63, 0x61, 0x74, 0x65, 0x64, 0x20, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x73, 0x4a, 0x04,
+	0x30, 0x2e, 0x37, 0x35, 0x52, 0x0f, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x65, 0x64, 0x46, 0x72, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xc1, 0x01, 0x0a, 0x0d, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x26, 0x92, 0x41, 0x23, 0x32, 0x0d, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x20, 0x63, 0x6f, 0x64, 0x65, 0x8a, 0x01, 0x07, 0x5e, 0x5b, 0x30, 0x2d,
+	0x39, 0x5d, 0x24, 0xa2, 0x02, 0x07, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x65, 0x72, 0x52, 0x04, 0x63,
+	0x6f, 0x64, 0x65, 0x12, 0x46, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x2c, 0x92, 0x41, 0x29, 0x32, 0x10, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x20, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x8a, 0x01, 0x14, 0x5e, 0x5b,
+	0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x31, 0x2c, 0x20, 0x33, 0x32,
+	0x7d, 0x24, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2c, 0x0a, 0x07, 0x64,
+	0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x42, 0x12, 0x92, 0x41,
+	0x0f, 0x32, 0x0d, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73,
+	0x52, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x22, 0x0d, 0x0a, 0x0b, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2a, 0x63, 0x0a, 0x06, 0x53, 0x6f, 0x72, 0x74,
+	0x42, 0x79, 0x12, 0x17, 0x0a, 0x13, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x42, 0x59, 0x5f, 0x55, 0x4e,
+	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x53,
+	0x4f, 0x52, 0x54, 0x5f, 0x42, 0x59, 0x5f, 0x44, 0x49, 0x53, 0x54, 0x41, 0x4e, 0x43, 0x45, 0x10,
+	0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x42, 0x59, 0x5f, 0x49, 0x44, 0x10,
+	0x02, 0x12, 0x1a, 0x0a, 0x16, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x42, 0x59, 0x5f, 0x52, 0x4f, 0x55,
+	0x54, 0x45, 0x5f, 0x50, 0x4f, 0x53, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x03, 0x2a, 0x5d, 0x0a,
+	0x0d, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1e,
+	0x0a, 0x1a, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x4f, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x15,
+	0x0a, 0x11, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x4f, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x41, 0x43, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54,
+	0x4f, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x44, 0x43, 0x10, 0x02, 0x32, 0xba, 0x17, 0x0a,
+	0x0a, 0x50, 0x6f, 0x49, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0xa6, 0x01, 0x0a, 0x03,
+	0x50, 0x6f, 0x49, 0x12, 0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6f, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x6f, 0x49, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x70, 0x6f, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x49, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x6e, 0x92, 0x41, 0x4d, 0x72, 0x4b, 0x0a, 0x49, 0x0a, 0x10, 0x58,
+	0x2d, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2d, 0x49, 0x64, 0x12,
+	0x2b, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x20, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x20, 0x69, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x01, 0x22, 0x04,
+	0x75, 0x75, 0x69, 0x64, 0x28, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x12, 0x16, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x69, 0x73, 0x2f, 0x69, 0x6e, 0x66, 0x6f, 0x2f,
+	0x7b, 0x69, 0x64, 0x7d, 0x12, 0xbd, 0x01, 0x0a, 0x0c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65,
+	0x74, 0x50, 0x6f, 0x49, 0x73, 0x12, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6f, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x49, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6f, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x49, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x6a, 0x92, 0x41, 0x4d, 0x72, 0x4b, 0x0a,
+	0x49, 0x0a, 0x10, 0x58, 0x2d, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2d, 0x49, 0x64, 0x12, 0x2b, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x20, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x20, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x20, 0x66, 0x6f, 0x72,
+	0x20, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73,
+	0x18, 0x01, 0x22, 0x04, 0x75, 0x75, 0x69, 0x64, 0x28, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14,
+	0x12, 0x12, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x69, 0x73, 0x2f, 0x62,
+	0x61, 0x74, 0x63, 0x68, 0x12, 0xad, 0x01, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50,
+	0x6f, 0x49, 0x12, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6f, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x49, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6f, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f,
+	0x49, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x69, 0x92, 0x41, 0x4d, 0x72, 0x4b,
+	0x0a, 0x49, 0x0a, 0x10, 0x58, 0x2d, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x2d, 0x49, 0x64, 0x12, 0x2b, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x20, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x20, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x20, 0x66, 0x6f,
+	0x72, 0x20, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x73, 0x18, 0x01, 0x22, 0x04, 0x75, 0x75, 0x69, 0x64, 0x28, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x13, 0x3a, 0x03, 0x70, 0x6f, 0x69, 0x22, 0x0c, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f,
+	0x70, 0x6f, 0x69, 0x73, 0x12, 0xb6, 0x01, 0x0a, 0x09, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50,
+	0x6f, 0x49, 0x12, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6f, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x49, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6f, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f,
+	0x49, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x72, 0x92, 0x41, 0x4d, 0x72, 0x4b,
+	0x0a, 0x49, 0x0a, 0x10, 0x58, 0x2d, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x2d, 0x49, 0x64, 0x12, 0x2b, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x20, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x20, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x20, 0x66, 0x6f,
+	0x72, 0x20, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x73, 0x18, 0x01, 0x22, 0x04, 0x75, 0x75, 0x69, 0x64, 0x28, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x1c, 0x3a, 0x03, 0x70, 0x6f, 0x69, 0x32, 0x15, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f,
+	0x70, 0x6f, 0x69, 0x73, 0x2f, 0x7b, 0x70, 0x6f, 0x69, 0x2e, 0x69, 0x64, 0x7d, 0x12, 0xb3, 0x01,
+	0x0a, 0x09, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x49, 0x12, 0x1c, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x70, 0x6f, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50,
+	0x6f, 0x49, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x70, 0x6f, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x49,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x69, 0x92, 0x41, 0x4d, 0x72, 0x4b, 0x0a,
+	0x49, 0x0a, 0x10, 0x58, 0x2d, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2d, 0x49, 0x64, 0x12, 0x2b, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x20, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x20, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x20, 0x66, 0x6f, 0x72,
+	0x20, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73,
+	0x18, 0x01, 0x22, 0x04, 0x75, 0x75, 0x69, 0x64, 0x28, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13,
+	0x2a, 0x11, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x69, 0x73, 0x2f, 0x7b,
+	0x69, 0x64, 0x7d, 0x12, 0xb8, 0x01, 0x0a, 0x09, 0x50, 0x72, 0x6f, 0x78, 0x69, 0x6d, 0x69, 0x74,
+	0x79, 0x12, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6f, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x72, 0x6f, 0x78, 0x69, 0x6d, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6f, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x49,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x6e,
+	0x92, 0x41, 0x4d, 0x72, 0x4b, 0x0a, 0x49, 0x0a, 0x10, 0x58, 0x2d, 0x43, 0x6f, 0x72, 0x72, 0x65,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2d, 0x49, 0x64, 0x12, 0x2b, 0x55, 0x6e, 0x69, 0x71, 0x75,
+	0x65, 0x20, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x20, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
+	0x65, 0x72, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x20, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x01, 0x22, 0x04, 0x75, 0x75, 0x69, 0x64, 0x28, 0x01,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x12, 0x16, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f,
+	0x70, 0x6f, 0x69, 0x73, 0x2f, 0x70, 0x72, 0x6f, 0x78, 0x69, 0x6d, 0x69, 0x74, 0x79, 0x12, 0xa9,
+	0x01, 0x0a, 0x04, 0x42, 0x42, 0x6f, 0x78, 0x12, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6f,
+	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x42, 0x6f, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6f, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f,
+	0x49, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x69, 0x92, 0x41, 0x4d, 0x72, 0x4b, 0x0a, 0x49, 0x0a, 0x10, 0x58, 0x2d, 0x43, 0x6f, 0x72, 0x72,
+	0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2d, 0x49, 0x64, 0x12, 0x2b, 0x55, 0x6e, 0x69, 0x71,
 	0x75, 0x65, 0x20, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x20, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
 	0x69, 0x65, 0x72, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x20, 0x72,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x4a, 0x1d, 0x22, 0x32, 0x6a, 0x73, 0x58, 0x41, 0x4e,
-	0x6a, 0x42, 0x71, 0x42, 0x75, 0x39, 0x30, 0x76, 0x61, 0x43, 0x37, 0x75, 0x50, 0x6c, 0x34, 0x67,
-	0x4e, 0x31, 0x79, 0x55, 0x6e, 0x22, 0xa2, 0x02, 0x05, 0x6b, 0x73, 0x75, 0x69, 0x64, 0x52, 0x02,
-	0x69, 0x64, 0x12, 0xb2, 0x01, 0x0a, 0x0a, 0x63, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6f,
-	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x42,
-	0x7a, 0x92, 0x41, 0x77, 0x32, 0x4e, 0x54, 0x68, 0x65, 0x20, 0x57, 0x47, 0x53, 0x20, 0x63, 0x6f,
-	0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x20, 0x72, 0x65, 0x70, 0x72, 0x65, 0x73, 0x65,
-	0x6e, 0x74, 0x69, 0x6e, 0x67, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72,
-	0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x20, 0x6f,
-	0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x50, 0x6f, 0x49, 0x73, 0x27, 0x20, 0x6c, 0x6f, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x4a, 0x25, 0x7b, 0x22, 0x6c, 0x61, 0x74, 0x22, 0x3a, 0x20, 0x34, 0x38,
-	0x2e, 0x31, 0x33, 0x37, 0x31, 0x35, 0x34, 0x2c, 0x20, 0x22, 0x6c, 0x6f, 0x6e, 0x22, 0x3a, 0x20,
-	0x31, 0x31, 0x2e, 0x35, 0x37, 0x36, 0x31, 0x32, 0x34, 0x20, 0x7d, 0x52, 0x0a, 0x63, 0x6f, 0x6f,
-	0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x12, 0xa7, 0x01, 0x0a, 0x08, 0x65, 0x6e, 0x74, 0x72,
-	0x61, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x70, 0x6f, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61,
-	0x74, 0x65, 0x42, 0x6e, 0x92, 0x41, 0x6b, 0x32, 0x42, 0x54, 0x68, 0x65, 0x20, 0x57, 0x47, 0x53,
-	0x20, 0x63, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x20, 0x6d, 0x61, 0x72, 0x6b,
-	0x69, 0x6e, 0x67, 0x20, 0x74, 0x68, 0x65, 0x20, 0x72, 0x6f, 0x61, 0x64, 0x20, 0x65, 0x6e, 0x74,
-	0x72, 0x61, 0x6e, 0x63, 0x65, 0x20, 0x74, 0x6f, 0x20, 0x74, 0x68, 0x65, 0x20, 0x50, 0x6f, 0x49,
-	0x73, 0x27, 0x20, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4a, 0x25, 0x7b, 0x22, 0x6c,
-	0x61, 0x74, 0x22, 0x3a, 0x20, 0x34, 0x38, 0x2e, 0x31, 0x33, 0x37, 0x31, 0x35, 0x34, 0x2c, 0x20,
-	0x22, 0x6c, 0x6f, 0x6e, 0x22, 0x3a, 0x20, 0x31, 0x31, 0x2e, 0x35, 0x37, 0x36, 0x31, 0x32, 0x34,
-	0x20, 0x7d, 0x48, 0x00, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x88, 0x01,
-	0x01, 0x12, 0xb8, 0x01, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6f, 0x69, 0x2e, 0x76, 0x31,
-	0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x42, 0x88, 0x01, 0x92, 0x41, 0x84, 0x01, 0x32,
-	0x16, 0x54, 0x68, 0x65, 0x20, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x20, 0x6f, 0x66, 0x20,
-	0x74, 0x68, 0x65, 0x20, 0x50, 0x6f, 0x49, 0x4a, 0x6a, 0x7b, 0x22, 0x73, 0x74, 0x72, 0x65, 0x65,
-	0x74, 0x22, 0x3a, 0x20, 0x22, 0x4d, 0x79, 0x20, 0x53, 0x74, 0x72, 0x65, 0x65, 0x74, 0x22, 0x2c,
-	0x20, 0x22, 0x73, 0x74, 0x72, 0x65, 0x65, 0x74, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22,
-	0x3a, 0x20, 0x22, 0x31, 0x62, 0x22, 0x2c, 0x20, 0x22, 0x7a, 0x69, 0x70, 0x5f, 0x63, 0x6f, 0x64,
-	0x65, 0x22, 0x3a, 0x20, 0x38, 0x30, 0x33, 0x33, 0x31, 0x2c, 0x20, 0x22, 0x63, 0x69, 0x74, 0x79,
-	0x22, 0x3a, 0x20, 0x20, 0x22, 0x4d, 0x75, 0x6e, 0x69, 0x63, 0x68, 0x22, 0x2c, 0x20, 0x22, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x22, 0x3a, 0x20, 0x22, 0x47, 0x65, 0x72, 0x6d, 0x61, 0x6e,
-	0x79, 0x22, 0x7d, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0xa2, 0x01, 0x0a,
-	0x08, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x42,
-	0x85, 0x01, 0x92, 0x41, 0x81, 0x01, 0x32, 0x4f, 0x41, 0x20, 0x6c, 0x69, 0x73, 0x74, 0x20, 0x6f,
-	0x66, 0x20, 0x66, 0x72, 0x65, 0x65, 0x20, 0x66, 0x6f, 0x72, 0x6d, 0x20, 0x74, 0x65, 0x78, 0x74,
-	0x20, 0x6f, 0x66, 0x20, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x20, 0x66, 0x65,
-	0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x61, 0x6d, 0x65, 0x6e, 0x69,
-	0x74, 0x69, 0x65, 0x73, 0x20, 0x61, 0x74, 0x20, 0x67, 0x69, 0x76, 0x65, 0x6e, 0x20, 0x6c, 0x6f,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x4a, 0x2e, 0x5b, 0x22, 0x63, 0x68, 0x61, 0x72, 0x67,
-	0x69, 0x6e, 0x67, 0x22, 0x2c, 0x20, 0x22, 0x72, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x22, 0x2c,
-	0x20, 0x22, 0x73, 0x68, 0x6f, 0x77, 0x65, 0x72, 0x22, 0x2c, 0x20, 0x22, 0x67, 0x72, 0x6f, 0x63,
-	0x65, 0x72, 0x69, 0x65, 0x73, 0x22, 0x5d, 0x52, 0x08, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65,
-	0x73, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x8d,
-	0x01, 0x0a, 0x0a, 0x43, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x12, 0x3f, 0x0a,
-	0x03, 0x6c, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x42, 0x2d, 0x92, 0x41, 0x27, 0x32,
-	0x1e, 0x4c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x20,
-	0x57, 0x47, 0x53, 0x20, 0x63, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x2e, 0x4a,
-	0x05, 0x31, 0x31, 0x2e, 0x35, 0x37, 0xe0, 0x41, 0x02, 0x52, 0x03, 0x6c, 0x6f, 0x6e, 0x12, 0x3e,
-	0x0a, 0x03, 0x6c, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x42, 0x2c, 0x92, 0x41, 0x26,
-	0x32, 0x1d, 0x4c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x20,
-	0x57, 0x47, 0x53, 0x20, 0x63, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x2e, 0x4a,
-	0x05, 0x34, 0x38, 0x2e, 0x31, 0x33, 0xe0, 0x41, 0x02, 0x52, 0x03, 0x6c, 0x61, 0x74, 0x22, 0xea,
-	0x02, 0x0a, 0x07, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x45, 0x0a, 0x06, 0x73, 0x74,
-	0x72, 0x65, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2d, 0x92, 0x41, 0x2a, 0x32,
-	0x16, 0x54, 0x68, 0x65, 0x20, 0x6e, 0x61, 0x6d, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65,
-	0x20, 0x73, 0x74, 0x72, 0x65, 0x65, 0x74, 0x4a, 0x10, 0x22, 0x42, 0x69, 0x65, 0x72, 0x67, 0x72,
-	0x61, 0x74, 0x65, 0x6e, 0x73, 0x74, 0x72, 0x2e, 0x22, 0x52, 0x06, 0x73, 0x74, 0x72, 0x65, 0x65,
-	0x74, 0x12, 0x58, 0x0a, 0x0d, 0x73, 0x74, 0x72, 0x65, 0x65, 0x74, 0x5f, 0x6e, 0x75, 0x6d, 0x62,
-	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x32, 0x92, 0x41, 0x2f, 0x32, 0x26, 0x54,
-	0x68, 0x65, 0x20, 0x73, 0x74, 0x72, 0x65, 0x65, 0x74, 0x20, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
-	0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x67, 0x69, 0x76, 0x65, 0x6e, 0x20, 0x61, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x4a, 0x05, 0x22, 0x31, 0x31, 0x61, 0x22, 0x52, 0x0d, 0x73, 0x74,
-	0x72, 0x65, 0x65, 0x74, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x4a, 0x0a, 0x08, 0x7a,
-	0x69, 0x70, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2e, 0x92,
-	0x41, 0x2b, 0x32, 0x22, 0x54, 0x68, 0x65, 0x20, 0x7a, 0x69, 0x70, 0x20, 0x63, 0x6f, 0x64, 0x65,
-	0x20, 0x66, 0x6f, 0x72, 0x20, 0x74, 0x68, 0x65, 0x20, 0x67, 0x69, 0x76, 0x65, 0x6e, 0x20, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x4a, 0x05, 0x38, 0x30, 0x33, 0x33, 0x31, 0x52, 0x08, 0x7a,
-	0x69, 0x70, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x37, 0x0a, 0x04, 0x63, 0x69, 0x74, 0x79, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x23, 0x92, 0x41, 0x20, 0x32, 0x14, 0x54, 0x68, 0x65, 0x20,
-	0x6e, 0x61, 0x6d, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x69, 0x74, 0x79,
-	0x4a, 0x08, 0x22, 0x42, 0x65, 0x72, 0x6c, 0x69, 0x6e, 0x22, 0x52, 0x04, 0x63, 0x69, 0x74, 0x79,
-	0x12, 0x39, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x1f, 0x92, 0x41, 0x1c, 0x32, 0x13, 0x41, 0x6c, 0x70, 0x68, 0x61, 0x33, 0x20, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x20, 0x63, 0x6f, 0x64, 0x65, 0x4a, 0x05, 0x22, 0x44, 0x45,
-	0x55, 0x22, 0x52, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x22, 0xcc, 0x02, 0x0a, 0x04,
-	0x42, 0x42, 0x6f, 0x78, 0x12, 0xa0, 0x01, 0x0a, 0x02, 0x73, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6f, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43,
-	0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x42, 0x78, 0x92, 0x41, 0x75, 0x32, 0x44,
-	0x54, 0x68, 0x65, 0x20, 0x57, 0x47, 0x53, 0x20, 0x63, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61,
-	0x74, 0x65, 0x20, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x20, 0x74, 0x68, 0x65, 0x20,
-	0x73, 0x6f, 0x75, 0x74, 0x68, 0x20, 0x77, 0x65, 0x73, 0x74, 0x20, 0x63, 0x6f, 0x72, 0x6e, 0x65,
-	0x72, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x20, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x20,
-	0x62, 0x6f, 0x78, 0x2e, 0x4a, 0x25, 0x7b, 0x22, 0x6c, 0x61, 0x74, 0x22, 0x3a, 0x20, 0x34, 0x38,
-	0x2e, 0x31, 0x33, 0x37, 0x31, 0x35, 0x34, 0x2c, 0x20, 0x22, 0x6c, 0x6f, 0x6e, 0x22, 0x3a, 0x20,
-	0x31, 0x31, 0x2e, 0x35, 0x37, 0x36, 0x31, 0x32, 0x34, 0x20, 0x7d, 0xca, 0x3e, 0x05, 0xfa, 0x02,
-	0x02, 0x73, 0x77, 0x52, 0x02, 0x73, 0x77, 0x12, 0xa0, 0x01, 0x0a, 0x02, 0x6e, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6f, 0x69, 0x2e, 0x76,
-	0x31, 0x2e, 0x43, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x42, 0x78, 0x92, 0x41,
-	0x75, 0x32, 0x44, 0x54, 0x68, 0x65, 0x20, 0x57, 0x47, 0x53, 0x20, 0x63, 0x6f, 0x6f, 0x72, 0x64,
-	0x69, 0x6e, 0x61, 0x74, 0x65, 0x20, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x20, 0x74,
-	0x68, 0x65, 0x20, 0x6e, 0x6f, 0x72, 0x74, 0x68, 0x20, 0x65, 0x61, 0x73, 0x74, 0x20, 0x63, 0x6f,
-	0x72, 0x6e, 0x65, 0x72, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x20, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x69,
-	0x6e, 0x67, 0x20, 0x62, 0x6f, 0x78, 0x2e, 0x4a, 0x25, 0x7b, 0x22, 0x6c, 0x61, 0x74, 0x22, 0x3a,
-	0x20, 0x34, 0x38, 0x2e, 0x31, 0x33, 0x37, 0x31, 0x35, 0x34, 0x2c, 0x20, 0x22, 0x6c, 0x6f, 0x6e,
-	0x22, 0x3a, 0x20, 0x31, 0x31, 0x2e, 0x35, 0x37, 0x36, 0x31, 0x32, 0x34, 0x20, 0x7d, 0xca, 0x3e,
-	0x05, 0xfa, 0x02, 0x02, 0x6e, 0x65, 0x52, 0x02, 0x6e, 0x65, 0x22, 0xbc, 0x01, 0x0a, 0x0a, 0x50,
-	0x6f, 0x49, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0xad, 0x01, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x9c, 0x01, 0x92, 0x41, 0x98, 0x01, 0x32, 0x1e, 0x54,
-	0x68, 0x65, 0x20, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x20, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69,
-	0x66, 0x69, 0x65, 0x72, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x20, 0x50, 0x6f, 0x49, 0x4a, 0x26, 0x22,
-	0x32, 0x34, 0x33, 0x38, 0x61, 0x63, 0x33, 0x63, 0x2d, 0x33, 0x37, 0x65, 0x62, 0x2d, 0x34, 0x39,
-	0x30, 0x32, 0x2d, 0x61, 0x64, 0x65, 0x66, 0x2d, 0x65, 0x64, 0x31, 0x36, 0x62, 0x34, 0x34, 0x33,
-	0x31, 0x30, 0x33, 0x30, 0x22, 0x8a, 0x01, 0x46, 0x5e, 0x5b, 0x30, 0x2d, 0x39, 0x41, 0x2d, 0x46,
-	0x5d, 0x7b, 0x38, 0x7d, 0x2d, 0x5b, 0x30, 0x2d, 0x39, 0x41, 0x2d, 0x46, 0x5d, 0x7b, 0x34, 0x7d,
-	0x2d, 0x34, 0x5b, 0x30, 0x2d, 0x39, 0x41, 0x2d, 0x46, 0x5d, 0x7b, 0x33, 0x7d, 0x2d, 0x5b, 0x38,
-	0x39, 0x41, 0x42, 0x5d, 0x5b, 0x30, 0x2d, 0x39, 0x41, 0x2d, 0x46, 0x5d, 0x7b, 0x33, 0x7d, 0x2d,
-	0x5b, 0x30, 0x2d, 0x77, 0x39, 0x41, 0x2d, 0x46, 0x5d, 0x7b, 0x31, 0x32, 0x7d, 0x24, 0xa2, 0x02,
-	0x04, 0x75, 0x75, 0x69, 0x64, 0x52, 0x02, 0x69, 0x64, 0x22, 0x30, 0x0a, 0x0b, 0x50, 0x6f, 0x49,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x03, 0x70, 0x6f, 0x69, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6f, 0x69, 0x2e,
-	0x76, 0x31, 0x2e, 0x50, 0x6f, 0x49, 0x52, 0x03, 0x70, 0x6f, 0x69, 0x22, 0xa5, 0x02, 0x0a, 0x10,
-	0x50, 0x72, 0x6f, 0x78, 0x69, 0x6d, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x33, 0x0a, 0x06, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6f, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f,
-	0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x06, 0x63,
-	0x65, 0x6e, 0x74, 0x65, 0x72, 0x12, 0xdb, 0x01, 0x0a, 0x0d, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73,
-	0x5f, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x42, 0xb5, 0x01,
-	0x92, 0x41, 0xb1, 0x01, 0x32, 0x95, 0x01, 0x54, 0x68, 0x65, 0x20, 0x73, 0x65, 0x61, 0x72, 0x63,
-	0x68, 0x20, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x20, 0x69, 0x6e, 0x20, 0x6d, 0x65, 0x74, 0x65,
-	0x72, 0x73, 0x20, 0x74, 0x6f, 0x20, 0x70, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x20, 0x61, 0x20,
-	0x70, 0x72, 0x78, 0x6f, 0x6d, 0x69, 0x74, 0x79, 0x20, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2e,
-	0x20, 0x54, 0x68, 0x65, 0x20, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x20, 0x69, 0x73, 0x20, 0x6c,
-	0x69, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x20, 0x64, 0x75, 0x65, 0x20, 0x74, 0x6f, 0x20,
-	0x70, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x20, 0x63, 0x6f, 0x6e, 0x73,
-	0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x73, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x72, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x69, 0x76, 0x6e, 0x65, 0x73, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65,
-	0x20, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4a, 0x05, 0x35, 0x30,
-	0x30, 0x30, 0x30, 0x59, 0x00, 0x00, 0x00, 0x00, 0x00, 0x6a, 0xf8, 0x40, 0x69, 0x00, 0x00, 0x00,
-	0x00, 0x00, 0x40, 0x8f, 0x40, 0x52, 0x0c, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x4d, 0x65, 0x74,
-	0x65, 0x72, 0x73, 0x22, 0x38, 0x0a, 0x0b, 0x42, 0x42, 0x6f, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x29, 0x0a, 0x04, 0x62, 0x62, 0x6f, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x10, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6f, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x42,
-	0x6f, 0x78, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x04, 0x62, 0x62, 0x6f, 0x78, 0x22, 0x8e, 0x01,
-	0x0a, 0x0c, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x7e,
-	0x0a, 0x05, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x70, 0x6f, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6f, 0x72, 0x64,
-	0x69, 0x6e, 0x61, 0x74, 0x65, 0x42, 0x50, 0x92, 0x41, 0x4d, 0x32, 0x46, 0x54, 0x68, 0x65, 0x20,
-	0x63, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x20, 0x70, 0x61, 0x74, 0x68, 0x20,
-	0x74, 0x6f, 0x20, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x63, 0x68,
-	0x61, 0x72, 0x67, 0x69, 0x6e, 0x67, 0x20, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x20,
-	0x69, 0x6e, 0x20, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x20, 0x70, 0x72, 0x6f, 0x78, 0x69, 0x6d, 0x69,
-	0x74, 0x79, 0x78, 0x64, 0x80, 0x01, 0x02, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x22, 0x3a,
-	0x0a, 0x11, 0x50, 0x6f, 0x49, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6f, 0x69, 0x2e, 0x76, 0x31, 0x2e,
-	0x50, 0x6f, 0x49, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0xc1, 0x01, 0x0a, 0x0d, 0x45,
-	0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x04,
-	0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x26, 0x92, 0x41, 0x23, 0x32,
-	0x0d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x20, 0x63, 0x6f, 0x64, 0x65, 0x8a, 0x01,
-	0x07, 0x5e, 0x5b, 0x30, 0x2d, 0x39, 0x5d, 0x24, 0xa2, 0x02, 0x07, 0x69, 0x6e, 0x74, 0x65, 0x67,
-	0x65, 0x72, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x46, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2c, 0x92, 0x41, 0x29, 0x32, 0x10,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x20, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x8a, 0x01, 0x14, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b,
-	0x31, 0x2c, 0x20, 0x33, 0x32, 0x7d, 0x24, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x12, 0x2c, 0x0a, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
-	0x09, 0x42, 0x12, 0x92, 0x41, 0x0f, 0x32, 0x0d, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x64, 0x65,
-	0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x22, 0x0d,
-	0x0a, 0x0b, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x32, 0xd2, 0x05,
-	0x0a, 0x0a, 0x50, 0x6f, 0x49, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0xa6, 0x01, 0x0a,
-	0x03, 0x50, 0x6f, 0x49, 0x12, 0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6f, 0x69, 0x2e, 0x76,
-	0x31, 0x2e, 0x50, 0x6f, 0x49, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x70, 0x6f, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x49, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x6e, 0x92, 0x41, 0x4d, 0x72, 0x4b, 0x0a, 0x49, 0x0a, 0x10,
-	0x58, 0x2d, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2d, 0x49, 0x64,
-	0x12, 0x2b, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x20, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x20, 0x69,
-	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x73, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x01, 0x22,
-	0x04, 0x75, 0x75, 0x69, 0x64, 0x28, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x12, 0x16, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x69, 0x73, 0x2f, 0x69, 0x6e, 0x66, 0x6f,
-	0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0xb8, 0x01, 0x0a, 0x09, 0x50, 0x72, 0x6f, 0x78, 0x69, 0x6d,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x01, 0x22, 0x04, 0x75, 0x75, 0x69, 0x64, 0x28,
+	0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x12, 0x11, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31,
+	0x2f, 0x70, 0x6f, 0x69, 0x73, 0x2f, 0x62, 0x62, 0x6f, 0x78, 0x12, 0xb3, 0x01, 0x0a, 0x05, 0x52,
+	0x6f, 0x75, 0x74, 0x65, 0x12, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6f, 0x69, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6f, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x49, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x71, 0x92,
+	0x41, 0x4d, 0x72, 0x4b, 0x0a, 0x49, 0x0a, 0x10, 0x58, 0x2d, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2d, 0x49, 0x64, 0x12, 0x2b, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65,
+	0x20, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x20, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65,
+	0x72, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x20, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x01, 0x22, 0x04, 0x75, 0x75, 0x69, 0x64, 0x28, 0x01, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x3a, 0x05, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x22, 0x12, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x69, 0x73, 0x2f, 0x72, 0x6f, 0x75, 0x74, 0x65,
+	0x12, 0xb2, 0x01, 0x0a, 0x07, 0x4e, 0x65, 0x61, 0x72, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x70, 0x6f, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x65, 0x61, 0x72, 0x65, 0x73,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70,
+	0x6f, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x49, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x6c, 0x92, 0x41, 0x4d, 0x72, 0x4b, 0x0a, 0x49,
+	0x0a, 0x10, 0x58, 0x2d, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2d,
+	0x49, 0x64, 0x12, 0x2b, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x20, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x20, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x20, 0x66, 0x6f, 0x72, 0x20,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18,
+	0x01, 0x22, 0x04, 0x75, 0x75, 0x69, 0x64, 0x28, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x12,
+	0x14, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x69, 0x73, 0x2f, 0x6e, 0x65,
+	0x61, 0x72, 0x65, 0x73, 0x74, 0x12, 0xb9, 0x01, 0x0a, 0x07, 0x50, 0x6f, 0x6c, 0x79, 0x67, 0x6f,
+	0x6e, 0x12, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6f, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x6f, 0x6c, 0x79, 0x67, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x70, 0x6f, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x49, 0x53, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x73, 0x92, 0x41,
+	0x4d, 0x72, 0x4b, 0x0a, 0x49, 0x0a, 0x10, 0x58, 0x2d, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x2d, 0x49, 0x64, 0x12, 0x2b, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x20,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x20, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72,
+	0x20, 0x66, 0x6f, 0x72, 0x20, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x20, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x73, 0x18, 0x01, 0x22, 0x04, 0x75, 0x75, 0x69, 0x64, 0x28, 0x01, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x1d, 0x3a, 0x05, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x14, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x69, 0x73, 0x2f, 0x70, 0x6f, 0x6c, 0x79, 0x67, 0x6f,
+	0x6e, 0x12, 0xde, 0x01, 0x0a, 0x11, 0x50, 0x6c, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x67, 0x69,
+	0x6e, 0x67, 0x53, 0x74, 0x6f, 0x70, 0x73, 0x12, 0x24, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6f,
+	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x67, 0x69, 0x6e,
+	0x67, 0x53, 0x74, 0x6f, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x70, 0x6f, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x43,
+	0x68, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x6f, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x7c, 0x92, 0x41, 0x4d, 0x72, 0x4b, 0x0a, 0x49, 0x0a, 0x10, 0x58,
+	0x2d, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2d, 0x49, 0x64, 0x12,
+	0x2b, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x20, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x20, 0x69, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x01, 0x22, 0x04,
+	0x75, 0x75, 0x69, 0x64, 0x28, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x3a, 0x01, 0x2a, 0x22,
+	0x21, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x69, 0x73, 0x2f, 0x72, 0x6f,
+	0x75, 0x74, 0x65, 0x2f, 0x63, 0x68, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x67, 0x2d, 0x73, 0x74, 0x6f,
+	0x70, 0x73, 0x12, 0xb4, 0x01, 0x0a, 0x08, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x12,
+	0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6f, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x70, 0x6f, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x6d, 0x92, 0x41, 0x4d, 0x72,
+	0x4b, 0x0a, 0x49, 0x0a, 0x10, 0x58, 0x2d, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2d, 0x49, 0x64, 0x12, 0x2b, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x20, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x20, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x20, 0x66,
+	0x6f, 0x72, 0x20, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x73, 0x18, 0x01, 0x22, 0x04, 0x75, 0x75, 0x69, 0x64, 0x28, 0x01, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x17, 0x12, 0x15, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x69, 0x73,
+	0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x12, 0xb3, 0x01, 0x0a, 0x07, 0x44, 0x65,
+	0x6e, 0x73, 0x69, 0x74, 0x79, 0x12, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6f, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x44, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6f, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x44,
+	0x65, 0x6e, 0x73, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x6f,
+	0x92, 0x41, 0x4d, 0x72, 0x4b, 0x0a, 0x49, 0x0a, 0x10, 0x58, 0x2d, 0x43, 0x6f, 0x72, 0x72, 0x65,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2d, 0x49, 0x64, 0x12, 0x2b, 0x55, 0x6e, 0x69, 0x71, 0x75,
+	0x65, 0x20, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x20, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
+	0x65, 0x72, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x20, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x01, 0x22, 0x04, 0x75, 0x75, 0x69, 0x64, 0x28, 0x01,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x3a, 0x01, 0x2a, 0x22, 0x14, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x76, 0x31, 0x2f, 0x70, 0x6f, 0x69, 0x73, 0x2f, 0x64, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x79, 0x12,
+	0xc7, 0x01, 0x0a, 0x0f, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x72, 0x6f, 0x78, 0x69, 0x6d,
 	0x69, 0x74, 0x79, 0x12, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6f, 0x69, 0x2e, 0x76, 0x31,
 	0x2e, 0x50, 0x72, 0x6f, 0x78, 0x69, 0x6d, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
 	0x74, 0x1a, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6f, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50,
 	0x6f, 0x49, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x6e, 0x92, 0x41, 0x4d, 0x72, 0x4b, 0x0a, 0x49, 0x0a, 0x10, 0x58, 0x2d, 0x43, 0x6f, 0x72,
+	0x22, 0x75, 0x92, 0x41, 0x4d, 0x72, 0x4b, 0x0a, 0x49, 0x0a, 0x10, 0x58, 0x2d, 0x43, 0x6f, 0x72,
 	0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2d, 0x49, 0x64, 0x12, 0x2b, 0x55, 0x6e, 0x69,
 	0x71, 0x75, 0x65, 0x20, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x20, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69,
 	0x66, 0x69, 0x65, 0x72, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x20,
 	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x01, 0x22, 0x04, 0x75, 0x75, 0x69, 0x64,
-	0x28, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x12, 0x16, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
+	0x28, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x12, 0x1d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
 	0x31, 0x2f, 0x70, 0x6f, 0x69, 0x73, 0x2f, 0x70, 0x72, 0x6f, 0x78, 0x69, 0x6d, 0x69, 0x74, 0x79,
-	0x12, 0xa9, 0x01, 0x0a, 0x04, 0x42, 0x42, 0x6f, 0x78, 0x12, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x70, 0x6f, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x42, 0x6f, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6f, 0x69, 0x2e, 0x76, 0x31, 0x2e,
-	0x50, 0x6f, 0x49, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x69, 0x92, 0x41, 0x4d, 0x72, 0x4b, 0x0a, 0x49, 0x0a, 0x10, 0x58, 0x2d, 0x43, 0x6f,
-	0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2d, 0x49, 0x64, 0x12, 0x2b, 0x55, 0x6e,
-	0x69, 0x71, 0x75, 0x65, 0x20, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x20, 0x69, 0x64, 0x65, 0x6e, 0x74,
-	0x69, 0x66, 0x69, 0x65, 0x72, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x01, 0x22, 0x04, 0x75, 0x75, 0x69,
-	0x64, 0x28, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x12, 0x11, 0x2f, 0x61, 0x70, 0x69, 0x2f,
-	0x76, 0x31, 0x2f, 0x70, 0x6f, 0x69, 0x73, 0x2f, 0x62, 0x62, 0x6f, 0x78, 0x12, 0xb3, 0x01, 0x0a,
-	0x05, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6f, 0x69,
-	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6f, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f,
-	0x49, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x71, 0x92, 0x41, 0x4d, 0x72, 0x4b, 0x0a, 0x49, 0x0a, 0x10, 0x58, 0x2d, 0x43, 0x6f, 0x72, 0x72,
-	0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2d, 0x49, 0x64, 0x12, 0x2b, 0x55, 0x6e, 0x69, 0x71,
-	0x75, 0x65, 0x20, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x20, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
-	0x69, 0x65, 0x72, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x20, 0x72,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x01, 0x22, 0x04, 0x75, 0x75, 0x69, 0x64, 0x28,
-	0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x3a, 0x05, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x22, 0x12,
-	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x69, 0x73, 0x2f, 0x72, 0x6f, 0x75,
-	0x74, 0x65, 0x42, 0xa0, 0x09, 0x92, 0x41, 0xe5, 0x07, 0x12, 0xa3, 0x03, 0x0a, 0x2c, 0x67, 0x52,
-	0x50, 0x43, 0x2d, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x20, 0x50, 0x6f, 0x49, 0x20, 0x49,
-	0x6e, 0x66, 0x6f, 0x20, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x20, 0x41, 0x50, 0x49, 0x20,
-	0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x9d, 0x01, 0x53, 0x77, 0x61,
-	0x67, 0x67, 0x65, 0x72, 0x20, 0x41, 0x50, 0x49, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20,
-	0x67, 0x52, 0x50, 0x43, 0x2d, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x20, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x50, 0x6f, 0x49, 0x20, 0x73, 0x65, 0x61,
-	0x72, 0x63, 0x68, 0x20, 0x28, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2c, 0x20, 0x70, 0x72, 0x6f, 0x78,
-	0x69, 0x6d, 0x69, 0x74, 0x79, 0x29, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x69, 0x6e, 0x66, 0x6f, 0x2e,
-	0x20, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x20, 0x52, 0x45, 0x53, 0x54,
-	0x20, 0x28, 0x48, 0x54, 0x54, 0x50, 0x2f, 0x4a, 0x53, 0x4f, 0x4e, 0x29, 0x20, 0x74, 0x6f, 0x20,
-	0x67, 0x52, 0x50, 0x43, 0x20, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x20, 0x63, 0x6f,
-	0x6e, 0x73, 0x75, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x62, 0x79, 0x20, 0x52, 0x45, 0x53,
-	0x54, 0x20, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x22, 0x5f, 0x0a, 0x16, 0x67, 0x52,
-	0x50, 0x43, 0x20, 0x47, 0x6f, 0x20, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x20, 0x50, 0x72, 0x6f,
-	0x6a, 0x65, 0x63, 0x74, 0x12, 0x45, 0x68, 0x74, 0x74, 0x70, 0x73, 0x3a, 0x2f, 0x2f, 0x67, 0x69,
-	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67, 0x72, 0x6e, 0x74, 0x6c, 0x72, 0x64,
-	0x75, 0x63, 0x6b, 0x2d, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2f, 0x67, 0x6f, 0x2d, 0x67, 0x72, 0x70,
-	0x63, 0x2d, 0x67, 0x65, 0x6f, 0x68, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x2d, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x2d, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2a, 0x6d, 0x0a, 0x12, 0x41,
-	0x70, 0x61, 0x63, 0x68, 0x65, 0x2d, 0x32, 0x2e, 0x30, 0x20, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73,
-	0x65, 0x12, 0x57, 0x68, 0x74, 0x74, 0x70, 0x73, 0x3a, 0x2f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67, 0x72, 0x6e, 0x74, 0x6c, 0x72, 0x64, 0x75, 0x63, 0x6b,
-	0x2d, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2f, 0x67, 0x6f, 0x2d, 0x67, 0x72, 0x70, 0x63, 0x2d, 0x67,
-	0x65, 0x6f, 0x68, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x2d, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2f, 0x62, 0x6c, 0x6f, 0x62, 0x2f, 0x6d, 0x61,
-	0x69, 0x6e, 0x2f, 0x4c, 0x49, 0x43, 0x45, 0x4e, 0x53, 0x45, 0x32, 0x03, 0x31, 0x2e, 0x30, 0x2a,
-	0x01, 0x02, 0x32, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f,
-	0x6a, 0x73, 0x6f, 0x6e, 0x32, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x3a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x52, 0x3e, 0x0a, 0x03, 0x34, 0x30,
-	0x31, 0x12, 0x37, 0x0a, 0x33, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x20, 0x77, 0x68,
-	0x65, 0x6e, 0x20, 0x75, 0x73, 0x65, 0x72, 0x20, 0x69, 0x73, 0x20, 0x66, 0x6f, 0x72, 0x62, 0x69,
-	0x64, 0x64, 0x65, 0x6e, 0x20, 0x74, 0x6f, 0x20, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x20, 0x72,
-	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x12, 0x00, 0x52, 0x52, 0x0a, 0x03, 0x34, 0x30,
-	0x33, 0x12, 0x4b, 0x0a, 0x47, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x20, 0x77, 0x68,
-	0x65, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x75, 0x73, 0x65, 0x72, 0x20, 0x64, 0x6f, 0x65, 0x73,
-	0x20, 0x6e, 0x6f, 0x74, 0x20, 0x68, 0x61, 0x76, 0x65, 0x20, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x20, 0x74, 0x6f, 0x20, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x20, 0x74,
-	0x68, 0x65, 0x20, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x12, 0x00, 0x52, 0x35,
-	0x0a, 0x03, 0x34, 0x30, 0x34, 0x12, 0x2e, 0x0a, 0x2a, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65,
-	0x64, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x72, 0x65, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x20, 0x64, 0x6f, 0x65, 0x73, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x65, 0x78, 0x69,
-	0x73, 0x74, 0x2e, 0x12, 0x00, 0x52, 0xf3, 0x01, 0x0a, 0x03, 0x35, 0x30, 0x30, 0x12, 0xeb, 0x01,
-	0x0a, 0x0c, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x19,
-	0x0a, 0x17, 0x1a, 0x15, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x72, 0x72, 0x6f,
-	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x1a, 0xbf, 0x01, 0x0a, 0x10, 0x58, 0x2d,
-	0x43, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2d, 0x49, 0x64, 0x12, 0xaa,
-	0x01, 0x0a, 0x2b, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x20, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x20,
-	0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x73,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x06,
-	0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x1a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x32, 0x26, 0x22, 0x32,
-	0x34, 0x33, 0x38, 0x61, 0x63, 0x33, 0x63, 0x2d, 0x33, 0x37, 0x65, 0x62, 0x2d, 0x34, 0x39, 0x30,
-	0x32, 0x2d, 0x61, 0x64, 0x65, 0x66, 0x2d, 0x65, 0x64, 0x31, 0x36, 0x62, 0x34, 0x34, 0x33, 0x31,
-	0x30, 0x33, 0x30, 0x22, 0x6a, 0x45, 0x5e, 0x5b, 0x30, 0x2d, 0x39, 0x41, 0x2d, 0x46, 0x5d, 0x7b,
-	0x38, 0x7d, 0x2d, 0x5b, 0x30, 0x2d, 0x39, 0x41, 0x2d, 0x46, 0x5d, 0x7b, 0x34, 0x7d, 0x2d, 0x34,
-	0x5b, 0x30, 0x2d, 0x39, 0x41, 0x2d, 0x46, 0x5d, 0x7b, 0x33, 0x7d, 0x2d, 0x5b, 0x38, 0x39, 0x41,
-	0x42, 0x5d, 0x5b, 0x30, 0x2d, 0x39, 0x41, 0x2d, 0x46, 0x5d, 0x7b, 0x33, 0x7d, 0x2d, 0x5b, 0x30,
-	0x2d, 0x39, 0x41, 0x2d, 0x46, 0x5d, 0x7b, 0x31, 0x32, 0x7d, 0x24, 0x5a, 0x1f, 0x0a, 0x1d, 0x0a,
-	0x0a, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x41, 0x75, 0x74, 0x68, 0x12, 0x0f, 0x08, 0x02, 0x1a,
-	0x09, 0x58, 0x2d, 0x41, 0x70, 0x69, 0x2d, 0x4b, 0x65, 0x79, 0x20, 0x02, 0x62, 0x10, 0x0a, 0x0e,
-	0x0a, 0x0a, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x41, 0x75, 0x74, 0x68, 0x12, 0x00, 0x0a, 0x0e,
-	0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6f, 0x69, 0x2e, 0x76, 0x31, 0x42, 0x08,
-	0x50, 0x6f, 0x69, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x51, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67, 0x72, 0x6e, 0x74, 0x6c, 0x72, 0x64, 0x75, 0x63,
-	0x6b, 0x2d, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2f, 0x67, 0x6f, 0x2d, 0x67, 0x72, 0x70, 0x63, 0x2d,
-	0x67, 0x65, 0x6f, 0x68, 0x61, 0x73, 0x69, 0x6e, 0x67, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x2d, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x67, 0x65, 0x6e,
-	0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x69, 0x3b, 0x70, 0x6f, 0x69, 0x76, 0x31, 0xa2, 0x02, 0x03,
-	0x41, 0x50, 0x58, 0xaa, 0x02, 0x0a, 0x41, 0x70, 0x69, 0x2e, 0x50, 0x6f, 0x69, 0x2e, 0x56, 0x31,
-	0xca, 0x02, 0x0a, 0x41, 0x70, 0x69, 0x5c, 0x50, 0x6f, 0x69, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x16,
-	0x41, 0x70, 0x69, 0x5c, 0x50, 0x6f, 0x69, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0c, 0x41, 0x70, 0x69, 0x3a, 0x3a, 0x50, 0x6f,
-	0x69, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x2f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x30, 0x01, 0x12, 0xb8, 0x01, 0x0a, 0x0a, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x42, 0x42, 0x6f, 0x78, 0x12, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70,
+	0x6f, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x42, 0x6f, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6f, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x6f, 0x49, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x70, 0x92, 0x41, 0x4d, 0x72, 0x4b, 0x0a, 0x49, 0x0a, 0x10, 0x58, 0x2d, 0x43, 0x6f, 0x72,
+	0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2d, 0x49, 0x64, 0x12, 0x2b, 0x55, 0x6e, 0x69,
+	0x71, 0x75, 0x65, 0x20, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x20, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x66, 0x69, 0x65, 0x72, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x20,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x01, 0x22, 0x04, 0x75, 0x75, 0x69, 0x64,
+	0x28, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x12, 0x18, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
+	0x31, 0x2f, 0x70, 0x6f, 0x69, 0x73, 0x2f, 0x62, 0x62, 0x6f, 0x78, 0x2f, 0x73, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x30, 0x01, 0x12, 0xc2, 0x01, 0x0a, 0x0b, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52,
+	0x6f, 0x75, 0x74, 0x65, 0x12, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6f, 0x69, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6f, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x49, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x78, 0x92,
+	0x41, 0x4d, 0x72, 0x4b, 0x0a, 0x49, 0x0a, 0x10, 0x58, 0x2d, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2d, 0x49, 0x64, 0x12, 0x2b, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65,
+	0x20, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x20, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65,
+	0x72, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x20, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x01, 0x22, 0x04, 0x75, 0x75, 0x69, 0x64, 0x28, 0x01, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x22, 0x3a, 0x05, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x22, 0x19, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x69, 0x73, 0x2f, 0x72, 0x6f, 0x75, 0x74, 0x65,
+	0x2f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x30, 0x01, 0x42, 0xa0, 0x09, 0x92, 0x41, 0xe5, 0x07,
+	0x12, 0xa3, 0x03, 0x0a, 0x2c, 0x67, 0x52, 0x50, 0x43, 0x2d, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61,
+	0x79, 0x20, 0x50, 0x6f, 0x49, 0x20, 0x49, 0x6e, 0x66, 0x6f, 0x20, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x20, 0x41, 0x50, 0x49, 0x20, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x9d, 0x01, 0x53, 0x77, 0x61, 0x67, 0x67, 0x65, 0x72, 0x20, 0x41, 0x50, 0x49, 0x20,
+	0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x67, 0x52, 0x50, 0x43, 0x2d, 0x47, 0x61, 0x74, 0x65,
+	0x77, 0x61, 0x79, 0x20, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x20, 0x66, 0x6f, 0x72, 0x20,
+	0x50, 0x6f, 0x49, 0x20, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x20, 0x28, 0x72, 0x6f, 0x75, 0x74,
+	0x65, 0x2c, 0x20, 0x70, 0x72, 0x6f, 0x78, 0x69, 0x6d, 0x69, 0x74, 0x79, 0x29, 0x20, 0x61, 0x6e,
+	0x64, 0x20, 0x69, 0x6e, 0x66, 0x6f, 0x2e, 0x20, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x63, 0x6f, 0x64,
+	0x65, 0x73, 0x20, 0x52, 0x45, 0x53, 0x54, 0x20, 0x28, 0x48, 0x54, 0x54, 0x50, 0x2f, 0x4a, 0x53,
+	0x4f, 0x4e, 0x29, 0x20, 0x74, 0x6f, 0x20, 0x67, 0x52, 0x50, 0x43, 0x20, 0x61, 0x6c, 0x6c, 0x6f,
+	0x77, 0x69, 0x6e, 0x67, 0x20, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x20, 0x62, 0x79, 0x20, 0x52, 0x45, 0x53, 0x54, 0x20, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73,
+	0x2e, 0x22, 0x5f, 0x0a, 0x16, 0x67, 0x52, 0x50, 0x43, 0x20, 0x47, 0x6f, 0x20, 0x53, 0x61, 0x6d,
+	0x70, 0x6c, 0x65, 0x20, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x45, 0x68, 0x74, 0x74,
+	0x70, 0x73, 0x3a, 0x2f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x67, 0x72, 0x6e, 0x74, 0x6c, 0x72, 0x64, 0x75, 0x63, 0x6b, 0x2d, 0x63, 0x6c, 0x6f, 0x75, 0x64,
+	0x2f, 0x67, 0x6f, 0x2d, 0x67, 0x72, 0x70, 0x63, 0x2d, 0x67, 0x65, 0x6f, 0x68, 0x61, 0x73, 0x68,
+	0x69, 0x6e, 0x67, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2d, 0x73, 0x61, 0x6d, 0x70,
+	0x6c, 0x65, 0x2a, 0x6d, 0x0a, 0x12, 0x41, 0x70, 0x61, 0x63, 0x68, 0x65, 0x2d, 0x32, 0x2e, 0x30,
+	0x20, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x12, 0x57, 0x68, 0x74, 0x74, 0x70, 0x73, 0x3a,
+	0x2f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67, 0x72, 0x6e,
+	0x74, 0x6c, 0x72, 0x64, 0x75, 0x63, 0x6b, 0x2d, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2f, 0x67, 0x6f,
+	0x2d, 0x67, 0x72, 0x70, 0x63, 0x2d, 0x67, 0x65, 0x6f, 0x68, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67,
+	0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2d, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2f,
+	0x62, 0x6c, 0x6f, 0x62, 0x2f, 0x6d, 0x61, 0x69, 0x6e, 0x2f, 0x4c, 0x49, 0x43, 0x45, 0x4e, 0x53,
+	0x45, 0x32, 0x03, 0x31, 0x2e, 0x30, 0x2a, 0x01, 0x02, 0x32, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x32, 0x10, 0x61, 0x70, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x3a, 0x10, 0x61,
+	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x3a,
+	0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x67, 0x72, 0x70,
+	0x63, 0x52, 0x3e, 0x0a, 0x03, 0x34, 0x30, 0x31, 0x12, 0x37, 0x0a, 0x33, 0x52, 0x65, 0x74, 0x75,
+	0x72, 0x6e, 0x65, 0x64, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x75, 0x73, 0x65, 0x72, 0x20, 0x69,
+	0x73, 0x20, 0x66, 0x6f, 0x72, 0x62, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x20, 0x74, 0x6f, 0x20, 0x61,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x20, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x12,
+	0x00, 0x52, 0x52, 0x0a, 0x03, 0x34, 0x30, 0x33, 0x12, 0x4b, 0x0a, 0x47, 0x52, 0x65, 0x74, 0x75,
+	0x72, 0x6e, 0x65, 0x64, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x75, 0x73,
+	0x65, 0x72, 0x20, 0x64, 0x6f, 0x65, 0x73, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x68, 0x61, 0x76, 0x65,
+	0x20, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x20, 0x74, 0x6f, 0x20, 0x61,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x2e, 0x12, 0x00, 0x52, 0x35, 0x0a, 0x03, 0x34, 0x30, 0x34, 0x12, 0x2e, 0x0a, 0x2a,
+	0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x74, 0x68,
+	0x65, 0x20, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x20, 0x64, 0x6f, 0x65, 0x73, 0x20,
+	0x6e, 0x6f, 0x74, 0x20, 0x65, 0x78, 0x69, 0x73, 0x74, 0x2e, 0x12, 0x00, 0x52, 0xf3, 0x01, 0x0a,
+	0x03, 0x35, 0x30, 0x30, 0x12, 0xeb, 0x01, 0x0a, 0x0c, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x20,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x19, 0x0a, 0x17, 0x1a, 0x15, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x1a, 0xbf, 0x01, 0x0a, 0x10, 0x58, 0x2d, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2d, 0x49, 0x64, 0x12, 0xaa, 0x01, 0x0a, 0x2b, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65,
+	0x20, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x20, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65,
+	0x72, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x20, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x06, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x1a, 0x04, 0x75,
+	0x75, 0x69, 0x64, 0x32, 0x26, 0x22, 0x32, 0x34, 0x33, 0x38, 0x61, 0x63, 0x33, 0x63, 0x2d, 0x33,
+	0x37, 0x65, 0x62, 0x2d, 0x34, 0x39, 0x30, 0x32, 0x2d, 0x61, 0x64, 0x65, 0x66, 0x2d, 0x65, 0x64,
+	0x31, 0x36, 0x62, 0x34, 0x34, 0x33, 0x31, 0x30, 0x33, 0x30, 0x22, 0x6a, 0x45, 0x5e, 0x5b, 0x30,
+	0x2d, 0x39, 0x41, 0x2d, 0x46, 0x5d, 0x7b, 0x38, 0x7d, 0x2d, 0x5b, 0x30, 0x2d, 0x39, 0x41, 0x2d,
+	0x46, 0x5d, 0x7b, 0x34, 0x7d, 0x2d, 0x34, 0x5b, 0x30, 0x2d, 0x39, 0x41, 0x2d, 0x46, 0x5d, 0x7b,
+	0x33, 0x7d, 0x2d, 0x5b, 0x38, 0x39, 0x41, 0x42, 0x5d, 0x5b, 0x30, 0x2d, 0x39, 0x41, 0x2d, 0x46,
+	0x5d, 0x7b, 0x33, 0x7d, 0x2d, 0x5b, 0x30, 0x2d, 0x39, 0x41, 0x2d, 0x46, 0x5d, 0x7b, 0x31, 0x32,
+	0x7d, 0x24, 0x5a, 0x1f, 0x0a, 0x1d, 0x0a, 0x0a, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x41, 0x75,
+	0x74, 0x68, 0x12, 0x0f, 0x08, 0x02, 0x1a, 0x09, 0x58, 0x2d, 0x41, 0x70, 0x69, 0x2d, 0x4b, 0x65,
+	0x79, 0x20, 0x02, 0x62, 0x10, 0x0a, 0x0e, 0x0a, 0x0a, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x41,
+	0x75, 0x74, 0x68, 0x12, 0x00, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70,
+	0x6f, 0x69, 0x2e, 0x76, 0x31, 0x42, 0x08, 0x50, 0x6f, 0x69, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50,
+	0x01, 0x5a, 0x51, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67, 0x72,
+	0x6e, 0x74, 0x6c, 0x72, 0x64, 0x75, 0x63, 0x6b, 0x2d, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2f, 0x67,
+	0x6f, 0x2d, 0x67, 0x72, 0x70, 0x63, 0x2d, 0x67, 0x65, 0x6f, 0x68, 0x61, 0x73, 0x69, 0x6e, 0x67,
+	0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2d, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x69, 0x3b, 0x70,
+	0x6f, 0x69, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x41, 0x50, 0x58, 0xaa, 0x02, 0x0a, 0x41, 0x70, 0x69,
+	0x2e, 0x50, 0x6f, 0x69, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0a, 0x41, 0x70, 0x69, 0x5c, 0x50, 0x6f,
+	0x69, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x16, 0x41, 0x70, 0x69, 0x5c, 0x50, 0x6f, 0x69, 0x5c, 0x56,
+	0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0c,
+	0x41, 0x70, 0x69, 0x3a, 0x3a, 0x50, 0x6f, 0x69, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -995,45 +3758,127 @@ func file_v1_poi_poi_proto_rawDescGZIP() []byte {
 	return file_v1_poi_poi_proto_rawDescData
 }
 
-var file_v1_poi_poi_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_v1_poi_poi_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_v1_poi_poi_proto_msgTypes = make([]protoimpl.MessageInfo, 33)
 var file_v1_poi_poi_proto_goTypes = []any{
-	(*PoI)(nil),               // 0: api.poi.v1.PoI
-	(*Coordinate)(nil),        // 1: api.poi.v1.Coordinate
-	(*Address)(nil),           // 2: api.poi.v1.Address
-	(*BBox)(nil),              // 3: api.poi.v1.BBox
-	(*PoIRequest)(nil),        // 4: api.poi.v1.PoIRequest
-	(*PoIResponse)(nil),       // 5: api.poi.v1.PoIResponse
-	(*ProximityRequest)(nil),  // 6: api.poi.v1.ProximityRequest
-	(*BBoxRequest)(nil),       // 7: api.poi.v1.BBoxRequest
-	(*RouteRequest)(nil),      // 8: api.poi.v1.RouteRequest
-	(*PoISearchResponse)(nil), // 9: api.poi.v1.PoISearchResponse
-	(*ErrorResponse)(nil),     // 10: api.poi.v1.ErrorResponse
-	(*ErrorObject)(nil),       // 11: api.poi.v1.ErrorObject
+	(SortBy)(0),                       // 0: api.poi.v1.SortBy
+	(ConnectorType)(0),                // 1: api.poi.v1.ConnectorType
+	(*PoI)(nil),                       // 2: api.poi.v1.PoI
+	(*ChargingInfo)(nil),              // 3: api.poi.v1.ChargingInfo
+	(*Connector)(nil),                 // 4: api.poi.v1.Connector
+	(*Coordinate)(nil),                // 5: api.poi.v1.Coordinate
+	(*Address)(nil),                   // 6: api.poi.v1.Address
+	(*BBox)(nil),                      // 7: api.poi.v1.BBox
+	(*PoIRequest)(nil),                // 8: api.poi.v1.PoIRequest
+	(*PoIResponse)(nil),               // 9: api.poi.v1.PoIResponse
+	(*BatchGetPoIsRequest)(nil),       // 10: api.poi.v1.BatchGetPoIsRequest
+	(*BatchGetPoIsResponse)(nil),      // 11: api.poi.v1.BatchGetPoIsResponse
+	(*CreatePoIRequest)(nil),          // 12: api.poi.v1.CreatePoIRequest
+	(*UpdatePoIRequest)(nil),          // 13: api.poi.v1.UpdatePoIRequest
+	(*DeletePoIRequest)(nil),          // 14: api.poi.v1.DeletePoIRequest
+	(*DeletePoIResponse)(nil),         // 15: api.poi.v1.DeletePoIResponse
+	(*SearchFilter)(nil),              // 16: api.poi.v1.SearchFilter
+	(*ProximityRequest)(nil),          // 17: api.poi.v1.ProximityRequest
+	(*BBoxRequest)(nil),               // 18: api.poi.v1.BBoxRequest
+	(*RouteRequest)(nil),              // 19: api.poi.v1.RouteRequest
+	(*Ring)(nil),                      // 20: api.poi.v1.Ring
+	(*PolygonRequest)(nil),            // 21: api.poi.v1.PolygonRequest
+	(*NearestRequest)(nil),            // 22: api.poi.v1.NearestRequest
+	(*PlanChargingStopsRequest)(nil),  // 23: api.poi.v1.PlanChargingStopsRequest
+	(*ChargingStop)(nil),              // 24: api.poi.v1.ChargingStop
+	(*PlanChargingStopsResponse)(nil), // 25: api.poi.v1.PlanChargingStopsResponse
+	(*ClustersRequest)(nil),           // 26: api.poi.v1.ClustersRequest
+	(*Cluster)(nil),                   // 27: api.poi.v1.Cluster
+	(*ClustersResponse)(nil),          // 28: api.poi.v1.ClustersResponse
+	(*DensityRequest)(nil),            // 29: api.poi.v1.DensityRequest
+	(*DensityCell)(nil),               // 30: api.poi.v1.DensityCell
+	(*DensityResponse)(nil),           // 31: api.poi.v1.DensityResponse
+	(*PoISearchResponse)(nil),         // 32: api.poi.v1.PoISearchResponse
+	(*ErrorResponse)(nil),             // 33: api.poi.v1.ErrorResponse
+	(*ErrorObject)(nil),               // 34: api.poi.v1.ErrorObject
+	(*fieldmaskpb.FieldMask)(nil),     // 35: google.protobuf.FieldMask
 }
 var file_v1_poi_poi_proto_depIdxs = []int32{
-	1,  // 0: api.poi.v1.PoI.coordinate:type_name -> api.poi.v1.Coordinate
-	1,  // 1: api.poi.v1.PoI.entrance:type_name -> api.poi.v1.Coordinate
-	2,  // 2: api.poi.v1.PoI.address:type_name -> api.poi.v1.Address
-	1,  // 3: api.poi.v1.BBox.sw:type_name -> api.poi.v1.Coordinate
-	1,  // 4: api.poi.v1.BBox.ne:type_name -> api.poi.v1.Coordinate
-	0,  // 5: api.poi.v1.PoIResponse.poi:type_name -> api.poi.v1.PoI
-	1,  // 6: api.poi.v1.ProximityRequest.center:type_name -> api.poi.v1.Coordinate
-	3,  // 7: api.poi.v1.BBoxRequest.bbox:type_name -> api.poi.v1.BBox
-	1,  // 8: api.poi.v1.RouteRequest.route:type_name -> api.poi.v1.Coordinate
-	0,  // 9: api.poi.v1.PoISearchResponse.items:type_name -> api.poi.v1.PoI
-	4,  // 10: api.poi.v1.PoIService.PoI:input_type -> api.poi.v1.PoIRequest
-	6,  // 11: api.poi.v1.PoIService.Proximity:input_type -> api.poi.v1.ProximityRequest
-	7,  // 12: api.poi.v1.PoIService.BBox:input_type -> api.poi.v1.BBoxRequest
-	8,  // 13: api.poi.v1.PoIService.Route:input_type -> api.poi.v1.RouteRequest
-	5,  // 14: api.poi.v1.PoIService.PoI:output_type -> api.poi.v1.PoIResponse
-	9,  // 15: api.poi.v1.PoIService.Proximity:output_type -> api.poi.v1.PoISearchResponse
-	9,  // 16: api.poi.v1.PoIService.BBox:output_type -> api.poi.v1.PoISearchResponse
-	9,  // 17: api.poi.v1.PoIService.Route:output_type -> api.poi.v1.PoISearchResponse
-	14, // [14:18] is the sub-list for method output_type
-	10, // [10:14] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	5,  // 0: api.poi.v1.PoI.coordinate:type_name -> api.poi.v1.Coordinate
+	5,  // 1: api.poi.v1.PoI.entrance:type_name -> api.poi.v1.Coordinate
+	6,  // 2: api.poi.v1.PoI.address:type_name -> api.poi.v1.Address
+	3,  // 3: api.poi.v1.PoI.charging:type_name -> api.poi.v1.ChargingInfo
+	4,  // 4: api.poi.v1.ChargingInfo.connectors:type_name -> api.poi.v1.Connector
+	1,  // 5: api.poi.v1.Connector.current_type:type_name -> api.poi.v1.ConnectorType
+	5,  // 6: api.poi.v1.BBox.sw:type_name -> api.poi.v1.Coordinate
+	5,  // 7: api.poi.v1.BBox.ne:type_name -> api.poi.v1.Coordinate
+	2,  // 8: api.poi.v1.PoIResponse.poi:type_name -> api.poi.v1.PoI
+	2,  // 9: api.poi.v1.BatchGetPoIsResponse.items:type_name -> api.poi.v1.PoI
+	2,  // 10: api.poi.v1.CreatePoIRequest.poi:type_name -> api.poi.v1.PoI
+	2,  // 11: api.poi.v1.UpdatePoIRequest.poi:type_name -> api.poi.v1.PoI
+	35, // 12: api.poi.v1.UpdatePoIRequest.update_mask:type_name -> google.protobuf.FieldMask
+	1,  // 13: api.poi.v1.SearchFilter.connector_types:type_name -> api.poi.v1.ConnectorType
+	5,  // 14: api.poi.v1.ProximityRequest.center:type_name -> api.poi.v1.Coordinate
+	0,  // 15: api.poi.v1.ProximityRequest.sort_by:type_name -> api.poi.v1.SortBy
+	16, // 16: api.poi.v1.ProximityRequest.filter:type_name -> api.poi.v1.SearchFilter
+	7,  // 17: api.poi.v1.BBoxRequest.bbox:type_name -> api.poi.v1.BBox
+	0,  // 18: api.poi.v1.BBoxRequest.sort_by:type_name -> api.poi.v1.SortBy
+	16, // 19: api.poi.v1.BBoxRequest.filter:type_name -> api.poi.v1.SearchFilter
+	5,  // 20: api.poi.v1.RouteRequest.route:type_name -> api.poi.v1.Coordinate
+	0,  // 21: api.poi.v1.RouteRequest.sort_by:type_name -> api.poi.v1.SortBy
+	16, // 22: api.poi.v1.RouteRequest.filter:type_name -> api.poi.v1.SearchFilter
+	5,  // 23: api.poi.v1.Ring.coordinates:type_name -> api.poi.v1.Coordinate
+	20, // 24: api.poi.v1.PolygonRequest.rings:type_name -> api.poi.v1.Ring
+	0,  // 25: api.poi.v1.PolygonRequest.sort_by:type_name -> api.poi.v1.SortBy
+	16, // 26: api.poi.v1.PolygonRequest.filter:type_name -> api.poi.v1.SearchFilter
+	5,  // 27: api.poi.v1.NearestRequest.center:type_name -> api.poi.v1.Coordinate
+	0,  // 28: api.poi.v1.NearestRequest.sort_by:type_name -> api.poi.v1.SortBy
+	16, // 29: api.poi.v1.NearestRequest.filter:type_name -> api.poi.v1.SearchFilter
+	5,  // 30: api.poi.v1.PlanChargingStopsRequest.route:type_name -> api.poi.v1.Coordinate
+	1,  // 31: api.poi.v1.PlanChargingStopsRequest.connector:type_name -> api.poi.v1.ConnectorType
+	2,  // 32: api.poi.v1.ChargingStop.station:type_name -> api.poi.v1.PoI
+	2,  // 33: api.poi.v1.ChargingStop.alternates:type_name -> api.poi.v1.PoI
+	24, // 34: api.poi.v1.PlanChargingStopsResponse.stops:type_name -> api.poi.v1.ChargingStop
+	7,  // 35: api.poi.v1.ClustersRequest.bbox:type_name -> api.poi.v1.BBox
+	5,  // 36: api.poi.v1.Cluster.centroid:type_name -> api.poi.v1.Coordinate
+	27, // 37: api.poi.v1.ClustersResponse.clusters:type_name -> api.poi.v1.Cluster
+	7,  // 38: api.poi.v1.DensityRequest.bbox:type_name -> api.poi.v1.BBox
+	20, // 39: api.poi.v1.DensityRequest.rings:type_name -> api.poi.v1.Ring
+	5,  // 40: api.poi.v1.DensityCell.center:type_name -> api.poi.v1.Coordinate
+	30, // 41: api.poi.v1.DensityResponse.cells:type_name -> api.poi.v1.DensityCell
+	2,  // 42: api.poi.v1.PoISearchResponse.items:type_name -> api.poi.v1.PoI
+	8,  // 43: api.poi.v1.PoIService.PoI:input_type -> api.poi.v1.PoIRequest
+	10, // 44: api.poi.v1.PoIService.BatchGetPoIs:input_type -> api.poi.v1.BatchGetPoIsRequest
+	12, // 45: api.poi.v1.PoIService.CreatePoI:input_type -> api.poi.v1.CreatePoIRequest
+	13, // 46: api.poi.v1.PoIService.UpdatePoI:input_type -> api.poi.v1.UpdatePoIRequest
+	14, // 47: api.poi.v1.PoIService.DeletePoI:input_type -> api.poi.v1.DeletePoIRequest
+	17, // 48: api.poi.v1.PoIService.Proximity:input_type -> api.poi.v1.ProximityRequest
+	18, // 49: api.poi.v1.PoIService.BBox:input_type -> api.poi.v1.BBoxRequest
+	19, // 50: api.poi.v1.PoIService.Route:input_type -> api.poi.v1.RouteRequest
+	22, // 51: api.poi.v1.PoIService.Nearest:input_type -> api.poi.v1.NearestRequest
+	21, // 52: api.poi.v1.PoIService.Polygon:input_type -> api.poi.v1.PolygonRequest
+	23, // 53: api.poi.v1.PoIService.PlanChargingStops:input_type -> api.poi.v1.PlanChargingStopsRequest
+	26, // 54: api.poi.v1.PoIService.Clusters:input_type -> api.poi.v1.ClustersRequest
+	29, // 55: api.poi.v1.PoIService.Density:input_type -> api.poi.v1.DensityRequest
+	17, // 56: api.poi.v1.PoIService.StreamProximity:input_type -> api.poi.v1.ProximityRequest
+	18, // 57: api.poi.v1.PoIService.StreamBBox:input_type -> api.poi.v1.BBoxRequest
+	19, // 58: api.poi.v1.PoIService.StreamRoute:input_type -> api.poi.v1.RouteRequest
+	9,  // 59: api.poi.v1.PoIService.PoI:output_type -> api.poi.v1.PoIResponse
+	11, // 60: api.poi.v1.PoIService.BatchGetPoIs:output_type -> api.poi.v1.BatchGetPoIsResponse
+	9,  // 61: api.poi.v1.PoIService.CreatePoI:output_type -> api.poi.v1.PoIResponse
+	9,  // 62: api.poi.v1.PoIService.UpdatePoI:output_type -> api.poi.v1.PoIResponse
+	15, // 63: api.poi.v1.PoIService.DeletePoI:output_type -> api.poi.v1.DeletePoIResponse
+	32, // 64: api.poi.v1.PoIService.Proximity:output_type -> api.poi.v1.PoISearchResponse
+	32, // 65: api.poi.v1.PoIService.BBox:output_type -> api.poi.v1.PoISearchResponse
+	32, // 66: api.poi.v1.PoIService.Route:output_type -> api.poi.v1.PoISearchResponse
+	32, // 67: api.poi.v1.PoIService.Nearest:output_type -> api.poi.v1.PoISearchResponse
+	32, // 68: api.poi.v1.PoIService.Polygon:output_type -> api.poi.v1.PoISearchResponse
+	25, // 69: api.poi.v1.PoIService.PlanChargingStops:output_type -> api.poi.v1.PlanChargingStopsResponse
+	28, // 70: api.poi.v1.PoIService.Clusters:output_type -> api.poi.v1.ClustersResponse
+	31, // 71: api.poi.v1.PoIService.Density:output_type -> api.poi.v1.DensityResponse
+	32, // 72: api.poi.v1.PoIService.StreamProximity:output_type -> api.poi.v1.PoISearchResponse
+	32, // 73: api.poi.v1.PoIService.StreamBBox:output_type -> api.poi.v1.PoISearchResponse
+	32, // 74: api.poi.v1.PoIService.StreamRoute:output_type -> api.poi.v1.PoISearchResponse
+	59, // [59:75] is the sub-list for method output_type
+	43, // [43:59] is the sub-list for method input_type
+	43, // [43:43] is the sub-list for extension type_name
+	43, // [43:43] is the sub-list for extension extendee
+	0,  // [0:43] is the sub-list for field type_name
 }
 
 func init() { file_v1_poi_poi_proto_init() }
@@ -1055,7 +3900,7 @@ func file_v1_poi_poi_proto_init() {
 			}
 		}
 		file_v1_poi_poi_proto_msgTypes[1].Exporter = func(v any, i int) any {
-			switch v := v.(*Coordinate); i {
+			switch v := v.(*ChargingInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1067,7 +3912,7 @@ func file_v1_poi_poi_proto_init() {
 			}
 		}
 		file_v1_poi_poi_proto_msgTypes[2].Exporter = func(v any, i int) any {
-			switch v := v.(*Address); i {
+			switch v := v.(*Connector); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1079,7 +3924,7 @@ func file_v1_poi_poi_proto_init() {
 			}
 		}
 		file_v1_poi_poi_proto_msgTypes[3].Exporter = func(v any, i int) any {
-			switch v := v.(*BBox); i {
+			switch v := v.(*Coordinate); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1091,7 +3936,7 @@ func file_v1_poi_poi_proto_init() {
 			}
 		}
 		file_v1_poi_poi_proto_msgTypes[4].Exporter = func(v any, i int) any {
-			switch v := v.(*PoIRequest); i {
+			switch v := v.(*Address); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1103,7 +3948,7 @@ func file_v1_poi_poi_proto_init() {
 			}
 		}
 		file_v1_poi_poi_proto_msgTypes[5].Exporter = func(v any, i int) any {
-			switch v := v.(*PoIResponse); i {
+			switch v := v.(*BBox); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1115,7 +3960,7 @@ func file_v1_poi_poi_proto_init() {
 			}
 		}
 		file_v1_poi_poi_proto_msgTypes[6].Exporter = func(v any, i int) any {
-			switch v := v.(*ProximityRequest); i {
+			switch v := v.(*PoIRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1127,7 +3972,7 @@ func file_v1_poi_poi_proto_init() {
 			}
 		}
 		file_v1_poi_poi_proto_msgTypes[7].Exporter = func(v any, i int) any {
-			switch v := v.(*BBoxRequest); i {
+			switch v := v.(*PoIResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1139,7 +3984,7 @@ func file_v1_poi_poi_proto_init() {
 			}
 		}
 		file_v1_poi_poi_proto_msgTypes[8].Exporter = func(v any, i int) any {
-			switch v := v.(*RouteRequest); i {
+			switch v := v.(*BatchGetPoIsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1151,7 +3996,7 @@ func file_v1_poi_poi_proto_init() {
 			}
 		}
 		file_v1_poi_poi_proto_msgTypes[9].Exporter = func(v any, i int) any {
-			switch v := v.(*PoISearchResponse); i {
+			switch v := v.(*BatchGetPoIsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1163,7 +4008,7 @@ func file_v1_poi_poi_proto_init() {
 			}
 		}
 		file_v1_poi_poi_proto_msgTypes[10].Exporter = func(v any, i int) any {
-			switch v := v.(*ErrorResponse); i {
+			switch v := v.(*CreatePoIRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1175,6 +4020,258 @@ func file_v1_poi_poi_proto_init() {
 			}
 		}
 		file_v1_poi_poi_proto_msgTypes[11].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdatePoIRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_poi_poi_proto_msgTypes[12].Exporter = func(v any, i int) any {
+			switch v := v.(*DeletePoIRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_poi_poi_proto_msgTypes[13].Exporter = func(v any, i int) any {
+			switch v := v.(*DeletePoIResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_poi_poi_proto_msgTypes[14].Exporter = func(v any, i int) any {
+			switch v := v.(*SearchFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_poi_poi_proto_msgTypes[15].Exporter = func(v any, i int) any {
+			switch v := v.(*ProximityRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_poi_poi_proto_msgTypes[16].Exporter = func(v any, i int) any {
+			switch v := v.(*BBoxRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_poi_poi_proto_msgTypes[17].Exporter = func(v any, i int) any {
+			switch v := v.(*RouteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_poi_poi_proto_msgTypes[18].Exporter = func(v any, i int) any {
+			switch v := v.(*Ring); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_poi_poi_proto_msgTypes[19].Exporter = func(v any, i int) any {
+			switch v := v.(*PolygonRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_poi_poi_proto_msgTypes[20].Exporter = func(v any, i int) any {
+			switch v := v.(*NearestRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_poi_poi_proto_msgTypes[21].Exporter = func(v any, i int) any {
+			switch v := v.(*PlanChargingStopsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_poi_poi_proto_msgTypes[22].Exporter = func(v any, i int) any {
+			switch v := v.(*ChargingStop); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_poi_poi_proto_msgTypes[23].Exporter = func(v any, i int) any {
+			switch v := v.(*PlanChargingStopsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_poi_poi_proto_msgTypes[24].Exporter = func(v any, i int) any {
+			switch v := v.(*ClustersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_poi_poi_proto_msgTypes[25].Exporter = func(v any, i int) any {
+			switch v := v.(*Cluster); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_poi_poi_proto_msgTypes[26].Exporter = func(v any, i int) any {
+			switch v := v.(*ClustersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_poi_poi_proto_msgTypes[27].Exporter = func(v any, i int) any {
+			switch v := v.(*DensityRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_poi_poi_proto_msgTypes[28].Exporter = func(v any, i int) any {
+			switch v := v.(*DensityCell); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_poi_poi_proto_msgTypes[29].Exporter = func(v any, i int) any {
+			switch v := v.(*DensityResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_poi_poi_proto_msgTypes[30].Exporter = func(v any, i int) any {
+			switch v := v.(*PoISearchResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_poi_poi_proto_msgTypes[31].Exporter = func(v any, i int) any {
+			switch v := v.(*ErrorResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_poi_poi_proto_msgTypes[32].Exporter = func(v any, i int) any {
 			switch v := v.(*ErrorObject); i {
 			case 0:
 				return &v.state
@@ -1193,13 +4290,14 @@ func file_v1_poi_poi_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_v1_poi_poi_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   12,
+			NumEnums:      2,
+			NumMessages:   33,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_v1_poi_poi_proto_goTypes,
 		DependencyIndexes: file_v1_poi_poi_proto_depIdxs,
+		EnumInfos:         file_v1_poi_poi_proto_enumTypes,
 		MessageInfos:      file_v1_poi_poi_proto_msgTypes,
 	}.Build()
 	File_v1_poi_poi_proto = out.File
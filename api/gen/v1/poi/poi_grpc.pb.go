@@ -19,10 +19,22 @@ import (
 const _ = grpc.SupportPackageIsVersion8
 
 const (
-	PoIService_PoI_FullMethodName       = "/api.poi.v1.PoIService/PoI"
-	PoIService_Proximity_FullMethodName = "/api.poi.v1.PoIService/Proximity"
-	PoIService_BBox_FullMethodName      = "/api.poi.v1.PoIService/BBox"
-	PoIService_Route_FullMethodName     = "/api.poi.v1.PoIService/Route"
+	PoIService_PoI_FullMethodName               = "/api.poi.v1.PoIService/PoI"
+	PoIService_BatchGetPoIs_FullMethodName      = "/api.poi.v1.PoIService/BatchGetPoIs"
+	PoIService_CreatePoI_FullMethodName         = "/api.poi.v1.PoIService/CreatePoI"
+	PoIService_UpdatePoI_FullMethodName         = "/api.poi.v1.PoIService/UpdatePoI"
+	PoIService_DeletePoI_FullMethodName         = "/api.poi.v1.PoIService/DeletePoI"
+	PoIService_Proximity_FullMethodName         = "/api.poi.v1.PoIService/Proximity"
+	PoIService_BBox_FullMethodName              = "/api.poi.v1.PoIService/BBox"
+	PoIService_Route_FullMethodName             = "/api.poi.v1.PoIService/Route"
+	PoIService_Nearest_FullMethodName           = "/api.poi.v1.PoIService/Nearest"
+	PoIService_Polygon_FullMethodName           = "/api.poi.v1.PoIService/Polygon"
+	PoIService_PlanChargingStops_FullMethodName = "/api.poi.v1.PoIService/PlanChargingStops"
+	PoIService_Clusters_FullMethodName          = "/api.poi.v1.PoIService/Clusters"
+	PoIService_Density_FullMethodName           = "/api.poi.v1.PoIService/Density"
+	PoIService_StreamProximity_FullMethodName   = "/api.poi.v1.PoIService/StreamProximity"
+	PoIService_StreamBBox_FullMethodName        = "/api.poi.v1.PoIService/StreamBBox"
+	PoIService_StreamRoute_FullMethodName       = "/api.poi.v1.PoIService/StreamRoute"
 )
 
 // PoIServiceClient is the client API for PoIService service.
@@ -30,9 +42,40 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type PoIServiceClient interface {
 	PoI(ctx context.Context, in *PoIRequest, opts ...grpc.CallOption) (*PoIResponse, error)
+	// gets many PoIs by their ids at once, ids of PoIs which do not exist are
+	// returned as missing ids
+	BatchGetPoIs(ctx context.Context, in *BatchGetPoIsRequest, opts ...grpc.CallOption) (*BatchGetPoIsResponse, error)
+	// creates a PoI, fails if a PoI with the given id already exists
+	CreatePoI(ctx context.Context, in *CreatePoIRequest, opts ...grpc.CallOption) (*PoIResponse, error)
+	// updates the fields of the update mask of an existing PoI
+	UpdatePoI(ctx context.Context, in *UpdatePoIRequest, opts ...grpc.CallOption) (*PoIResponse, error)
+	DeletePoI(ctx context.Context, in *DeletePoIRequest, opts ...grpc.CallOption) (*DeletePoIResponse, error)
 	Proximity(ctx context.Context, in *ProximityRequest, opts ...grpc.CallOption) (*PoISearchResponse, error)
 	BBox(ctx context.Context, in *BBoxRequest, opts ...grpc.CallOption) (*PoISearchResponse, error)
 	Route(ctx context.Context, in *RouteRequest, opts ...grpc.CallOption) (*PoISearchResponse, error)
+	Nearest(ctx context.Context, in *NearestRequest, opts ...grpc.CallOption) (*PoISearchResponse, error)
+	Polygon(ctx context.Context, in *PolygonRequest, opts ...grpc.CallOption) (*PoISearchResponse, error)
+	// plans the charging stops of an electric vehicle along a route, keeping the
+	// vehicle above the reserve charge with the minimum number of stops. Routes
+	// too long to be searched completely fail with OUT_OF_RANGE
+	PlanChargingStops(ctx context.Context, in *PlanChargingStopsRequest, opts ...grpc.CallOption) (*PlanChargingStopsResponse, error)
+	// aggregates the PoIs within the bounding box into clusters of geo cells
+	// appropriate to the zoom level of a map. Zoom levels up to 10 are summed up
+	// from the precomputed density and accept any bounding box, finer zoom levels
+	// fail with OUT_OF_RANGE if the bounding box holds too many PoIs
+	Clusters(ctx context.Context, in *ClustersRequest, opts ...grpc.CallOption) (*ClustersResponse, error)
+	// counts the PoIs and their charging capacity per geo cell of a level within
+	// // a bounding box or polygon, e.g. for heatmaps
+	Density(ctx context.Context, in *DensityRequest, opts ...grpc.CallOption) (*DensityResponse, error)
+	// streams the PoIs of each queried geo cell as soon as the cell has been queried,
+	// the REST endpoint responds with newline-delimited JSON
+	StreamProximity(ctx context.Context, in *ProximityRequest, opts ...grpc.CallOption) (PoIService_StreamProximityClient, error)
+	// streams the PoIs of each queried geo cell as soon as the cell has been queried,
+	// the REST endpoint responds with newline-delimited JSON
+	StreamBBox(ctx context.Context, in *BBoxRequest, opts ...grpc.CallOption) (PoIService_StreamBBoxClient, error)
+	// streams the PoIs of each queried geo cell as soon as the cell has been queried,
+	// the REST endpoint responds with newline-delimited JSON
+	StreamRoute(ctx context.Context, in *RouteRequest, opts ...grpc.CallOption) (PoIService_StreamRouteClient, error)
 }
 
 type poIServiceClient struct {
@@ -53,6 +96,46 @@ func (c *poIServiceClient) PoI(ctx context.Context, in *PoIRequest, opts ...grpc
 	return out, nil
 }
 
+func (c *poIServiceClient) BatchGetPoIs(ctx context.Context, in *BatchGetPoIsRequest, opts ...grpc.CallOption) (*BatchGetPoIsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchGetPoIsResponse)
+	err := c.cc.Invoke(ctx, PoIService_BatchGetPoIs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *poIServiceClient) CreatePoI(ctx context.Context, in *CreatePoIRequest, opts ...grpc.CallOption) (*PoIResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PoIResponse)
+	err := c.cc.Invoke(ctx, PoIService_CreatePoI_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *poIServiceClient) UpdatePoI(ctx context.Context, in *UpdatePoIRequest, opts ...grpc.CallOption) (*PoIResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PoIResponse)
+	err := c.cc.Invoke(ctx, PoIService_UpdatePoI_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *poIServiceClient) DeletePoI(ctx context.Context, in *DeletePoIRequest, opts ...grpc.CallOption) (*DeletePoIResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeletePoIResponse)
+	err := c.cc.Invoke(ctx, PoIService_DeletePoI_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *poIServiceClient) Proximity(ctx context.Context, in *ProximityRequest, opts ...grpc.CallOption) (*PoISearchResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(PoISearchResponse)
@@ -83,14 +166,194 @@ func (c *poIServiceClient) Route(ctx context.Context, in *RouteRequest, opts ...
 	return out, nil
 }
 
+func (c *poIServiceClient) Nearest(ctx context.Context, in *NearestRequest, opts ...grpc.CallOption) (*PoISearchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PoISearchResponse)
+	err := c.cc.Invoke(ctx, PoIService_Nearest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *poIServiceClient) Polygon(ctx context.Context, in *PolygonRequest, opts ...grpc.CallOption) (*PoISearchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PoISearchResponse)
+	err := c.cc.Invoke(ctx, PoIService_Polygon_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *poIServiceClient) PlanChargingStops(ctx context.Context, in *PlanChargingStopsRequest, opts ...grpc.CallOption) (*PlanChargingStopsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PlanChargingStopsResponse)
+	err := c.cc.Invoke(ctx, PoIService_PlanChargingStops_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *poIServiceClient) Clusters(ctx context.Context, in *ClustersRequest, opts ...grpc.CallOption) (*ClustersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClustersResponse)
+	err := c.cc.Invoke(ctx, PoIService_Clusters_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *poIServiceClient) Density(ctx context.Context, in *DensityRequest, opts ...grpc.CallOption) (*DensityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DensityResponse)
+	err := c.cc.Invoke(ctx, PoIService_Density_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *poIServiceClient) StreamProximity(ctx context.Context, in *ProximityRequest, opts ...grpc.CallOption) (PoIService_StreamProximityClient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &PoIService_ServiceDesc.Streams[0], PoIService_StreamProximity_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &poIServiceStreamProximityClient{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type PoIService_StreamProximityClient interface {
+	Recv() (*PoISearchResponse, error)
+	grpc.ClientStream
+}
+
+type poIServiceStreamProximityClient struct {
+	grpc.ClientStream
+}
+
+func (x *poIServiceStreamProximityClient) Recv() (*PoISearchResponse, error) {
+	m := new(PoISearchResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *poIServiceClient) StreamBBox(ctx context.Context, in *BBoxRequest, opts ...grpc.CallOption) (PoIService_StreamBBoxClient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &PoIService_ServiceDesc.Streams[1], PoIService_StreamBBox_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &poIServiceStreamBBoxClient{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type PoIService_StreamBBoxClient interface {
+	Recv() (*PoISearchResponse, error)
+	grpc.ClientStream
+}
+
+type poIServiceStreamBBoxClient struct {
+	grpc.ClientStream
+}
+
+func (x *poIServiceStreamBBoxClient) Recv() (*PoISearchResponse, error) {
+	m := new(PoISearchResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *poIServiceClient) StreamRoute(ctx context.Context, in *RouteRequest, opts ...grpc.CallOption) (PoIService_StreamRouteClient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &PoIService_ServiceDesc.Streams[2], PoIService_StreamRoute_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &poIServiceStreamRouteClient{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type PoIService_StreamRouteClient interface {
+	Recv() (*PoISearchResponse, error)
+	grpc.ClientStream
+}
+
+type poIServiceStreamRouteClient struct {
+	grpc.ClientStream
+}
+
+func (x *poIServiceStreamRouteClient) Recv() (*PoISearchResponse, error) {
+	m := new(PoISearchResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // PoIServiceServer is the server API for PoIService service.
 // All implementations should embed UnimplementedPoIServiceServer
 // for forward compatibility
 type PoIServiceServer interface {
 	PoI(context.Context, *PoIRequest) (*PoIResponse, error)
+	// gets many PoIs by their ids at once, ids of PoIs which do not exist are
+	// returned as missing ids
+	BatchGetPoIs(context.Context, *BatchGetPoIsRequest) (*BatchGetPoIsResponse, error)
+	// creates a PoI, fails if a PoI with the given id already exists
+	CreatePoI(context.Context, *CreatePoIRequest) (*PoIResponse, error)
+	// updates the fields of the update mask of an existing PoI
+	UpdatePoI(context.Context, *UpdatePoIRequest) (*PoIResponse, error)
+	DeletePoI(context.Context, *DeletePoIRequest) (*DeletePoIResponse, error)
 	Proximity(context.Context, *ProximityRequest) (*PoISearchResponse, error)
 	BBox(context.Context, *BBoxRequest) (*PoISearchResponse, error)
 	Route(context.Context, *RouteRequest) (*PoISearchResponse, error)
+	Nearest(context.Context, *NearestRequest) (*PoISearchResponse, error)
+	Polygon(context.Context, *PolygonRequest) (*PoISearchResponse, error)
+	// plans the charging stops of an electric vehicle along a route, keeping the
+	// vehicle above the reserve charge with the minimum number of stops. Routes
+	// too long to be searched completely fail with OUT_OF_RANGE
+	PlanChargingStops(context.Context, *PlanChargingStopsRequest) (*PlanChargingStopsResponse, error)
+	// aggregates the PoIs within the bounding box into clusters of geo cells
+	// appropriate to the zoom level of a map. Zoom levels up to 10 are summed up
+	// from the precomputed density and accept any bounding box, finer zoom levels
+	// fail with OUT_OF_RANGE if the bounding box holds too many PoIs
+	Clusters(context.Context, *ClustersRequest) (*ClustersResponse, error)
+	// counts the PoIs and their charging capacity per geo cell of a level within
+	// // a bounding box or polygon, e.g. for heatmaps
+	Density(context.Context, *DensityRequest) (*DensityResponse, error)
+	// streams the PoIs of each queried geo cell as soon as the cell has been queried,
+	// the REST endpoint responds with newline-delimited JSON
+	StreamProximity(*ProximityRequest, PoIService_StreamProximityServer) error
+	// streams the PoIs of each queried geo cell as soon as the cell has been queried,
+	// the REST endpoint responds with newline-delimited JSON
+	StreamBBox(*BBoxRequest, PoIService_StreamBBoxServer) error
+	// streams the PoIs of each queried geo cell as soon as the cell has been queried,
+	// the REST endpoint responds with newline-delimited JSON
+	StreamRoute(*RouteRequest, PoIService_StreamRouteServer) error
 }
 
 // UnimplementedPoIServiceServer should be embedded to have forward compatible implementations.
@@ -100,6 +363,18 @@ type UnimplementedPoIServiceServer struct {
 func (UnimplementedPoIServiceServer) PoI(context.Context, *PoIRequest) (*PoIResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PoI not implemented")
 }
+func (UnimplementedPoIServiceServer) BatchGetPoIs(context.Context, *BatchGetPoIsRequest) (*BatchGetPoIsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGetPoIs not implemented")
+}
+func (UnimplementedPoIServiceServer) CreatePoI(context.Context, *CreatePoIRequest) (*PoIResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePoI not implemented")
+}
+func (UnimplementedPoIServiceServer) UpdatePoI(context.Context, *UpdatePoIRequest) (*PoIResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePoI not implemented")
+}
+func (UnimplementedPoIServiceServer) DeletePoI(context.Context, *DeletePoIRequest) (*DeletePoIResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePoI not implemented")
+}
 func (UnimplementedPoIServiceServer) Proximity(context.Context, *ProximityRequest) (*PoISearchResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Proximity not implemented")
 }
@@ -109,6 +384,30 @@ func (UnimplementedPoIServiceServer) BBox(context.Context, *BBoxRequest) (*PoISe
 func (UnimplementedPoIServiceServer) Route(context.Context, *RouteRequest) (*PoISearchResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Route not implemented")
 }
+func (UnimplementedPoIServiceServer) Nearest(context.Context, *NearestRequest) (*PoISearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Nearest not implemented")
+}
+func (UnimplementedPoIServiceServer) Polygon(context.Context, *PolygonRequest) (*PoISearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Polygon not implemented")
+}
+func (UnimplementedPoIServiceServer) PlanChargingStops(context.Context, *PlanChargingStopsRequest) (*PlanChargingStopsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlanChargingStops not implemented")
+}
+func (UnimplementedPoIServiceServer) Clusters(context.Context, *ClustersRequest) (*ClustersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Clusters not implemented")
+}
+func (UnimplementedPoIServiceServer) Density(context.Context, *DensityRequest) (*DensityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Density not implemented")
+}
+func (UnimplementedPoIServiceServer) StreamProximity(*ProximityRequest, PoIService_StreamProximityServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamProximity not implemented")
+}
+func (UnimplementedPoIServiceServer) StreamBBox(*BBoxRequest, PoIService_StreamBBoxServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamBBox not implemented")
+}
+func (UnimplementedPoIServiceServer) StreamRoute(*RouteRequest, PoIService_StreamRouteServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamRoute not implemented")
+}
 
 // UnsafePoIServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to PoIServiceServer will
@@ -139,6 +438,78 @@ func _PoIService_PoI_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PoIService_BatchGetPoIs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetPoIsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PoIServiceServer).BatchGetPoIs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PoIService_BatchGetPoIs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PoIServiceServer).BatchGetPoIs(ctx, req.(*BatchGetPoIsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PoIService_CreatePoI_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePoIRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PoIServiceServer).CreatePoI(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PoIService_CreatePoI_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PoIServiceServer).CreatePoI(ctx, req.(*CreatePoIRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PoIService_UpdatePoI_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePoIRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PoIServiceServer).UpdatePoI(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PoIService_UpdatePoI_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PoIServiceServer).UpdatePoI(ctx, req.(*UpdatePoIRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PoIService_DeletePoI_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePoIRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PoIServiceServer).DeletePoI(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PoIService_DeletePoI_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PoIServiceServer).DeletePoI(ctx, req.(*DeletePoIRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PoIService_Proximity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ProximityRequest)
 	if err := dec(in); err != nil {
@@ -193,6 +564,159 @@ func _PoIService_Route_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PoIService_Nearest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NearestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PoIServiceServer).Nearest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PoIService_Nearest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PoIServiceServer).Nearest(ctx, req.(*NearestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PoIService_Polygon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PolygonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PoIServiceServer).Polygon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PoIService_Polygon_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PoIServiceServer).Polygon(ctx, req.(*PolygonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PoIService_PlanChargingStops_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlanChargingStopsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PoIServiceServer).PlanChargingStops(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PoIService_PlanChargingStops_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PoIServiceServer).PlanChargingStops(ctx, req.(*PlanChargingStopsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PoIService_Clusters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClustersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PoIServiceServer).Clusters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PoIService_Clusters_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PoIServiceServer).Clusters(ctx, req.(*ClustersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PoIService_Density_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DensityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PoIServiceServer).Density(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PoIService_Density_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PoIServiceServer).Density(ctx, req.(*DensityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PoIService_StreamProximity_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ProximityRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PoIServiceServer).StreamProximity(m, &poIServiceStreamProximityServer{ServerStream: stream})
+}
+
+type PoIService_StreamProximityServer interface {
+	Send(*PoISearchResponse) error
+	grpc.ServerStream
+}
+
+type poIServiceStreamProximityServer struct {
+	grpc.ServerStream
+}
+
+func (x *poIServiceStreamProximityServer) Send(m *PoISearchResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _PoIService_StreamBBox_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(BBoxRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PoIServiceServer).StreamBBox(m, &poIServiceStreamBBoxServer{ServerStream: stream})
+}
+
+type PoIService_StreamBBoxServer interface {
+	Send(*PoISearchResponse) error
+	grpc.ServerStream
+}
+
+type poIServiceStreamBBoxServer struct {
+	grpc.ServerStream
+}
+
+func (x *poIServiceStreamBBoxServer) Send(m *PoISearchResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _PoIService_StreamRoute_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(RouteRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PoIServiceServer).StreamRoute(m, &poIServiceStreamRouteServer{ServerStream: stream})
+}
+
+type PoIService_StreamRouteServer interface {
+	Send(*PoISearchResponse) error
+	grpc.ServerStream
+}
+
+type poIServiceStreamRouteServer struct {
+	grpc.ServerStream
+}
+
+func (x *poIServiceStreamRouteServer) Send(m *PoISearchResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // PoIService_ServiceDesc is the grpc.ServiceDesc for PoIService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -204,6 +728,22 @@ var PoIService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PoI",
 			Handler:    _PoIService_PoI_Handler,
 		},
+		{
+			MethodName: "BatchGetPoIs",
+			Handler:    _PoIService_BatchGetPoIs_Handler,
+		},
+		{
+			MethodName: "CreatePoI",
+			Handler:    _PoIService_CreatePoI_Handler,
+		},
+		{
+			MethodName: "UpdatePoI",
+			Handler:    _PoIService_UpdatePoI_Handler,
+		},
+		{
+			MethodName: "DeletePoI",
+			Handler:    _PoIService_DeletePoI_Handler,
+		},
 		{
 			MethodName: "Proximity",
 			Handler:    _PoIService_Proximity_Handler,
@@ -216,7 +756,43 @@ var PoIService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Route",
 			Handler:    _PoIService_Route_Handler,
 		},
+		{
+			MethodName: "Nearest",
+			Handler:    _PoIService_Nearest_Handler,
+		},
+		{
+			MethodName: "Polygon",
+			Handler:    _PoIService_Polygon_Handler,
+		},
+		{
+			MethodName: "PlanChargingStops",
+			Handler:    _PoIService_PlanChargingStops_Handler,
+		},
+		{
+			MethodName: "Clusters",
+			Handler:    _PoIService_Clusters_Handler,
+		},
+		{
+			MethodName: "Density",
+			Handler:    _PoIService_Density_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamProximity",
+			Handler:       _PoIService_StreamProximity_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamBBox",
+			Handler:       _PoIService_StreamBBox_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamRoute",
+			Handler:       _PoIService_StreamRoute_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "v1/poi/poi.proto",
 }
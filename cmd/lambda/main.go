@@ -81,9 +81,14 @@ func (handler *TableInitHandler) HandleCfn(ctx context.Context, event *cfn.Event
 	}
 
 	// do batch upsert off all
-	err = handler.repository.UpsertBatch(ctx, domain, handler.logger)
+	report, err := handler.repository.UpsertBatch(ctx, domain, handler.logger)
 	if err != nil {
 		handler.logger.Error("failed to upsert batches to table", zap.Error(err))
+		if report != nil {
+			for _, f := range report.Failed() {
+				logger.Warn("location not written", zap.String("location_id", f.ID.String()), zap.Error(f.Err))
+			}
+		}
 		resp.Status = cfn.StatusFailed
 		_ = resp.Send()
 		return
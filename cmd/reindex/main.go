@@ -0,0 +1,84 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"go.uber.org/zap"
+
+	"github.com/grntlrduck-cloud/go-grpc-geohasing-service-sample/internal/adapters/dynamo"
+)
+
+// This program recomputes the geo index attributes of all items of the table with the given spatial index and level
+// for the geo index, e.g. after changing the spatial index or the level or introducing a new geo index. An interrupted run continues at the
+// checkpoint, once all items are reindexed the number of items per cell of the geo index is verified and the density
+// aggregates are rebuilt if requested.
+func main() {
+	tableName := flag.String("table", "", "name of the table to reindex")
+	region := flag.String("region", "eu-west-1", "region of the table")
+	spatialIndex := flag.String("spatial-index", dynamo.SpatialIndexS2, "spatial index of the geo indexes, s2 or geohash")
+	level := flag.Int(
+		"level",
+		dynamo.CPoIItemCellLevel,
+		"level of the cells partitioning the geo index, aws.dynamodb.level of the service must be the same",
+	)
+	rate := flag.Int("rate", 50, "max number of item updates per second")
+	pageSize := flag.Int("page-size", 100, "number of items per scan request")
+	checkpoint := flag.String("checkpoint", "reindex_checkpoint.json", "path of the checkpoint file")
+	host := flag.String("host", "", "host of a local DynamoDB, e.g. localhost")
+	port := flag.String("port", "8000", "port of a local DynamoDB")
+	density := flag.Bool("density", false, "rebuild the density aggregates once all items are reindexed")
+	flag.Parse()
+
+	logger, err := zap.NewProduction()
+	if err != nil {
+		panic(err)
+	}
+	defer func() {
+		_ = logger.Sync()
+	}()
+	if *tableName == "" {
+		logger.Fatal("missing table name, use -table")
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	clientOpts := []dynamo.ClientOptions{dynamo.WithContext(ctx), dynamo.WithRegion(*region)}
+	if *host != "" {
+		clientOpts = append(clientOpts, dynamo.WithEndPointOverride(*host, *port))
+	}
+	client, err := dynamo.NewClientWrapper(clientOpts...)
+	if err != nil {
+		logger.Fatal("failed to init dynamodb client", zap.Error(err))
+	}
+	reindexer, err := dynamo.NewReindexer(
+		dynamo.WithReindexClient(client),
+		dynamo.WithReindexTableName(*tableName),
+		dynamo.WithReindexSpatialIndex(*spatialIndex),
+		dynamo.WithReindexLevel(*level),
+		dynamo.WithReindexRate(*rate),
+		dynamo.WithReindexPageSize(int32(*pageSize)), //nolint:gosec // page size is a small number
+		dynamo.WithReindexCheckpoint(*checkpoint),
+		dynamo.WithReindexDensity(*density),
+	)
+	if err != nil {
+		logger.Fatal("failed to init reindexer", zap.Error(err))
+	}
+
+	stats, err := reindexer.Run(ctx, logger)
+	if stats != nil {
+		logger.Info("reindex finished",
+			zap.Int("num_scanned", stats.Scanned),
+			zap.Int("num_updated", stats.Updated),
+			zap.Int("num_skipped", stats.Skipped),
+		)
+	}
+	if err != nil {
+		logger.Fatal("reindex failed, run again to continue at the checkpoint", zap.Error(err))
+	}
+	logger.Info("Done!")
+}
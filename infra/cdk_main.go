@@ -44,6 +44,8 @@ func main() {
 			},
 			AppName:   appName,
 			TableName: fmt.Sprintf("%s_charging-pois", appName),
+			// deploy with -c skipFineGeoIndex=true to add the coarse geo index to an existing table first
+			FineGeoIndex: app.Node().TryGetContext(jsii.String("skipFineGeoIndex")) == nil,
 		},
 	)
 
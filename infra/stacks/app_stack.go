@@ -65,6 +65,17 @@ func NewAppStack(
 		},
 	)
 
+	// signs the page tokens of paginated searches, must be shared by all tasks of the service
+	pageTokenSecret := awssecretsmanager.NewSecret(
+		stack,
+		jsii.String("PageTokenSecret"),
+		&awssecretsmanager.SecretProps{
+			GenerateSecretString: &awssecretsmanager.SecretStringGenerator{
+				ExcludeCharacters: jsii.String(`"'\@/\\`), // exclude escape characters
+			},
+		},
+	)
+
 	containerName := fmt.Sprintf("%s-container", props.AppName)
 	restProxyPort := 8443
 
@@ -87,6 +98,7 @@ func NewAppStack(
 				Image:         awsecs.ContainerImage_FromEcrRepository(ecrRepo, &imageTag),
 				Secrets: &map[string]awsecs.Secret{
 					"API_KEY_SECRET_VALUE": awsecs.Secret_FromSecretsManager(apiKeySecret, nil),
+					"PAGE_TOKEN_SECRET":    awsecs.Secret_FromSecretsManager(pageTokenSecret, nil),
 				},
 				Environment: &map[string]*string{
 					"APP_NAME":            &props.AppName,
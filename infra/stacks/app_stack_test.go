@@ -37,8 +37,8 @@ var _ = Describe("Given app stack", Ordered, func() {
 	})
 
 	When("stack template", func() {
-		It("has secret", func() {
-			template.ResourceCountIs(jsii.String("AWS::SecretsManager::Secret"), jsii.Number(1))
+		It("has api key and page token secret", func() {
+			template.ResourceCountIs(jsii.String("AWS::SecretsManager::Secret"), jsii.Number(2))
 		})
 
 		It("has alb", func() {
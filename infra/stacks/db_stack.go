@@ -16,6 +16,9 @@ type DBStackProps struct {
 	TableName  string
 	AppName    string
 	LambdaPath string
+	// FineGeoIndex adds the fine geo index gsi3_geo. Existing tables only accept one new index per deployment, hence
+	// tables without the coarse and fine geo index are deployed without it first, see README.
+	FineGeoIndex bool
 }
 
 type DBStack struct {
@@ -74,8 +77,38 @@ func NewDBStack(
 				MaxWriteRequestUnits: jsii.Number(200),
 				MaxReadRequestUnits:  jsii.Number(200),
 			},
+			// the coarse and fine geo indexes share the sort key of the geo index
+			{
+				IndexName: jsii.String("gsi2_geo"),
+				PartitionKey: &awsdynamodb.Attribute{
+					Name: jsii.String("gsi2_geo_pk"),
+					Type: awsdynamodb.AttributeType_NUMBER,
+				},
+				SortKey: &awsdynamodb.Attribute{
+					Name: jsii.String("gsi1_geo_sk"),
+					Type: awsdynamodb.AttributeType_NUMBER,
+				},
+				MaxWriteRequestUnits: jsii.Number(200),
+				MaxReadRequestUnits:  jsii.Number(200),
+			},
 		},
 	}
+	if props.FineGeoIndex {
+		indexes := append(*tableProps.GlobalSecondaryIndexes, &awsdynamodb.GlobalSecondaryIndexPropsV2{
+			IndexName: jsii.String("gsi3_geo"),
+			PartitionKey: &awsdynamodb.Attribute{
+				Name: jsii.String("gsi3_geo_pk"),
+				Type: awsdynamodb.AttributeType_NUMBER,
+			},
+			SortKey: &awsdynamodb.Attribute{
+				Name: jsii.String("gsi1_geo_sk"),
+				Type: awsdynamodb.AttributeType_NUMBER,
+			},
+			MaxWriteRequestUnits: jsii.Number(200),
+			MaxReadRequestUnits:  jsii.Number(200),
+		})
+		tableProps.GlobalSecondaryIndexes = &indexes
+	}
 
 	tableWithInitPois := mycnstrcts.NewDynamoDBWithInitialData(
 		stack,
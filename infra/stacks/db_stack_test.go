@@ -5,6 +5,7 @@ import (
 	"github.com/aws/aws-cdk-go/awscdk/v2/assertions"
 	"github.com/aws/jsii-runtime-go"
 	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
 
 	"github.com/grntlrduck-cloud/go-grpc-geohasing-service-sample/infra/stacks"
 )
@@ -21,12 +22,23 @@ var _ = Describe("Given app stack", Ordered, func() {
 					Region:  jsii.String("eu-west-1"),
 				},
 			},
-			AppName:    "test",
-			LambdaPath: "../../cmd/lambda",
+			AppName:      "test",
+			LambdaPath:   "../../cmd/lambda",
+			FineGeoIndex: true,
 		})
 		template = assertions.Template_FromStack(stack.Stack, nil)
 	})
 
+	numGeoIndexes := func(t assertions.Template) int {
+		tables := t.FindResources(jsii.String("AWS::DynamoDB::GlobalTable"), nil)
+		Expect(*tables).To(HaveLen(1))
+		for _, table := range *tables {
+			indexes := (*table)["Properties"].(map[string]any)["GlobalSecondaryIndexes"]
+			return len(indexes.([]any))
+		}
+		return 0
+	}
+
 	When("stack template", func() {
 		It("has table", func() {
 			template.ResourceCountIs(
@@ -35,6 +47,18 @@ var _ = Describe("Given app stack", Ordered, func() {
 			)
 		})
 
+		It("has all geo indexes", func() {
+			Expect(numGeoIndexes(template)).To(Equal(3))
+		})
+
+		It("has no fine geo index if disabled", func() {
+			stack := stacks.NewDBStack(awscdk.NewApp(nil), "test-db-stack", &stacks.DBStackProps{
+				AppName:    "test",
+				LambdaPath: "../../cmd/lambda",
+			})
+			Expect(numGeoIndexes(assertions.Template_FromStack(stack.Stack, nil))).To(Equal(2))
+		})
+
 		It("has custom resource", func() {
 			template.ResourceCountIs(
 				jsii.String("AWS::CloudFormation::CustomResource"),
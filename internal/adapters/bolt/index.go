@@ -0,0 +1,228 @@
+package bolt
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+
+	"github.com/golang/geo/s2"
+	bbolt "go.etcd.io/bbolt"
+
+	"github.com/grntlrduck-cloud/go-grpc-geohasing-service-sample/internal/adapters/dynamo"
+	"github.com/grntlrduck-cloud/go-grpc-geohasing-service-sample/internal/adapters/geo"
+	"github.com/grntlrduck-cloud/go-grpc-geohasing-service-sample/internal/domain/poi"
+)
+
+// The locations are stored as json encoded dynamo.CPoIItem in the pois bucket keyed by their id.
+// The gsi1_geo bucket mirrors the geo index of the DynamoDB table, its keys are the trimmed geohash,
+// the full precision geohash, and the id of the location, all in big endian so that the keys are
+// ordered by cell. Since the leaf cells of any cell form a contiguous range, all locations within
+// a cell of a covering are found by a single cursor scan.
+var (
+	poisBucket     = []byte("pois")
+	geoIndexBucket = []byte(dynamo.CPoIItemGeoIndexName)
+	// the density bucket holds the json encoded poi.DensityCount keyed by the cell of the density aggregate level
+	// in big endian, it is updated by the transactions writing the locations
+	densityBucket = []byte("density")
+)
+
+const geoIndexKeyPrefixSize = 16
+
+func geoIndexKey(item *dynamo.CPoIItem) []byte {
+	key := make([]byte, geoIndexKeyPrefixSize, geoIndexKeyPrefixSize+len(item.Pk))
+	binary.BigEndian.PutUint64(key, item.GeoIndexPk)
+	binary.BigEndian.PutUint64(key[8:], item.GeoIndexSk)
+	return append(key, item.Pk...)
+}
+
+// geoIndexSeekKey is the first key of a location within the cell, the trimmed geohash of the leaf cells
+// grows with the full precision geohash, hence the keys of the cell are ordered by the latter
+func geoIndexSeekKey(cell s2.CellID) []byte {
+	first := cell.RangeMin()
+	key := make([]byte, geoIndexKeyPrefixSize)
+	binary.BigEndian.PutUint64(key, uint64(first.Parent(dynamo.CPoIItemCellLevel)))
+	binary.BigEndian.PutUint64(key[8:], uint64(first))
+	return key
+}
+
+func getItem(tx *bbolt.Tx, id string) (*dynamo.CPoIItem, error) {
+	v := tx.Bucket(poisBucket).Get([]byte(id))
+	if v == nil {
+		return nil, nil
+	}
+	item := new(dynamo.CPoIItem)
+	if err := json.Unmarshal(v, item); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal item %s: %w", id, err)
+	}
+	return item, nil
+}
+
+// putItem stores the item, moves its index entry if the location has moved, and updates the density aggregates
+func putItem(tx *bbolt.Tx, item *dynamo.CPoIItem) error {
+	stored, err := getItem(tx, item.Pk)
+	if err != nil {
+		return err
+	}
+	if err := updateDensity(tx, stored, item); err != nil {
+		return err
+	}
+	index := tx.Bucket(geoIndexBucket)
+	if stored != nil {
+		if err := index.Delete(geoIndexKey(stored)); err != nil {
+			return fmt.Errorf("failed to delete index entry: %w", err)
+		}
+	}
+	v, err := json.Marshal(item)
+	if err != nil {
+		return fmt.Errorf("failed to marshal item %s: %w", item.Pk, err)
+	}
+	if err := tx.Bucket(poisBucket).Put([]byte(item.Pk), v); err != nil {
+		return fmt.Errorf("failed to put item: %w", err)
+	}
+	if err := index.Put(geoIndexKey(item), []byte{}); err != nil {
+		return fmt.Errorf("failed to put index entry: %w", err)
+	}
+	return nil
+}
+
+func deleteItem(tx *bbolt.Tx, item *dynamo.CPoIItem) error {
+	if err := updateDensity(tx, item, nil); err != nil {
+		return err
+	}
+	if err := tx.Bucket(geoIndexBucket).Delete(geoIndexKey(item)); err != nil {
+		return fmt.Errorf("failed to delete index entry: %w", err)
+	}
+	if err := tx.Bucket(poisBucket).Delete([]byte(item.Pk)); err != nil {
+		return fmt.Errorf("failed to delete item: %w", err)
+	}
+	return nil
+}
+
+// scanCell visits the items within the cell in the order of the index, starting after the given key if any,
+// until visit returns false
+func scanCell(
+	tx *bbolt.Tx,
+	cell s2.CellID,
+	after []byte,
+	visit func(key []byte, item *dynamo.CPoIItem) bool,
+) error {
+	last := uint64(cell.RangeMax())
+	c := tx.Bucket(geoIndexBucket).Cursor()
+	k, _ := c.Seek(geoIndexSeekKey(cell))
+	if after != nil && bytes.Compare(after, k) >= 0 {
+		k, _ = c.Seek(after)
+		if bytes.Equal(k, after) {
+			k, _ = c.Next()
+		}
+	}
+	for ; k != nil && len(k) > geoIndexKeyPrefixSize; k, _ = c.Next() {
+		if binary.BigEndian.Uint64(k[8:geoIndexKeyPrefixSize]) > last {
+			return nil
+		}
+		item, err := getItem(tx, string(k[geoIndexKeyPrefixSize:]))
+		if err != nil {
+			return err
+		}
+		if item == nil {
+			return fmt.Errorf("index entry without item %s", k[geoIndexKeyPrefixSize:])
+		}
+		if !visit(k, item) {
+			return nil
+		}
+	}
+	return nil
+}
+
+// updateDensity adds the change of replacing the stored item with the item to the density aggregates, the stored
+// item is nil for new items and the item is nil for deleted items
+func updateDensity(tx *bbolt.Tx, stored, item *dynamo.CPoIItem) error {
+	changes := make(geo.DensityAggregates)
+	var locations [2]*poi.PoILocation
+	for i, it := range []*dynamo.CPoIItem{stored, item} {
+		if it == nil {
+			continue
+		}
+		l, err := it.Domain()
+		if err != nil {
+			return fmt.Errorf("failed to map item %s: %w", it.Pk, err)
+		}
+		locations[i] = l
+	}
+	changes.Replace(locations[0], locations[1])
+	return addDensity(tx, changes)
+}
+
+// addDensity adds the changes to the density aggregates, cells whose count cancels out are removed
+func addDensity(tx *bbolt.Tx, changes geo.DensityAggregates) error {
+	bucket := tx.Bucket(densityBucket)
+	for cell, change := range changes {
+		key := binary.BigEndian.AppendUint64(nil, uint64(cell))
+		aggregates := make(geo.DensityAggregates, 1)
+		if v := bucket.Get(key); v != nil {
+			var count poi.DensityCount
+			if err := json.Unmarshal(v, &count); err != nil {
+				return fmt.Errorf("failed to unmarshal density of cell %s: %w", cell.ToToken(), err)
+			}
+			aggregates[cell] = count
+		}
+		aggregates.Add(cell, change)
+		count, ok := aggregates[cell]
+		if !ok {
+			if err := bucket.Delete(key); err != nil {
+				return fmt.Errorf("failed to delete density: %w", err)
+			}
+			continue
+		}
+		v, err := json.Marshal(count)
+		if err != nil {
+			return fmt.Errorf("failed to marshal density of cell %s: %w", cell.ToToken(), err)
+		}
+		if err := bucket.Put(key, v); err != nil {
+			return fmt.Errorf("failed to put density: %w", err)
+		}
+	}
+	return nil
+}
+
+// rebuildDensity aggregates the density of all stored items, e.g. for files written before the density bucket
+// has been introduced
+func rebuildDensity(tx *bbolt.Tx) error {
+	changes := make(geo.DensityAggregates)
+	err := tx.Bucket(poisBucket).ForEach(func(k, v []byte) error {
+		item := new(dynamo.CPoIItem)
+		if err := json.Unmarshal(v, item); err != nil {
+			return fmt.Errorf("failed to unmarshal item %s: %w", k, err)
+		}
+		l, err := item.Domain()
+		if err != nil {
+			return fmt.Errorf("failed to map item %s: %w", k, err)
+		}
+		changes.Replace(nil, l)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	return addDensity(tx, changes)
+}
+
+// scanDensity returns the density aggregates within the cells, which must not be finer than the aggregate level
+func scanDensity(tx *bbolt.Tx, cells []s2.CellID) (geo.DensityAggregates, error) {
+	aggregates := make(geo.DensityAggregates)
+	c := tx.Bucket(densityBucket).Cursor()
+	for _, cell := range cells {
+		last := uint64(cell.RangeMax())
+		for k, v := c.Seek(binary.BigEndian.AppendUint64(nil, uint64(cell.RangeMin()))); k != nil; k, v = c.Next() {
+			if binary.BigEndian.Uint64(k) > last {
+				break
+			}
+			var count poi.DensityCount
+			if err := json.Unmarshal(v, &count); err != nil {
+				return nil, fmt.Errorf("failed to unmarshal density: %w", err)
+			}
+			aggregates[s2.CellID(binary.BigEndian.Uint64(k))] = count
+		}
+	}
+	return aggregates, nil
+}
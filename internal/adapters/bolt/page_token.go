@@ -0,0 +1,26 @@
+package bolt
+
+import (
+	"fmt"
+)
+
+// The pageToken is the position at which a paged search continues.
+// Cell is the index of the covering cell to scan next and LastKey is the geo index key
+// the previous page ended with within that cell, if any.
+// Covering is a fingerprint of the covering and the search options the token has been issued for,
+// so that a token can not be used to resume a different search.
+// The tokens are signed with a random secret like the tokens of the DynamoDB repository, they are only valid
+// for the lifetime of the process.
+type pageToken struct {
+	Covering uint64 `json:"h"`
+	Cell     int    `json:"c"`
+	LastKey  []byte `json:"k,omitempty"`
+}
+
+// valid reports an error if the last key can not be a key of the geo index
+func (t *pageToken) valid() error {
+	if t.LastKey != nil && len(t.LastKey) <= geoIndexKeyPrefixSize {
+		return fmt.Errorf("invalid last key of length %d", len(t.LastKey))
+	}
+	return nil
+}
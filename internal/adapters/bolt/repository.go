@@ -0,0 +1,792 @@
+package bolt
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/golang/geo/s2"
+	"github.com/segmentio/ksuid"
+	bbolt "go.etcd.io/bbolt"
+	"go.uber.org/zap"
+
+	"github.com/grntlrduck-cloud/go-grpc-geohasing-service-sample/internal/adapters/csv"
+	"github.com/grntlrduck-cloud/go-grpc-geohasing-service-sample/internal/adapters/dynamo"
+	"github.com/grntlrduck-cloud/go-grpc-geohasing-service-sample/internal/adapters/geo"
+	"github.com/grntlrduck-cloud/go-grpc-geohasing-service-sample/internal/adapters/pagetoken"
+	"github.com/grntlrduck-cloud/go-grpc-geohasing-service-sample/internal/domain/poi"
+)
+
+const (
+	defaultPath = "poi.db"
+	openTimeout = 5 * time.Second // the file is locked by a single process
+)
+
+// The PoIRepository stores the locations in a single local bolt file, so that the service can run
+// without AWS, e.g. for edge deployments and offline demos. Searches cover the search area with the same
+// cells as the DynamoDB repository and return the same locations.
+type PoIRepository struct {
+	db           *bbolt.DB
+	path         string
+	loadInitData bool
+	initDataPath string
+	pageTokens   *pagetoken.Codec
+}
+
+type PoIRepositoryOptions func(p *PoIRepository)
+
+func WithPath(path string) PoIRepositoryOptions {
+	return func(p *PoIRepository) {
+		p.path = path
+	}
+}
+
+// WithLoadInitData loads the test data if the file does not contain any location yet
+func WithLoadInitData(loadInitData bool) PoIRepositoryOptions {
+	return func(p *PoIRepository) {
+		p.loadInitData = loadInitData
+	}
+}
+
+func WithInitDataOverride(initDataPath string) PoIRepositoryOptions {
+	return func(p *PoIRepository) {
+		p.initDataPath = initDataPath
+	}
+}
+
+func NewPoIRepository(
+	logger *zap.Logger,
+	opts ...PoIRepositoryOptions,
+) (*PoIRepository, error) {
+	repo := &PoIRepository{
+		path:         defaultPath,
+		initDataPath: csv.TestInitDataPath,
+	}
+	for _, opt := range opts {
+		opt(repo)
+	}
+	codec, err := pagetoken.NewCodec("")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create page token codec: %w", err)
+	}
+	repo.pageTokens = codec
+	db, err := bbolt.Open(repo.path, 0o600, &bbolt.Options{Timeout: openTimeout})
+	if err != nil {
+		return nil, fmt.Errorf("failed to open bolt file %s: %w", repo.path, err)
+	}
+	repo.db = db
+	empty := false
+	err = db.Update(func(tx *bbolt.Tx) error {
+		pois, errB := tx.CreateBucketIfNotExists(poisBucket)
+		if errB != nil {
+			return errB
+		}
+		empty = pois.Stats().KeyN == 0
+		_, errB = tx.CreateBucketIfNotExists(geoIndexBucket)
+		if errB != nil || tx.Bucket(densityBucket) != nil {
+			return errB
+		}
+		if _, errB = tx.CreateBucket(densityBucket); errB != nil {
+			return errB
+		}
+		return rebuildDensity(tx)
+	})
+	if err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("failed to create buckets: %w", err)
+	}
+	if repo.loadInitData && empty {
+		if err := repo.loadData(logger); err != nil {
+			_ = db.Close()
+			return nil, err
+		}
+	}
+	return repo, nil
+}
+
+// Close releases the file, the repository must not be used afterwards
+func (r *PoIRepository) Close() error {
+	return r.db.Close()
+}
+
+func (r *PoIRepository) loadData(logger *zap.Logger) error {
+	locations, err := csv.LocationsFromFile(r.initDataPath)
+	if err != nil {
+		return fmt.Errorf("failed to load test data from csv: %w", err)
+	}
+	if _, err := r.UpsertBatch(context.Background(), locations, logger); err != nil {
+		return fmt.Errorf("failed to perform batch upsert: %w", err)
+	}
+	return nil
+}
+
+func (r *PoIRepository) UpsertBatch(
+	ctx context.Context,
+	pois []*poi.PoILocation,
+	logger *zap.Logger,
+) (*poi.BatchWriteReport, error) {
+	// handle context cancelation
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+	report := &poi.BatchWriteReport{Results: make([]poi.BatchWriteResult, len(pois))}
+	items := make([]*dynamo.CPoIItem, 0, len(pois))
+	for i, v := range pois {
+		report.Results[i].ID = v.ID
+		item, err := dynamo.NewItemFromDomain(v)
+		if err != nil {
+			report.Results[i].Err = fmt.Errorf("unable to map location to item: %w", err)
+			continue
+		}
+		items = append(items, item)
+	}
+	// all items are written in a single transaction, hence either all or none of them are written
+	err := r.db.Update(func(tx *bbolt.Tx) error {
+		for _, item := range items {
+			if err := putItem(tx, item); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		for i := range report.Results {
+			if report.Results[i].Err == nil {
+				report.Results[i].Err = fmt.Errorf("%w: %w", poi.ErrDBBatchUpsert, err)
+			}
+		}
+	}
+	failed := report.Failed()
+	if len(failed) > 0 {
+		logger.Error("batch upsert incomplete",
+			zap.Error(failed[0].Err),
+			zap.Int("num_failed_items", len(failed)),
+			zap.Int("num_items", len(pois)),
+		)
+		return report, poi.ErrDBBatchUpsert
+	}
+	logger.Info("batch upsert complete",
+		zap.Int("num_items", len(pois)),
+	)
+	return report, nil
+}
+
+func (r *PoIRepository) Upsert(
+	ctx context.Context,
+	domain *poi.PoILocation,
+	logger *zap.Logger,
+) error {
+	return r.write(ctx, domain, func(_ *dynamo.CPoIItem) error { return nil }, logger)
+}
+
+func (r *PoIRepository) Create(
+	ctx context.Context,
+	domain *poi.PoILocation,
+	logger *zap.Logger,
+) error {
+	created := *domain
+	created.Version = 1
+	err := r.write(ctx, &created, func(stored *dynamo.CPoIItem) error {
+		if stored != nil {
+			return poi.ErrLocationAlreadyExists
+		}
+		return nil
+	}, logger)
+	if err != nil {
+		return err
+	}
+	domain.Version = created.Version
+	return nil
+}
+
+func (r *PoIRepository) Update(
+	ctx context.Context,
+	domain *poi.PoILocation,
+	logger *zap.Logger,
+) error {
+	updated := *domain
+	updated.Version = domain.Version + 1
+	err := r.write(ctx, &updated, func(stored *dynamo.CPoIItem) error {
+		return checkVersion(stored, domain.Version)
+	}, logger)
+	if err != nil {
+		return err
+	}
+	domain.Version = updated.Version
+	return nil
+}
+
+// write stores the location if the check of the stored item, nil if there is none, passes
+func (r *PoIRepository) write(
+	ctx context.Context,
+	domain *poi.PoILocation,
+	check func(stored *dynamo.CPoIItem) error,
+	logger *zap.Logger,
+) error {
+	// handle context cancelation
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	item, err := dynamo.NewItemFromDomain(domain)
+	if err != nil {
+		return fmt.Errorf("unable to upsert location: %w", err)
+	}
+	var checkErr error
+	err = r.db.Update(func(tx *bbolt.Tx) error {
+		stored, errG := getItem(tx, item.Pk)
+		if errG != nil {
+			return errG
+		}
+		if checkErr = check(stored); checkErr != nil {
+			return checkErr
+		}
+		return putItem(tx, item)
+	})
+	if checkErr != nil {
+		return checkErr
+	}
+	if err != nil {
+		logger.Error("failed to put item",
+			zap.Error(err),
+		)
+		return poi.ErrDBUpsert
+	}
+	return nil
+}
+
+func (r *PoIRepository) Delete(
+	ctx context.Context,
+	id ksuid.KSUID,
+	version int64,
+	logger *zap.Logger,
+) error {
+	// handle context cancelation
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	var checkErr error
+	err := r.db.Update(func(tx *bbolt.Tx) error {
+		stored, errG := getItem(tx, id.String())
+		if errG != nil {
+			return errG
+		}
+		if checkErr = checkVersion(stored, version); checkErr != nil {
+			return checkErr
+		}
+		return deleteItem(tx, stored)
+	})
+	if checkErr != nil {
+		return checkErr
+	}
+	if err != nil {
+		logger.Error("failed to delete item",
+			zap.Error(err),
+		)
+		return poi.ErrDBDelete
+	}
+	return nil
+}
+
+func (r *PoIRepository) GetByID(
+	ctx context.Context,
+	id ksuid.KSUID,
+	logger *zap.Logger,
+) (*poi.PoILocation, error) {
+	locations, err := r.GetByIDs(ctx, []ksuid.KSUID{id}, logger)
+	if err != nil {
+		return nil, err
+	}
+	if len(locations) == 0 {
+		return nil, poi.ErrLocationNotFound
+	}
+	return locations[0], nil
+}
+
+func (r *PoIRepository) GetByIDs(
+	ctx context.Context,
+	ids []ksuid.KSUID,
+	logger *zap.Logger,
+) ([]*poi.PoILocation, error) {
+	// handle context cancelation
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+	locations := make([]*poi.PoILocation, 0, len(ids))
+	seen := make(map[ksuid.KSUID]bool, len(ids))
+	err := r.db.View(func(tx *bbolt.Tx) error {
+		for _, id := range ids {
+			if seen[id] {
+				continue
+			}
+			seen[id] = true
+			item, err := getItem(tx, id.String())
+			if err != nil {
+				return err
+			}
+			if item == nil {
+				continue
+			}
+			l, err := item.Domain()
+			if err != nil {
+				return err
+			}
+			locations = append(locations, l)
+		}
+		return nil
+	})
+	if err != nil {
+		logger.Error("failed to get items",
+			zap.Error(err),
+		)
+		return nil, poi.ErrDBQuery
+	}
+	return locations, nil
+}
+
+func (r *PoIRepository) GetByProximity(
+	ctx context.Context,
+	cntr poi.Coordinates,
+	radius float64,
+	logger *zap.Logger,
+	opts ...poi.SearchOption,
+) (*poi.SearchResult, error) {
+	// handle context cancelation
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+	covering, err := geo.CoverProximity(cntr, radius, logger)
+	if err != nil {
+		return nil, err
+	}
+	return r.search(ctx, logger, covering, opts)
+}
+
+func (r *PoIRepository) GetByBbox(
+	ctx context.Context,
+	sw, ne poi.Coordinates,
+	logger *zap.Logger,
+	opts ...poi.SearchOption,
+) (*poi.SearchResult, error) {
+	// handle context cancelation
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+	covering, err := geo.CoverBbox(sw, ne, logger)
+	if err != nil {
+		return nil, err
+	}
+	return r.search(ctx, logger, covering, opts)
+}
+
+func (r *PoIRepository) GetByRoute(
+	ctx context.Context,
+	path []poi.Coordinates,
+	logger *zap.Logger,
+	opts ...poi.SearchOption,
+) (*poi.SearchResult, error) {
+	// handle context cancelation
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+	covering, err := geo.CoverRoute(path, poi.NewSearchOptions(opts...).CorridorMeters, logger)
+	if err != nil {
+		return nil, err
+	}
+	// a corridor describes the exact search area and the locations are always filtered strictly
+	if covering.Area != nil {
+		opts = append(opts, poi.WithStrict(true))
+	}
+	return r.search(ctx, logger, covering, opts)
+}
+
+func (r *PoIRepository) GetByPolygon(
+	ctx context.Context,
+	rings [][]poi.Coordinates,
+	logger *zap.Logger,
+	opts ...poi.SearchOption,
+) (*poi.SearchResult, error) {
+	// handle context cancelation
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+	covering, err := geo.CoverPolygon(rings, logger)
+	if err != nil {
+		return nil, err
+	}
+	// a polygon describes the exact search area, hence the locations are always filtered strictly
+	opts = append(opts, poi.WithStrict(true))
+	return r.search(ctx, logger, covering, opts)
+}
+
+func (r *PoIRepository) StreamByProximity(
+	ctx context.Context,
+	cntr poi.Coordinates,
+	radius float64,
+	logger *zap.Logger,
+	handle poi.SearchResultHandler,
+	opts ...poi.SearchOption,
+) error {
+	// handle context cancelation
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	covering, err := geo.CoverProximity(cntr, radius, logger)
+	if err != nil {
+		return err
+	}
+	return r.stream(ctx, logger, covering, handle, opts)
+}
+
+func (r *PoIRepository) StreamByBbox(
+	ctx context.Context,
+	sw, ne poi.Coordinates,
+	logger *zap.Logger,
+	handle poi.SearchResultHandler,
+	opts ...poi.SearchOption,
+) error {
+	// handle context cancelation
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	covering, err := geo.CoverBbox(sw, ne, logger)
+	if err != nil {
+		return err
+	}
+	return r.stream(ctx, logger, covering, handle, opts)
+}
+
+func (r *PoIRepository) StreamByRoute(
+	ctx context.Context,
+	path []poi.Coordinates,
+	logger *zap.Logger,
+	handle poi.SearchResultHandler,
+	opts ...poi.SearchOption,
+) error {
+	// handle context cancelation
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	covering, err := geo.CoverRoute(path, poi.NewSearchOptions(opts...).CorridorMeters, logger)
+	if err != nil {
+		return err
+	}
+	if covering.Area != nil {
+		opts = append(opts, poi.WithStrict(true))
+	}
+	return r.stream(ctx, logger, covering, handle, opts)
+}
+
+func (r *PoIRepository) GetNearest(
+	ctx context.Context,
+	cntr poi.Coordinates,
+	k int,
+	maxRadius float64,
+	logger *zap.Logger,
+	opts ...poi.SearchOption,
+) ([]*poi.PoILocation, error) {
+	// handle context cancelation
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+	if k <= 0 {
+		return []*poi.PoILocation{}, nil
+	}
+	filter := poi.NewSearchOptions(opts...).Filter
+	return geo.Nearest(cntr, k, maxRadius, filter, func(cells []s2.CellID) ([]*poi.PoILocation, error) {
+		return r.scanCells(ctx, cells, filter)
+	}, logger)
+}
+
+// GetClusters sums up the density aggregates for zoom levels up to the aggregate level, finer zoom levels are
+// clustered from the locations of the cells
+func (r *PoIRepository) GetClusters(
+	ctx context.Context,
+	sw, ne poi.Coordinates,
+	zoom int,
+	logger *zap.Logger,
+) (*poi.ClusterResult, error) {
+	// handle context cancelation
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+	if geo.ClustersFromAggregates(zoom) {
+		covering, err := geo.CoverClusterAggregates(sw, ne, logger)
+		if err != nil {
+			return nil, err
+		}
+		var aggregates geo.DensityAggregates
+		err = r.db.View(func(tx *bbolt.Tx) error {
+			var errS error
+			aggregates, errS = scanDensity(tx, covering.Cells)
+			return errS
+		})
+		if err != nil {
+			logger.Error("failed to scan density aggregates",
+				zap.Error(err),
+				zap.Int("num_cells", len(covering.Cells)),
+			)
+			return nil, poi.ErrDBQuery
+		}
+		return geo.NewClusterResultFromAggregates(aggregates, covering, zoom, logger), nil
+	}
+	covering, err := geo.CoverBbox(sw, ne, logger)
+	if err != nil {
+		return nil, err
+	}
+	locations, err := r.GetByCells(ctx, covering.Cells, logger)
+	if err != nil {
+		return nil, err
+	}
+	if len(locations) > geo.MaxClusterLocations {
+		return nil, fmt.Errorf("%w: more than %d locations to cluster", poi.ErrTooLargeSearchArea, geo.MaxClusterLocations)
+	}
+	return geo.NewClusterResult(locations, covering, zoom, logger), nil
+}
+
+// GetDensity sums up the density aggregates for levels up to the aggregate level, finer levels are counted from the
+// locations of the cells
+func (r *PoIRepository) GetDensity(
+	ctx context.Context,
+	area *poi.DensityArea,
+	level int,
+	logger *zap.Logger,
+) (*poi.DensityResult, error) {
+	// handle context cancelation
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+	covering, err := geo.CoverDensityArea(area, logger)
+	if err != nil {
+		return nil, err
+	}
+	cells := geo.DensityCells(covering, level)
+	if level > geo.DensityAggregateLevel {
+		locations, err := r.GetByCells(ctx, cells, logger)
+		if err != nil {
+			return nil, err
+		}
+		return geo.NewDensityResult(locations, cells, covering, level, logger), nil
+	}
+	var aggregates geo.DensityAggregates
+	err = r.db.View(func(tx *bbolt.Tx) error {
+		var errS error
+		aggregates, errS = scanDensity(tx, cells)
+		return errS
+	})
+	if err != nil {
+		logger.Error("failed to scan density aggregates",
+			zap.Error(err),
+			zap.Int("num_cells", len(cells)),
+		)
+		return nil, poi.ErrDBQuery
+	}
+	return geo.NewDensityResultFromAggregates(aggregates, cells, covering, level, logger), nil
+}
+
+func (r *PoIRepository) GetByCells(
+	ctx context.Context,
+	cells []s2.CellID,
+	logger *zap.Logger,
+) ([]*poi.PoILocation, error) {
+	// handle context cancelation
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+	locations, err := r.scanCells(ctx, cells, nil)
+	if err != nil {
+		logger.Error("failed to scan cells",
+			zap.Error(err),
+			zap.Int("num_cells", len(cells)),
+		)
+		return nil, poi.ErrDBQuery
+	}
+	return locations, nil
+}
+
+// search scans the cells of the covering either all at once or page by page and applies the search options
+// to the locations found. If area is nil the locations are not filtered strictly.
+func (r *PoIRepository) search(
+	ctx context.Context,
+	logger *zap.Logger,
+	covering *geo.Covering,
+	opts []poi.SearchOption,
+) (*poi.SearchResult, error) {
+	options := poi.NewSearchOptions(opts...)
+	if options.Paged() {
+		res, err := geo.NewPagedSearchResult(func(token string) ([]*poi.PoILocation, string, error) {
+			return r.pagedScan(ctx, logger, covering.Cells, options, token)
+		}, covering, options, logger)
+		return res, scanError(err, logger)
+	}
+	locations, err := r.scanCells(ctx, covering.Cells, options.Filter)
+	if err != nil {
+		return nil, scanError(err, logger)
+	}
+	return geo.NewSearchResult(locations, covering, options, logger), nil
+}
+
+// scanError logs the error of a failed scan and returns the error to be returned to the caller
+func scanError(err error, logger *zap.Logger) error {
+	if err == nil || errors.Is(err, poi.ErrInvalidPageToken) {
+		return err
+	}
+	logger.Error("failed to scan geo index",
+		zap.Error(err),
+	)
+	return poi.ErrDBQuery
+}
+
+// pagedScan scans the cells of the covering in order until the page is full, starting at the position
+// of the page token. It returns the token for the next page, which is empty if all cells have been scanned.
+func (r *PoIRepository) pagedScan(
+	ctx context.Context,
+	logger *zap.Logger,
+	cells []s2.CellID,
+	options *poi.SearchOptions,
+	token string,
+) ([]*poi.PoILocation, string, error) {
+	covering := geo.Fingerprint(cells, options)
+	start := &pageToken{Covering: covering}
+	if token != "" {
+		t := new(pageToken)
+		err := r.pageTokens.Decode(token, t)
+		if err == nil {
+			err = t.valid()
+		}
+		if err != nil {
+			logger.Warn("failed to decode page token", zap.Error(err))
+			return nil, "", poi.ErrInvalidPageToken
+		}
+		if t.Covering != covering || t.Cell < 0 || t.Cell >= len(cells) {
+			logger.Warn("page token does not match the search",
+				zap.Int("cell", t.Cell),
+				zap.Int("num_cells", len(cells)),
+			)
+			return nil, "", poi.ErrInvalidPageToken
+		}
+		start = t
+	}
+
+	locations := make([]*poi.PoILocation, 0, options.PageSize)
+	var next *pageToken
+	err := r.db.View(func(tx *bbolt.Tx) error {
+		after := start.LastKey
+		for i := start.Cell; i < len(cells) && next == nil; i++ {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			var mapErr error
+			errS := scanCell(tx, cells[i], after, func(key []byte, item *dynamo.CPoIItem) bool {
+				l, errD := item.Domain()
+				if errD != nil {
+					mapErr = errD
+					return false
+				}
+				if options.Filter.Matches(l) {
+					locations = append(locations, l)
+				}
+				if len(locations) < options.PageSize {
+					return true
+				}
+				// the page is full within the cell, continue in the same cell after the last scanned key,
+				// keys are only valid within the transaction
+				next = &pageToken{Covering: covering, Cell: i, LastKey: bytes.Clone(key)}
+				return false
+			})
+			if errS != nil {
+				return errS
+			}
+			if mapErr != nil {
+				return mapErr
+			}
+			after = nil
+		}
+		return nil
+	})
+	if err != nil || next == nil {
+		return locations, "", err
+	}
+	nextToken, err := r.pageTokens.Encode(next)
+	return locations, nextToken, err
+}
+
+// stream scans the cells of the covering and hands the search result of each cell to the handler
+func (r *PoIRepository) stream(
+	ctx context.Context,
+	logger *zap.Logger,
+	covering *geo.Covering,
+	handle poi.SearchResultHandler,
+	opts []poi.SearchOption,
+) error {
+	options := poi.NewSearchOptions(opts...)
+	for _, c := range covering.Cells {
+		// the handler is called outside of the read transaction so that slow clients do not block writers
+		locations, err := r.scanCells(ctx, []s2.CellID{c}, options.Filter)
+		if err != nil {
+			logger.Error("failed to stream geo index",
+				zap.Error(err),
+			)
+			return poi.ErrDBQuery
+		}
+		if len(locations) == 0 {
+			continue
+		}
+		if err := handle(geo.NewSearchResult(locations, covering, options, logger)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// scanCells returns the locations within the cells matching the filter
+func (r *PoIRepository) scanCells(
+	ctx context.Context,
+	cells []s2.CellID,
+	filter *poi.SearchFilter,
+) ([]*poi.PoILocation, error) {
+	locations := make([]*poi.PoILocation, 0)
+	err := r.db.View(func(tx *bbolt.Tx) error {
+		for _, c := range cells {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			var mapErr error
+			errS := scanCell(tx, c, nil, func(_ []byte, item *dynamo.CPoIItem) bool {
+				l, errD := item.Domain()
+				if errD != nil {
+					mapErr = errD
+					return false
+				}
+				if filter.Matches(l) {
+					locations = append(locations, l)
+				}
+				return true
+			})
+			if errS != nil {
+				return errS
+			}
+			if mapErr != nil {
+				return mapErr
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return locations, nil
+}
+
+// checkVersion verifies that the item exists with the version, any version is accepted for poi.AnyVersion.
+// Items imported without version have version zero.
+func checkVersion(stored *dynamo.CPoIItem, version int64) error {
+	if stored == nil {
+		return poi.ErrLocationNotFound
+	}
+	if version != poi.AnyVersion && stored.Version != version {
+		return poi.ErrVersionMismatch
+	}
+	return nil
+}
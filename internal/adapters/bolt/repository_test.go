@@ -0,0 +1,295 @@
+package bolt_test
+
+import (
+	"context"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/segmentio/ksuid"
+	"go.uber.org/zap"
+
+	"github.com/grntlrduck-cloud/go-grpc-geohasing-service-sample/internal/adapters/bolt"
+	"github.com/grntlrduck-cloud/go-grpc-geohasing-service-sample/internal/adapters/memory"
+	"github.com/grntlrduck-cloud/go-grpc-geohasing-service-sample/internal/domain/poi"
+)
+
+const testDatapath = "../../../config/db/local/cpoi_dynamo_items_int_test.csv"
+
+var _ = Describe("given bolt repository with test data", Ordered, func() {
+	ctx := context.Background()
+	logger := zap.NewNop()
+	sw := poi.Coordinates{Longitude: 8.494772, Latitude: 49.425026}
+	ne := poi.Coordinates{Longitude: 10.040508, Latitude: 50.089540}
+	cntr := poi.Coordinates{Longitude: 9.147263, Latitude: 49.333418}
+	var (
+		path       string
+		repository *bolt.PoIRepository
+		reference  poi.Repository
+	)
+
+	open := func() *bolt.PoIRepository {
+		repo, err := bolt.NewPoIRepository(
+			logger,
+			bolt.WithPath(path),
+			bolt.WithLoadInitData(true),
+			bolt.WithInitDataOverride(testDatapath),
+		)
+		Expect(err).To(Not(HaveOccurred()))
+		return repo
+	}
+
+	BeforeAll(func() {
+		path = filepath.Join(GinkgoT().TempDir(), "poi.db")
+		repository = open()
+		var err error
+		reference, err = memory.NewPoIRepository(
+			logger,
+			memory.WithLoadInitData(true),
+			memory.WithInitDataOverride(testDatapath),
+		)
+		Expect(err).To(Not(HaveOccurred()))
+	})
+
+	AfterAll(func() {
+		Expect(repository.Close()).To(Succeed())
+	})
+
+	When("location query received", func() {
+		It("get poi by id returns poi location as expected", func() {
+			kID, err := ksuid.Parse("2ofD9igSisfEtgC743gf3BnzO7L")
+			Expect(err).To(Not(HaveOccurred()))
+			location, err := repository.GetByID(ctx, kID, logger)
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(location.ID).To(Equal(kID))
+			_, err = repository.GetByID(ctx, ksuid.New(), logger)
+			Expect(err).To(Equal(poi.ErrLocationNotFound))
+		})
+
+		It("get location by bbox and proximity search returns the same locations as the in-memory repository", func() {
+			expected, err := reference.GetByBbox(ctx, sw, ne, logger)
+			Expect(err).To(Not(HaveOccurred()))
+			res, err := repository.GetByBbox(ctx, sw, ne, logger)
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(ids(res.Locations)).To(ConsistOf(ids(expected.Locations)))
+
+			expected, err = reference.GetByProximity(ctx, cntr, 30_000.0, logger, poi.WithStrict(true))
+			Expect(err).To(Not(HaveOccurred()))
+			res, err = repository.GetByProximity(ctx, cntr, 30_000.0, logger, poi.WithStrict(true))
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(len(res.Locations)).To(BeNumerically(">", 0))
+			Expect(ids(res.Locations)).To(ConsistOf(ids(expected.Locations)))
+			Expect(res.Discarded).To(Equal(expected.Discarded))
+		})
+
+		It("get location by bbox search with page size returns all locations page by page", func() {
+			all, err := repository.GetByBbox(ctx, sw, ne, logger)
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(len(all.Locations)).To(BeNumerically(">", 70))
+			pageSize := 7
+			seen := make(map[string]bool)
+			token := ""
+			for {
+				res, errP := repository.GetByBbox(ctx, sw, ne, logger, poi.WithPage(pageSize, token))
+				Expect(errP).To(Not(HaveOccurred()))
+				Expect(len(res.Locations)).To(BeNumerically("<=", pageSize))
+				for _, p := range res.Locations {
+					Expect(seen).To(Not(HaveKey(p.ID.String())))
+					seen[p.ID.String()] = true
+				}
+				if res.NextPageToken == "" {
+					break
+				}
+				token = res.NextPageToken
+			}
+			Expect(seen).To(HaveLen(len(all.Locations)))
+		})
+
+		It("get location by proximity search with page token of other search returns error", func() {
+			res, err := repository.GetByBbox(ctx, sw, ne, logger, poi.WithPage(5, ""))
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(res.NextPageToken).To(Not(BeEmpty()))
+			_, err = repository.GetByProximity(ctx, cntr, 30_000.0, logger, poi.WithPage(5, res.NextPageToken))
+			Expect(err).To(Equal(poi.ErrInvalidPageToken))
+			_, err = repository.GetByBbox(ctx, sw, ne, logger, poi.WithPage(5, "x"+res.NextPageToken))
+			Expect(err).To(Equal(poi.ErrInvalidPageToken))
+		})
+
+		It("stream location by bbox search hands over all locations cell by cell", func() {
+			all, err := repository.GetByBbox(ctx, sw, ne, logger)
+			Expect(err).To(Not(HaveOccurred()))
+			numResults, numLocations := 0, 0
+			err = repository.StreamByBbox(ctx, sw, ne, logger, func(res *poi.SearchResult) error {
+				numResults++
+				numLocations += len(res.Locations)
+				return nil
+			})
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(numResults).To(BeNumerically(">", 1))
+			Expect(numLocations).To(Equal(len(all.Locations)))
+		})
+
+		It("get nearest locations returns the same locations as the in-memory repository", func() {
+			expected, err := reference.GetNearest(ctx, cntr, 5, 100_000.0, logger)
+			Expect(err).To(Not(HaveOccurred()))
+			pois, err := repository.GetNearest(ctx, cntr, 5, 100_000.0, logger)
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(pois).To(HaveLen(5))
+			Expect(ids(pois)).To(Equal(ids(expected)))
+		})
+
+		It("get clusters returns the same clusters as the in-memory repository", func() {
+			expected, err := reference.GetClusters(ctx, sw, ne, 8, logger)
+			Expect(err).To(Not(HaveOccurred()))
+			res, err := repository.GetClusters(ctx, sw, ne, 8, logger)
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(res.Clusters).To(Not(BeEmpty()))
+			Expect(res).To(Equal(expected))
+		})
+
+		It("get density returns the same cells as the in-memory repository", func() {
+			for _, level := range []int{6, 10, 14} {
+				area := &poi.DensityArea{SW: sw, NE: ne}
+				expected, err := reference.GetDensity(ctx, area, level, logger)
+				Expect(err).To(Not(HaveOccurred()))
+				res, err := repository.GetDensity(ctx, area, level, logger)
+				Expect(err).To(Not(HaveOccurred()))
+				Expect(res.Cells).To(Not(BeEmpty()))
+				Expect(res).To(Equal(expected))
+			}
+		})
+	})
+
+	When("location is written", func() {
+		newLocation := func() poi.PoILocation {
+			return poi.PoILocation{
+				ID:               ksuid.New(),
+				Location:         poi.Coordinates{Latitude: 64.135482, Longitude: -21.895410},
+				LocationEntrance: poi.Coordinates{Latitude: 64.135482, Longitude: -21.895410},
+				Address: poi.Address{
+					Street:      "Laugavegur",
+					City:        "Reykjavik",
+					ZipCode:     "101",
+					CountryCode: "ISL",
+				},
+				Features: []string{"AC_CHARGING"},
+			}
+		}
+
+		It("create, update and delete poi with conditions on versions as expected", func() {
+			location := newLocation()
+			err := repository.Update(ctx, &location, logger)
+			Expect(err).To(Equal(poi.ErrLocationNotFound))
+			err = repository.Create(ctx, &location, logger)
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(location.Version).To(Equal(int64(1)))
+			err = repository.Create(ctx, &location, logger)
+			Expect(err).To(Equal(poi.ErrLocationAlreadyExists))
+
+			stale := location
+			err = repository.Update(ctx, &location, logger)
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(location.Version).To(Equal(int64(2)))
+			err = repository.Update(ctx, &stale, logger)
+			Expect(err).To(Equal(poi.ErrVersionMismatch))
+			err = repository.Delete(ctx, location.ID, stale.Version, logger)
+			Expect(err).To(Equal(poi.ErrVersionMismatch))
+
+			err = repository.Delete(ctx, location.ID, location.Version, logger)
+			Expect(err).To(Not(HaveOccurred()))
+			_, err = repository.GetByID(ctx, location.ID, logger)
+			Expect(err).To(Equal(poi.ErrLocationNotFound))
+		})
+
+		It("moved location is only found at its new position", func() {
+			location := newLocation()
+			Expect(repository.Upsert(ctx, &location, logger)).To(Succeed())
+			oldPosition := location.Location
+			location.Location = poi.Coordinates{Latitude: 65.683424, Longitude: -18.110554}
+			Expect(repository.Upsert(ctx, &location, logger)).To(Succeed())
+
+			res, err := repository.GetByProximity(ctx, oldPosition, 1_000.0, logger, poi.WithStrict(true))
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(res.Locations).To(Not(ContainElement(HaveField("ID", location.ID))))
+			res, err = repository.GetByProximity(ctx, location.Location, 1_000.0, logger, poi.WithStrict(true))
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(res.Locations).To(ContainElement(HaveField("ID", location.ID)))
+		})
+
+		It("written location is still found after the file has been reopened", func() {
+			location := newLocation()
+			Expect(repository.Create(ctx, &location, logger)).To(Succeed())
+			Expect(repository.Close()).To(Succeed())
+			repository = open()
+
+			actual, err := repository.GetByID(ctx, location.ID, logger)
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(actual.Version).To(Equal(int64(1)))
+			Expect(actual.Address).To(Equal(location.Address))
+			res, err := repository.GetByProximity(ctx, location.Location, 1_000.0, logger)
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(res.Locations).To(ContainElement(HaveField("ID", location.ID)))
+		})
+
+		It("density aggregates follow created, moved and deleted locations", func() {
+			density := func(c poi.Coordinates, level int) int {
+				res, err := repository.GetDensity(ctx, &poi.DensityArea{
+					SW: poi.Coordinates{Latitude: c.Latitude - 0.001, Longitude: c.Longitude - 0.001},
+					NE: poi.Coordinates{Latitude: c.Latitude + 0.001, Longitude: c.Longitude + 0.001},
+				}, level, logger)
+				Expect(err).To(Not(HaveOccurred()))
+				count := 0
+				for _, cell := range res.Cells {
+					count += cell.Count
+				}
+				return count
+			}
+			location := newLocation()
+			oldPosition := location.Location
+			newPosition := poi.Coordinates{Latitude: 65.683424, Longitude: -18.110554}
+			before, beforeMoved := density(oldPosition, 8), density(newPosition, 8)
+			Expect(density(oldPosition, 16)).To(BeNumerically("<=", before))
+
+			Expect(repository.Create(ctx, &location, logger)).To(Succeed())
+			Expect(density(oldPosition, 8)).To(Equal(before + 1))
+			location.Location = newPosition
+			Expect(repository.Update(ctx, &location, logger)).To(Succeed())
+			Expect(density(oldPosition, 8)).To(Equal(before))
+			Expect(density(newPosition, 8)).To(Equal(beforeMoved + 1))
+			Expect(repository.Delete(ctx, location.ID, location.Version, logger)).To(Succeed())
+			Expect(density(newPosition, 8)).To(Equal(beforeMoved))
+		})
+
+		It("upsert poi with invalid coordinates returns error", func() {
+			location := newLocation()
+			location.Location.Latitude = 91
+			Expect(repository.Upsert(ctx, &location, logger)).To(Not(Succeed()))
+			report, err := repository.UpsertBatch(ctx, []*poi.PoILocation{&location}, logger)
+			Expect(err).To(Equal(poi.ErrDBBatchUpsert))
+			Expect(report.Failed()).To(HaveLen(1))
+		})
+	})
+})
+
+func ids(locations []*poi.PoILocation) []string {
+	res := make([]string, len(locations))
+	for i, l := range locations {
+		res[i] = l.ID.String()
+	}
+	return res
+}
+
+var _ = Describe("given bolt repository without test data", func() {
+	It("search in empty file returns no locations", func() {
+		repository, err := bolt.NewPoIRepository(
+			zap.NewNop(),
+			bolt.WithPath(filepath.Join(GinkgoT().TempDir(), "empty.db")),
+		)
+		Expect(err).To(Not(HaveOccurred()))
+		defer repository.Close()
+		cntr := poi.Coordinates{Longitude: 9.147263, Latitude: 49.333418}
+		res, err := repository.GetByProximity(context.Background(), cntr, 30_000.0, zap.NewNop())
+		Expect(err).To(Not(HaveOccurred()))
+		Expect(res.Locations).To(BeEmpty())
+	})
+})
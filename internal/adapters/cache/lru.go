@@ -0,0 +1,81 @@
+package cache
+
+import (
+	"container/list"
+	"time"
+)
+
+// The lru holds at most size entries, adding an entry to a full lru evicts the least recently used entry.
+// Entries expire after the ttl, expired entries are evicted when they are accessed. The lru is not safe for
+// concurrent use.
+type lru[K comparable, V any] struct {
+	size    int
+	ttl     time.Duration
+	now     func() time.Time
+	order   *list.List // front is the most recently used entry
+	entries map[K]*list.Element
+	// onEvict is called for each entry leaving the lru, may be nil
+	onEvict func(key K, value V)
+}
+
+type lruEntry[K comparable, V any] struct {
+	key     K
+	value   V
+	expires time.Time
+}
+
+func newLRU[K comparable, V any](size int, ttl time.Duration, now func() time.Time) *lru[K, V] {
+	return &lru[K, V]{
+		size:    size,
+		ttl:     ttl,
+		now:     now,
+		order:   list.New(),
+		entries: make(map[K]*list.Element, size),
+	}
+}
+
+func (c *lru[K, V]) get(key K) (V, bool) {
+	var zero V
+	e, ok := c.entries[key]
+	if !ok {
+		return zero, false
+	}
+	entry := e.Value.(*lruEntry[K, V])
+	if !c.now().Before(entry.expires) {
+		c.evict(e)
+		return zero, false
+	}
+	c.order.MoveToFront(e)
+	return entry.value, true
+}
+
+// add stores the value and reports whether an entry has been evicted to make room for it
+func (c *lru[K, V]) add(key K, value V) bool {
+	if e, ok := c.entries[key]; ok {
+		c.evict(e)
+	}
+	c.entries[key] = c.order.PushFront(&lruEntry[K, V]{key: key, value: value, expires: c.now().Add(c.ttl)})
+	if c.order.Len() <= c.size {
+		return false
+	}
+	c.evict(c.order.Back())
+	return true
+}
+
+func (c *lru[K, V]) remove(key K) {
+	if e, ok := c.entries[key]; ok {
+		c.evict(e)
+	}
+}
+
+func (c *lru[K, V]) len() int {
+	return c.order.Len()
+}
+
+func (c *lru[K, V]) evict(e *list.Element) {
+	entry := c.order.Remove(e).(*lruEntry[K, V])
+	delete(c.entries, entry.key)
+	if c.onEvict != nil {
+		c.onEvict(entry.key, entry.value)
+	}
+}
@@ -0,0 +1,63 @@
+package cache
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("given lru", func() {
+	var (
+		now     time.Time
+		cache   *lru[string, int]
+		evicted []string
+	)
+
+	BeforeEach(func() {
+		now = time.Unix(0, 0)
+		evicted = nil
+		cache = newLRU[string, int](2, time.Minute, func() time.Time { return now })
+		cache.onEvict = func(key string, _ int) {
+			evicted = append(evicted, key)
+		}
+	})
+
+	When("entries are added to full lru", func() {
+		It("evicts the least recently used entry", func() {
+			Expect(cache.add("a", 1)).To(BeFalse())
+			Expect(cache.add("b", 2)).To(BeFalse())
+			_, ok := cache.get("a")
+			Expect(ok).To(BeTrue())
+			Expect(cache.add("c", 3)).To(BeTrue())
+			Expect(evicted).To(Equal([]string{"b"}))
+			Expect(cache.len()).To(Equal(2))
+			v, ok := cache.get("a")
+			Expect(ok).To(BeTrue())
+			Expect(v).To(Equal(1))
+		})
+
+		It("replaces the value of an existing entry without eviction", func() {
+			cache.add("a", 1)
+			cache.add("b", 2)
+			Expect(cache.add("a", 3)).To(BeFalse())
+			v, _ := cache.get("a")
+			Expect(v).To(Equal(3))
+			Expect(cache.len()).To(Equal(2))
+		})
+	})
+
+	When("entry is expired", func() {
+		It("is evicted on access", func() {
+			cache.add("a", 1)
+			now = now.Add(59 * time.Second)
+			_, ok := cache.get("a")
+			Expect(ok).To(BeTrue())
+			now = now.Add(time.Second)
+			_, ok = cache.get("a")
+			Expect(ok).To(BeFalse())
+			Expect(evicted).To(Equal([]string{"a"}))
+			Expect(cache.len()).To(BeZero())
+		})
+	})
+})
@@ -0,0 +1,495 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/golang/geo/s2"
+	"github.com/segmentio/ksuid"
+	"go.uber.org/zap"
+
+	"github.com/grntlrduck-cloud/go-grpc-geohasing-service-sample/internal/adapters/geo"
+	"github.com/grntlrduck-cloud/go-grpc-geohasing-service-sample/internal/domain/poi"
+)
+
+const (
+	defaultMaxCells     = 10_000
+	defaultMaxLocations = 10_000
+	defaultTTL          = 5 * time.Minute
+)
+
+// The PoIRepository is a read-through cache in front of another repository. It caches the locations of each cell
+// of a search covering and the locations looked up by id, so that overlapping searches, e.g. of a panned map,
+// only query the cells which have not been searched before. Paged searches and streams are not cached since the
+// page tokens belong to the decorated repository. Searches are only cached if the decorated repository is a
+// geo.CellRepository.
+//
+// Each write invalidates the cells containing the old and the new position of the written locations. Writes by
+// other instances of the service are not visible until the cached entries expire.
+type PoIRepository struct {
+	poi.Repository // serves the calls which are not cached
+	cellRepo       geo.CellRepository
+
+	mu        sync.Mutex
+	cells     *lru[s2.CellID, []*poi.PoILocation]
+	locations *lru[ksuid.KSUID, *poi.PoILocation]
+	// cellsByID are the cached cells containing a location, used to invalidate the old position of a location
+	cellsByID map[ksuid.KSUID]map[s2.CellID]struct{}
+	// generation is incremented by each write, results queried before a write are not cached
+	generation uint64
+
+	maxCells     int
+	maxLocations int
+	ttl          time.Duration
+	now          func() time.Time
+
+	cellHits       atomic.Uint64
+	cellMisses     atomic.Uint64
+	locationHits   atomic.Uint64
+	locationMisses atomic.Uint64
+	evictions      atomic.Uint64
+}
+
+// The Stats count the lookups of the cache, a search counts a hit or miss for each cell of its covering
+type Stats struct {
+	CellHits       uint64
+	CellMisses     uint64
+	LocationHits   uint64
+	LocationMisses uint64
+	// Evictions counts the entries removed to make room for new entries, not expired or invalidated entries
+	Evictions uint64
+}
+
+type PoIRepositoryOptions func(r *PoIRepository)
+
+func WithMaxCells(maxCells int) PoIRepositoryOptions {
+	return func(r *PoIRepository) {
+		r.maxCells = maxCells
+	}
+}
+
+func WithMaxLocations(maxLocations int) PoIRepositoryOptions {
+	return func(r *PoIRepository) {
+		r.maxLocations = maxLocations
+	}
+}
+
+func WithTTL(ttl time.Duration) PoIRepositoryOptions {
+	return func(r *PoIRepository) {
+		r.ttl = ttl
+	}
+}
+
+func NewPoIRepository(repo poi.Repository, opts ...PoIRepositoryOptions) *PoIRepository {
+	r := &PoIRepository{
+		Repository:   repo,
+		cellsByID:    make(map[ksuid.KSUID]map[s2.CellID]struct{}),
+		maxCells:     defaultMaxCells,
+		maxLocations: defaultMaxLocations,
+		ttl:          defaultTTL,
+		now:          time.Now,
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+	r.cellRepo, _ = repo.(geo.CellRepository)
+	r.cells = newLRU[s2.CellID, []*poi.PoILocation](r.maxCells, r.ttl, r.now)
+	r.cells.onEvict = r.unregisterCell
+	r.locations = newLRU[ksuid.KSUID, *poi.PoILocation](r.maxLocations, r.ttl, r.now)
+	return r
+}
+
+func (r *PoIRepository) Stats() Stats {
+	return Stats{
+		CellHits:       r.cellHits.Load(),
+		CellMisses:     r.cellMisses.Load(),
+		LocationHits:   r.locationHits.Load(),
+		LocationMisses: r.locationMisses.Load(),
+		Evictions:      r.evictions.Load(),
+	}
+}
+
+func (r *PoIRepository) UpsertBatch(
+	ctx context.Context,
+	pois []*poi.PoILocation,
+	logger *zap.Logger,
+) (*poi.BatchWriteReport, error) {
+	// a failed batch might have been written partially, hence the cache is always invalidated
+	defer r.invalidate(pois...)
+	return r.Repository.UpsertBatch(ctx, pois, logger)
+}
+
+func (r *PoIRepository) Upsert(ctx context.Context, domain *poi.PoILocation, logger *zap.Logger) error {
+	defer r.invalidate(domain)
+	return r.Repository.Upsert(ctx, domain, logger)
+}
+
+func (r *PoIRepository) Create(ctx context.Context, domain *poi.PoILocation, logger *zap.Logger) error {
+	defer r.invalidate(domain)
+	return r.Repository.Create(ctx, domain, logger)
+}
+
+func (r *PoIRepository) Update(ctx context.Context, domain *poi.PoILocation, logger *zap.Logger) error {
+	defer r.invalidate(domain)
+	return r.Repository.Update(ctx, domain, logger)
+}
+
+func (r *PoIRepository) Delete(ctx context.Context, id ksuid.KSUID, version int64, logger *zap.Logger) error {
+	defer r.invalidateID(id)
+	return r.Repository.Delete(ctx, id, version, logger)
+}
+
+func (r *PoIRepository) GetByID(
+	ctx context.Context,
+	id ksuid.KSUID,
+	logger *zap.Logger,
+) (*poi.PoILocation, error) {
+	locations, err := r.GetByIDs(ctx, []ksuid.KSUID{id}, logger)
+	if err != nil {
+		return nil, err
+	}
+	if len(locations) == 0 {
+		return nil, poi.ErrLocationNotFound
+	}
+	return locations[0], nil
+}
+
+func (r *PoIRepository) GetByIDs(
+	ctx context.Context,
+	ids []ksuid.KSUID,
+	logger *zap.Logger,
+) ([]*poi.PoILocation, error) {
+	// handle context cancelation
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+	locations := make([]*poi.PoILocation, 0, len(ids))
+	missing := make([]ksuid.KSUID, 0, len(ids))
+	seen := make(map[ksuid.KSUID]bool, len(ids))
+	r.mu.Lock()
+	generation := r.generation
+	for _, id := range ids {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		if l, ok := r.locations.get(id); ok {
+			locations = append(locations, l.Clone())
+			continue
+		}
+		missing = append(missing, id)
+	}
+	r.mu.Unlock()
+	r.locationHits.Add(uint64(len(locations)))
+	r.locationMisses.Add(uint64(len(missing)))
+	if len(missing) == 0 {
+		return locations, nil
+	}
+
+	queried, err := r.Repository.GetByIDs(ctx, missing, logger)
+	if err != nil {
+		return nil, err
+	}
+	r.mu.Lock()
+	if r.generation == generation {
+		for _, l := range queried {
+			r.evicted(r.locations.add(l.ID, l.Clone()))
+		}
+	}
+	r.mu.Unlock()
+	return append(locations, queried...), nil
+}
+
+func (r *PoIRepository) GetByProximity(
+	ctx context.Context,
+	cntr poi.Coordinates,
+	radius float64,
+	logger *zap.Logger,
+	opts ...poi.SearchOption,
+) (*poi.SearchResult, error) {
+	if !r.cached(opts) {
+		return r.Repository.GetByProximity(ctx, cntr, radius, logger, opts...)
+	}
+	// handle context cancelation
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+	covering, err := geo.CoverProximity(cntr, radius, logger)
+	if err != nil {
+		return nil, err
+	}
+	return r.search(ctx, logger, covering, opts)
+}
+
+func (r *PoIRepository) GetByBbox(
+	ctx context.Context,
+	sw, ne poi.Coordinates,
+	logger *zap.Logger,
+	opts ...poi.SearchOption,
+) (*poi.SearchResult, error) {
+	if !r.cached(opts) {
+		return r.Repository.GetByBbox(ctx, sw, ne, logger, opts...)
+	}
+	// handle context cancelation
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+	covering, err := geo.CoverBbox(sw, ne, logger)
+	if err != nil {
+		return nil, err
+	}
+	return r.search(ctx, logger, covering, opts)
+}
+
+func (r *PoIRepository) GetByRoute(
+	ctx context.Context,
+	path []poi.Coordinates,
+	logger *zap.Logger,
+	opts ...poi.SearchOption,
+) (*poi.SearchResult, error) {
+	if !r.cached(opts) {
+		return r.Repository.GetByRoute(ctx, path, logger, opts...)
+	}
+	// handle context cancelation
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+	covering, err := geo.CoverRoute(path, poi.NewSearchOptions(opts...).CorridorMeters, logger)
+	if err != nil {
+		return nil, err
+	}
+	// a corridor describes the exact search area and the locations are always filtered strictly
+	if covering.Area != nil {
+		opts = append(opts, poi.WithStrict(true))
+	}
+	return r.search(ctx, logger, covering, opts)
+}
+
+func (r *PoIRepository) GetByPolygon(
+	ctx context.Context,
+	rings [][]poi.Coordinates,
+	logger *zap.Logger,
+	opts ...poi.SearchOption,
+) (*poi.SearchResult, error) {
+	if !r.cached(opts) {
+		return r.Repository.GetByPolygon(ctx, rings, logger, opts...)
+	}
+	// handle context cancelation
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+	covering, err := geo.CoverPolygon(rings, logger)
+	if err != nil {
+		return nil, err
+	}
+	// a polygon describes the exact search area, hence the locations are always filtered strictly
+	opts = append(opts, poi.WithStrict(true))
+	return r.search(ctx, logger, covering, opts)
+}
+
+func (r *PoIRepository) GetNearest(
+	ctx context.Context,
+	cntr poi.Coordinates,
+	k int,
+	maxRadius float64,
+	logger *zap.Logger,
+	opts ...poi.SearchOption,
+) ([]*poi.PoILocation, error) {
+	if r.cellRepo == nil {
+		return r.Repository.GetNearest(ctx, cntr, k, maxRadius, logger, opts...)
+	}
+	// handle context cancelation
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+	if k <= 0 {
+		return []*poi.PoILocation{}, nil
+	}
+	filter := poi.NewSearchOptions(opts...).Filter
+	return geo.Nearest(cntr, k, maxRadius, filter, func(cells []s2.CellID) ([]*poi.PoILocation, error) {
+		return r.getByCells(ctx, cells, logger)
+	}, logger)
+}
+
+func (r *PoIRepository) GetClusters(
+	ctx context.Context,
+	sw, ne poi.Coordinates,
+	zoom int,
+	logger *zap.Logger,
+) (*poi.ClusterResult, error) {
+	if r.cellRepo == nil || geo.ClustersFromAggregates(zoom) {
+		return r.Repository.GetClusters(ctx, sw, ne, zoom, logger)
+	}
+	// handle context cancelation
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+	covering, err := geo.CoverBbox(sw, ne, logger)
+	if err != nil {
+		return nil, err
+	}
+	locations, err := r.getByCells(ctx, covering.Cells, logger)
+	if err != nil {
+		return nil, err
+	}
+	if len(locations) > geo.MaxClusterLocations {
+		return nil, fmt.Errorf("%w: more than %d locations to cluster", poi.ErrTooLargeSearchArea, geo.MaxClusterLocations)
+	}
+	return geo.NewClusterResult(locations, covering, zoom, logger), nil
+}
+
+// cached reports whether a search with the options is served from the cache
+func (r *PoIRepository) cached(opts []poi.SearchOption) bool {
+	return r.cellRepo != nil && !poi.NewSearchOptions(opts...).Paged()
+}
+
+// search returns the locations of the cells matching the filter and applies the search options
+func (r *PoIRepository) search(
+	ctx context.Context,
+	logger *zap.Logger,
+	covering *geo.Covering,
+	opts []poi.SearchOption,
+) (*poi.SearchResult, error) {
+	options := poi.NewSearchOptions(opts...)
+	locations, err := r.getByCells(ctx, covering.Cells, logger)
+	if err != nil {
+		return nil, err
+	}
+	locations = slices.DeleteFunc(locations, func(l *poi.PoILocation) bool {
+		return !options.Filter.Matches(l)
+	})
+	return geo.NewSearchResult(locations, covering, options, logger), nil
+}
+
+// getByCells returns copies of all locations within the cells, the cells which are not cached are queried from
+// the decorated repository at once and cached afterwards
+func (r *PoIRepository) getByCells(
+	ctx context.Context,
+	cells []s2.CellID,
+	logger *zap.Logger,
+) ([]*poi.PoILocation, error) {
+	locations := make([]*poi.PoILocation, 0)
+	missing := make([]s2.CellID, 0, len(cells))
+	r.mu.Lock()
+	generation := r.generation
+	for _, c := range cells {
+		cached, ok := r.cells.get(c)
+		if !ok {
+			missing = append(missing, c)
+			continue
+		}
+		for _, l := range cached {
+			locations = append(locations, l.Clone())
+		}
+	}
+	r.mu.Unlock()
+	r.cellHits.Add(uint64(len(cells) - len(missing)))
+	r.cellMisses.Add(uint64(len(missing)))
+	logger.Debug("looked up cells in cache",
+		zap.Int("num_cells", len(cells)),
+		zap.Int("num_missing", len(missing)),
+	)
+	if len(missing) == 0 {
+		return locations, nil
+	}
+
+	queried, err := r.cellRepo.GetByCells(ctx, missing, logger)
+	if err != nil {
+		return nil, err
+	}
+	byCell := groupByCell(missing, queried)
+	r.mu.Lock()
+	if r.generation == generation {
+		for _, c := range missing {
+			r.addCell(c, byCell[c])
+		}
+	}
+	r.mu.Unlock()
+	return append(locations, queried...), nil
+}
+
+// addCell caches copies of the locations of the cell, requires the lock to be held
+func (r *PoIRepository) addCell(cell s2.CellID, locations []*poi.PoILocation) {
+	cached := make([]*poi.PoILocation, len(locations))
+	for i, l := range locations {
+		cached[i] = l.Clone()
+		if r.cellsByID[l.ID] == nil {
+			r.cellsByID[l.ID] = make(map[s2.CellID]struct{})
+		}
+		r.cellsByID[l.ID][cell] = struct{}{}
+	}
+	r.evicted(r.cells.add(cell, cached))
+}
+
+// unregisterCell removes the cell leaving the cache from the cells of its locations, requires the lock to be held
+func (r *PoIRepository) unregisterCell(cell s2.CellID, locations []*poi.PoILocation) {
+	for _, l := range locations {
+		delete(r.cellsByID[l.ID], cell)
+		if len(r.cellsByID[l.ID]) == 0 {
+			delete(r.cellsByID, l.ID)
+		}
+	}
+}
+
+// invalidate removes the locations and all cells containing their old or new position from the cache
+func (r *PoIRepository) invalidate(pois ...*poi.PoILocation) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.generation++
+	for _, l := range pois {
+		r.removeID(l.ID)
+		leaf := s2.CellIDFromLatLng(s2.LatLngFromDegrees(l.Location.Latitude, l.Location.Longitude))
+		for level := range s2.MaxLevel + 1 {
+			r.cells.remove(leaf.Parent(level))
+		}
+	}
+}
+
+// invalidateID removes the location and all cells containing its old position from the cache
+func (r *PoIRepository) invalidateID(id ksuid.KSUID) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.generation++
+	r.removeID(id)
+}
+
+// removeID removes the location and the cells containing it, requires the lock to be held
+func (r *PoIRepository) removeID(id ksuid.KSUID) {
+	r.locations.remove(id)
+	for c := range r.cellsByID[id] {
+		r.cells.remove(c)
+	}
+}
+
+func (r *PoIRepository) evicted(evicted bool) {
+	if evicted {
+		r.evictions.Add(1)
+	}
+}
+
+// groupByCell assigns each location to the cell containing it, each cell has an entry even if it is empty
+func groupByCell(cells []s2.CellID, locations []*poi.PoILocation) map[s2.CellID][]*poi.PoILocation {
+	byCell := make(map[s2.CellID][]*poi.PoILocation, len(cells))
+	for _, c := range cells {
+		byCell[c] = make([]*poi.PoILocation, 0)
+	}
+	sorted := slices.Clone(cells)
+	slices.Sort(sorted)
+	for _, l := range locations {
+		leaf := s2.CellIDFromLatLng(s2.LatLngFromDegrees(l.Location.Latitude, l.Location.Longitude))
+		i, _ := slices.BinarySearchFunc(sorted, leaf, func(c, target s2.CellID) int {
+			if c.RangeMax() < target {
+				return -1
+			}
+			return 1
+		})
+		if i < len(sorted) && sorted[i].Contains(leaf) {
+			byCell[sorted[i]] = append(byCell[sorted[i]], l)
+		}
+	}
+	return byCell
+}
@@ -0,0 +1,206 @@
+package cache_test
+
+import (
+	"context"
+
+	"github.com/golang/geo/s2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/segmentio/ksuid"
+	"go.uber.org/zap"
+
+	"github.com/grntlrduck-cloud/go-grpc-geohasing-service-sample/internal/adapters/cache"
+	"github.com/grntlrduck-cloud/go-grpc-geohasing-service-sample/internal/adapters/geo"
+	"github.com/grntlrduck-cloud/go-grpc-geohasing-service-sample/internal/adapters/memory"
+	"github.com/grntlrduck-cloud/go-grpc-geohasing-service-sample/internal/domain/poi"
+)
+
+const testDatapath = "../../../config/db/local/cpoi_dynamo_items_int_test.csv"
+
+// countingRepository counts the cells queried from the decorated repository
+type countingRepository struct {
+	poi.Repository
+	cellQueries int
+}
+
+func (c *countingRepository) GetByCells(
+	ctx context.Context,
+	cells []s2.CellID,
+	logger *zap.Logger,
+) ([]*poi.PoILocation, error) {
+	c.cellQueries += len(cells)
+	return c.Repository.(geo.CellRepository).GetByCells(ctx, cells, logger)
+}
+
+var _ = Describe("given cache in front of in-memory repository with test data", Ordered, func() {
+	ctx := context.Background()
+	logger := zap.NewNop()
+	sw := poi.Coordinates{Longitude: 8.494772, Latitude: 49.425026}
+	ne := poi.Coordinates{Longitude: 10.040508, Latitude: 50.089540}
+	cntr := poi.Coordinates{Longitude: 9.147263, Latitude: 49.333418}
+	var (
+		inner      *countingRepository
+		repository *cache.PoIRepository
+	)
+
+	BeforeAll(func() {
+		repo, err := memory.NewPoIRepository(
+			logger,
+			memory.WithLoadInitData(true),
+			memory.WithInitDataOverride(testDatapath),
+		)
+		Expect(err).To(Not(HaveOccurred()))
+		inner = &countingRepository{Repository: repo}
+		repository = cache.NewPoIRepository(inner)
+	})
+
+	When("location query received", func() {
+		It("repeated bbox search is served from the cache", func() {
+			expected, err := inner.GetByBbox(ctx, sw, ne, logger)
+			Expect(err).To(Not(HaveOccurred()))
+			res, err := repository.GetByBbox(ctx, sw, ne, logger)
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(ids(res.Locations)).To(ConsistOf(ids(expected.Locations)))
+			queried := inner.cellQueries
+			Expect(queried).To(BeNumerically(">", 0))
+			Expect(repository.Stats().CellMisses).To(Equal(uint64(queried)))
+
+			res, err = repository.GetByBbox(ctx, sw, ne, logger)
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(ids(res.Locations)).To(ConsistOf(ids(expected.Locations)))
+			Expect(inner.cellQueries).To(Equal(queried))
+			Expect(repository.Stats().CellHits).To(Equal(uint64(queried)))
+		})
+
+		It("search with filter or strict mode returns the same locations as the decorated repository", func() {
+			expected, err := inner.GetByProximity(ctx, cntr, 30_000.0, logger, poi.WithStrict(true))
+			Expect(err).To(Not(HaveOccurred()))
+			res, err := repository.GetByProximity(ctx, cntr, 30_000.0, logger, poi.WithStrict(true))
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(res.Discarded).To(BeNumerically(">", 0))
+			Expect(res.Discarded).To(Equal(expected.Discarded))
+			Expect(ids(res.Locations)).To(ConsistOf(ids(expected.Locations)))
+
+			filter := &poi.SearchFilter{Features: []string{"DC_CHARGING"}}
+			expected, err = inner.GetByBbox(ctx, sw, ne, logger, poi.WithFilter(filter))
+			Expect(err).To(Not(HaveOccurred()))
+			res, err = repository.GetByBbox(ctx, sw, ne, logger, poi.WithFilter(filter))
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(len(res.Locations)).To(BeNumerically(">", 0))
+			Expect(ids(res.Locations)).To(ConsistOf(ids(expected.Locations)))
+		})
+
+		It("nearest search returns the same locations as the decorated repository", func() {
+			expected, err := inner.GetNearest(ctx, cntr, 5, 100_000.0, logger)
+			Expect(err).To(Not(HaveOccurred()))
+			pois, err := repository.GetNearest(ctx, cntr, 5, 100_000.0, logger)
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(ids(pois)).To(Equal(ids(expected)))
+		})
+
+		It("repeated clusters are served from the cache", func() {
+			expected, err := inner.GetClusters(ctx, sw, ne, 12, logger)
+			Expect(err).To(Not(HaveOccurred()))
+			res, err := repository.GetClusters(ctx, sw, ne, 12, logger)
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(res).To(Equal(expected))
+			queried := inner.cellQueries
+			res, err = repository.GetClusters(ctx, sw, ne, 12, logger)
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(res).To(Equal(expected))
+			Expect(inner.cellQueries).To(Equal(queried))
+		})
+
+		It("clusters summed up from the density aggregates are served by the decorated repository", func() {
+			expected, err := inner.GetClusters(ctx, sw, ne, 8, logger)
+			Expect(err).To(Not(HaveOccurred()))
+			res, err := repository.GetClusters(ctx, sw, ne, 8, logger)
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(res).To(Equal(expected))
+		})
+
+		It("paged search is served by the decorated repository", func() {
+			queried := inner.cellQueries
+			res, err := repository.GetByBbox(ctx, sw, ne, logger, poi.WithPage(5, ""))
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(res.Locations).To(HaveLen(5))
+			Expect(res.NextPageToken).To(Not(BeEmpty()))
+			Expect(inner.cellQueries).To(Equal(queried))
+		})
+
+		It("repeated get by id is served from the cache and returns copies", func() {
+			kID, err := ksuid.Parse("2ofD9igSisfEtgC743gf3BnzO7L")
+			Expect(err).To(Not(HaveOccurred()))
+			location, err := repository.GetByID(ctx, kID, logger)
+			Expect(err).To(Not(HaveOccurred()))
+			location.Address.City = "modified"
+			hits := repository.Stats().LocationHits
+			actual, err := repository.GetByID(ctx, kID, logger)
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(actual.Address.City).To(Not(Equal("modified")))
+			Expect(repository.Stats().LocationHits).To(Equal(hits + 1))
+		})
+	})
+
+	When("location is written", func() {
+		location := poi.PoILocation{
+			ID:               ksuid.New(),
+			Location:         poi.Coordinates{Latitude: 49.334, Longitude: 9.148},
+			LocationEntrance: poi.Coordinates{Latitude: 49.334, Longitude: 9.148},
+			Address: poi.Address{
+				Street:      "Hauptstrasse",
+				City:        "Heilbronn",
+				ZipCode:     "74072",
+				CountryCode: "DEU",
+			},
+			Features: []string{"AC_CHARGING"},
+		}
+		moved := poi.Coordinates{Latitude: 49.9, Longitude: 9.9}
+
+		It("created location is found by cached searches", func() {
+			_, err := repository.GetByProximity(ctx, cntr, 30_000.0, logger)
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(repository.Create(ctx, &location, logger)).To(Succeed())
+			res, err := repository.GetByProximity(ctx, cntr, 30_000.0, logger)
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(res.Locations).To(ContainElement(HaveField("ID", location.ID)))
+			actual, err := repository.GetByID(ctx, location.ID, logger)
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(actual.Version).To(Equal(int64(1)))
+		})
+
+		It("moved location is only found at its new position", func() {
+			_, err := repository.GetByBbox(ctx, sw, ne, logger)
+			Expect(err).To(Not(HaveOccurred()))
+			location.Location = moved
+			Expect(repository.Update(ctx, &location, logger)).To(Succeed())
+
+			res, err := repository.GetByProximity(ctx, cntr, 30_000.0, logger)
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(res.Locations).To(Not(ContainElement(HaveField("ID", location.ID))))
+			res, err = repository.GetByBbox(ctx, sw, ne, logger)
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(res.Locations).To(ContainElement(HaveField("ID", location.ID)))
+			actual, err := repository.GetByID(ctx, location.ID, logger)
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(actual.Location).To(Equal(moved))
+		})
+
+		It("deleted location is not found anymore", func() {
+			Expect(repository.Delete(ctx, location.ID, location.Version, logger)).To(Succeed())
+			res, err := repository.GetByBbox(ctx, sw, ne, logger)
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(res.Locations).To(Not(ContainElement(HaveField("ID", location.ID))))
+			_, err = repository.GetByID(ctx, location.ID, logger)
+			Expect(err).To(Equal(poi.ErrLocationNotFound))
+		})
+	})
+})
+
+func ids(locations []*poi.PoILocation) []string {
+	res := make([]string, len(locations))
+	for i, l := range locations {
+		res[i] = l.ID.String()
+	}
+	return res
+}
@@ -0,0 +1,112 @@
+package csv
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/gocarina/gocsv"
+	"github.com/segmentio/ksuid"
+
+	"github.com/grntlrduck-cloud/go-grpc-geohasing-service-sample/internal/domain/poi"
+)
+
+// TestInitDataPath is the path of the test data relative to the root of the repository
+const TestInitDataPath = "config/db/local/cpoi_dynamo_items_int_test.csv"
+
+// The locationRow has the columns of the location in the csv files of the DynamoDB items, the columns of the keys
+// and indexes of the items are ignored, so that the data can be loaded by any repository
+type locationRow struct {
+	ID                string      `csv:"id"`
+	Street            string      `csv:"street"`
+	StreetNumber      string      `csv:"street_number"`
+	ZipCode           string      `csv:"zip_code"`
+	City              string      `csv:"city"`
+	CountryCode       string      `csv:"country_code"`
+	Features          []string    `csv:"features"`
+	Longitude         float64     `csv:"lon"`
+	Latitude          float64     `csv:"lat"`
+	EntranceLongitude float64     `csv:"entrance_lon"`
+	EntranceLatitude  float64     `csv:"entrance_lat"`
+	MaxPowerKW        float64     `csv:"max_power_kw"`
+	ChargePoints      int         `csv:"charge_points"`
+	Connectors        []connector `csv:"connectors"`
+	Version           int64       `csv:"version"`
+}
+
+// The connector is a plug of a charging location, the current is either "AC", "DC", or empty if unknown
+type connector struct {
+	PlugType string `json:"plug_type"`
+	Current  string `json:"current"`
+}
+
+// LocationsFromFile reads the locations of a csv file with the columns of the DynamoDB items, e.g. the test data
+func LocationsFromFile(path string) ([]*poi.PoILocation, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load csv from file: %w", err)
+	}
+	defer file.Close()
+	rows := []*locationRow{}
+	if errMarshall := gocsv.UnmarshalFile(file, &rows); errMarshall != nil {
+		return nil, fmt.Errorf("failed to map rows to struct, %w", errMarshall)
+	}
+	locations := make([]*poi.PoILocation, len(rows))
+	for i, r := range rows {
+		l, errD := r.domain()
+		if errD != nil {
+			return nil, fmt.Errorf("failed to map test data to domain struct: %w", errD)
+		}
+		locations[i] = l
+	}
+	return locations, nil
+}
+
+func (r *locationRow) domain() (*poi.PoILocation, error) {
+	id, err := ksuid.Parse(r.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse id of row to ksuid: %w", err)
+	}
+	return &poi.PoILocation{
+		ID: id,
+		Location: poi.Coordinates{
+			Latitude:  r.Latitude,
+			Longitude: r.Longitude,
+		},
+		Address: poi.Address{
+			Street:       r.Street,
+			StreetNumber: r.StreetNumber,
+			ZipCode:      r.ZipCode,
+			City:         r.City,
+			CountryCode:  r.CountryCode,
+		},
+		LocationEntrance: poi.Coordinates{
+			Latitude:  r.EntranceLatitude,
+			Longitude: r.EntranceLongitude,
+		},
+		Features: r.Features,
+		Charging: r.chargingInfo(),
+		Version:  r.Version,
+	}, nil
+}
+
+func (r *locationRow) chargingInfo() *poi.ChargingInfo {
+	if r.MaxPowerKW == 0 && r.ChargePoints == 0 && len(r.Connectors) == 0 {
+		return nil
+	}
+	connectors := make([]poi.ChargingConnector, len(r.Connectors))
+	for i, c := range r.Connectors {
+		current := poi.CurrentAny
+		switch c.Current {
+		case "AC":
+			current = poi.CurrentAC
+		case "DC":
+			current = poi.CurrentDC
+		}
+		connectors[i] = poi.ChargingConnector{PlugType: c.PlugType, Current: current}
+	}
+	return &poi.ChargingInfo{
+		MaxPowerKW:   r.MaxPowerKW,
+		ChargePoints: r.ChargePoints,
+		Connectors:   connectors,
+	}
+}
@@ -0,0 +1,44 @@
+package csv_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/grntlrduck-cloud/go-grpc-geohasing-service-sample/internal/adapters/csv"
+	"github.com/grntlrduck-cloud/go-grpc-geohasing-service-sample/internal/adapters/dynamo"
+	"github.com/grntlrduck-cloud/go-grpc-geohasing-service-sample/internal/domain/poi"
+)
+
+const testDataPath = "../../../" + csv.TestInitDataPath
+
+var _ = Describe("given csv file of items", func() {
+	When("locations are read", func() {
+		It("maps the rows to the locations", func() {
+			locations, err := csv.LocationsFromFile(testDataPath)
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(locations).To(Not(BeEmpty()))
+			first := locations[0]
+			Expect(first.ID.String()).To(Equal("2ofD9hciu5kGIGdGXjPuJy3tUvH"))
+			Expect(first.Location).To(Equal(poi.Coordinates{Latitude: 49.64636, Longitude: 8.78141}))
+			Expect(first.Address.City).To(Equal("Fürth"))
+			Expect(first.Features).To(ContainElement("AC_CHARGING"))
+		})
+
+		It("maps the rows like the items of the DynamoDB repository", func() {
+			locations, err := csv.LocationsFromFile(testDataPath)
+			Expect(err).To(Not(HaveOccurred()))
+			for _, l := range locations[:10] {
+				item, err := dynamo.NewItemFromDomain(l)
+				Expect(err).To(Not(HaveOccurred()))
+				d, err := item.Domain()
+				Expect(err).To(Not(HaveOccurred()))
+				Expect(d).To(Equal(l))
+			}
+		})
+
+		It("returns error for missing file", func() {
+			_, err := csv.LocationsFromFile("missing.csv")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
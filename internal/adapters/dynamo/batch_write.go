@@ -0,0 +1,322 @@
+package dynamo
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/golang/geo/s2"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/grntlrduck-cloud/go-grpc-geohasing-service-sample/internal/domain/poi"
+)
+
+const (
+	dynamoMaxBatchSize       = 25 // the maximum number of items of a BatchWriteItem request
+	maxConcurrentBatchWrites = 4
+	batchWriteMaxAttempts    = 8 // attempts to write the items of a chunk including resubmissions
+	baseBackoff              = 50 * time.Millisecond
+	maxBackoff               = 5 * time.Second
+)
+
+// a batchWrite is the request to write the location at the index of the batch
+type batchWrite struct {
+	index   int
+	pk      string
+	request types.WriteRequest
+}
+
+func (pgr *PoIGeoRepository) UpsertBatch(
+	ctx context.Context,
+	pois []*poi.PoILocation,
+	logger *zap.Logger,
+) (*poi.BatchWriteReport, error) {
+	// handle context cancelation
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+	report := &poi.BatchWriteReport{Results: make([]poi.BatchWriteResult, len(pois))}
+	// verify validity
+	if len(pois) == 0 {
+		logger.Warn(
+			"skipping batch upsert because pois is empty slice",
+		)
+		return report, nil
+	}
+
+	// map domain model to dynamo items, locations which can not be mapped are reported as failed
+	chunks := createBatchRequests(pois, pgr.index, pgr.density, report, logger)
+
+	// write chunks concurrently, each chunk reports the results of its own locations. The transactions of chunks
+	// adding to the same density aggregates would conflict, hence those chunks are written one after another.
+	groups := make([][]int, len(chunks))
+	for i := range chunks {
+		groups[i] = []int{i}
+	}
+	if pgr.density {
+		groups = densityChunkGroups(chunks, pois)
+	}
+	var errGrp errgroup.Group
+	errGrp.SetLimit(maxConcurrentBatchWrites)
+	for _, g := range groups {
+		errGrp.Go(func() error {
+			for _, i := range g {
+				chunkLogger := logger.With(
+					zap.Int("batch_num", i),
+					zap.Int("total_num_batches", len(chunks)),
+				)
+				if !pgr.density {
+					pgr.writeChunk(ctx, chunks[i], report, chunkLogger)
+					continue
+				}
+				pgr.writeChunkWithDensity(ctx, chunks[i], pois, report, chunkLogger)
+			}
+			return nil
+		})
+	}
+	_ = errGrp.Wait()
+
+	failed := report.Failed()
+	if len(failed) > 0 {
+		logger.Error("batch upsert incomplete",
+			zap.Error(failed[0].Err),
+			zap.Int("num_failed_items", len(failed)),
+			zap.Int("num_items", len(pois)),
+		)
+		return report, poi.ErrDBBatchUpsert
+	}
+	logger.Info("batch upsert complete",
+		zap.Int("num_items", len(pois)),
+	)
+	return report, nil
+}
+
+// writeChunk writes the chunk and resubmits unprocessed items and throttled requests with exponential backoff
+// until all items are written or the attempts are exhausted
+func (pgr *PoIGeoRepository) writeChunk(
+	ctx context.Context,
+	chunk []batchWrite,
+	report *poi.BatchWriteReport,
+	logger *zap.Logger,
+) {
+	pending := chunk
+	for attempt := 0; len(pending) > 0; attempt++ {
+		if attempt == batchWriteMaxAttempts {
+			logger.Error("failed to write batch, attempts exhausted",
+				zap.Int("num_unprocessed_items", len(pending)),
+			)
+			fail(report, pending, fmt.Errorf("unprocessed after %d attempts: %w", attempt, poi.ErrDBBatchUpsert))
+			return
+		}
+		if attempt > 0 {
+			if err := sleepWithContext(ctx, backoff(attempt)); err != nil {
+				fail(report, pending, err)
+				return
+			}
+		}
+		requests := make([]types.WriteRequest, len(pending))
+		for i, w := range pending {
+			requests[i] = w.request
+		}
+		output, err := pgr.dynamoClient.BatchPutItem(ctx, &dynamodb.BatchWriteItemInput{
+			RequestItems: map[string][]types.WriteRequest{pgr.tableName: requests},
+		})
+		if err != nil && retryable(err) {
+			logger.Warn("batch write throttled, retrying",
+				zap.Int("attempt", attempt),
+				zap.Error(err),
+			)
+			continue
+		}
+		if err != nil {
+			logger.Error("failed to perform batch PutItem",
+				zap.Int("num_items", len(pending)),
+				zap.Error(err),
+			)
+			fail(report, pending, fmt.Errorf("%w: %w", poi.ErrDBBatchUpsert, err))
+			return
+		}
+		pending = unprocessed(pending, output.UnprocessedItems[pgr.tableName])
+		if len(pending) > 0 {
+			logger.Debug("resubmitting unprocessed items",
+				zap.Int("attempt", attempt),
+				zap.Int("num_unprocessed_items", len(pending)),
+			)
+		}
+	}
+	logger.Debug("successfully inserted batch",
+		zap.Int("num_items", len(chunk)),
+	)
+}
+
+// writeChunkWithDensity writes the chunk in one transaction with the change of the density aggregates
+func (pgr *PoIGeoRepository) writeChunkWithDensity(
+	ctx context.Context,
+	chunk []batchWrite,
+	pois []*poi.PoILocation,
+	report *poi.BatchWriteReport,
+	logger *zap.Logger,
+) {
+	writes := make([]*densityWrite, len(chunk))
+	for i, w := range chunk {
+		writes[i] = &densityWrite{pk: w.pk, location: pois[w.index], item: w.request.PutRequest.Item}
+	}
+	if err := pgr.writeWithDensity(ctx, writes, logger); err != nil {
+		logger.Error("failed to write batch with density aggregates",
+			zap.Int("num_items", len(chunk)),
+			zap.Error(err),
+		)
+		fail(report, chunk, fmt.Errorf("%w: %w", poi.ErrDBBatchUpsert, err))
+		return
+	}
+	logger.Debug("successfully inserted batch",
+		zap.Int("num_items", len(chunk)),
+	)
+}
+
+// createBatchRequests maps the locations to write requests in chunks of at most dynamoMaxBatchSize items. With
+// density aggregates, the requests are ordered by the density partition of their location, so that the locations of
+// a partition are written in as few chunks as possible.
+func createBatchRequests(
+	pois []*poi.PoILocation,
+	index SpatialIndex,
+	density bool,
+	report *poi.BatchWriteReport,
+	logger *zap.Logger,
+) [][]batchWrite {
+	writes := make([]batchWrite, 0, len(pois))
+	for i, v := range pois {
+		report.Results[i].ID = v.ID
+		item, err := newItemFromDomain(v, index)
+		if err != nil {
+			report.Results[i].Err = fmt.Errorf("unable to map location to item: %w", err)
+			continue
+		}
+		av, err := attributevalue.MarshalMap(&item)
+		if err != nil {
+			logger.Error("unable to marshall item to DynamoDB AttributeValues",
+				zap.String("location_id", item.ID),
+				zap.Error(err),
+			)
+			report.Results[i].Err = poi.ErrDBEntityMapping
+			continue
+		}
+		writes = append(writes, batchWrite{
+			index:   i,
+			pk:      item.Pk,
+			request: types.WriteRequest{PutRequest: &types.PutRequest{Item: av}},
+		})
+	}
+	if density {
+		slices.SortStableFunc(writes, func(a, b batchWrite) int {
+			return cmp.Compare(densityPartition(pois[a.index]), densityPartition(pois[b.index]))
+		})
+	}
+	chunks := make([][]batchWrite, 0, (len(writes)+dynamoMaxBatchSize-1)/dynamoMaxBatchSize)
+	for start := 0; start < len(writes); start += dynamoMaxBatchSize {
+		chunks = append(chunks, writes[start:min(start+dynamoMaxBatchSize, len(writes))])
+	}
+	return chunks
+}
+
+// densityChunkGroups groups the indexes of the chunks which add to the same density partitions, directly or via
+// other chunks of the group. The groups are ordered by their first chunk.
+func densityChunkGroups(chunks [][]batchWrite, pois []*poi.PoILocation) [][]int {
+	// union find of the chunks, each chunk is linked to the first chunk of its partitions
+	parent := make([]int, len(chunks))
+	var root func(i int) int
+	root = func(i int) int {
+		if parent[i] != i {
+			parent[i] = root(parent[i])
+		}
+		return parent[i]
+	}
+	first := make(map[s2.CellID]int)
+	for i, c := range chunks {
+		parent[i] = i
+		for _, w := range c {
+			p := densityPartition(pois[w.index])
+			j, ok := first[p]
+			if !ok {
+				first[p] = i
+				continue
+			}
+			if ri, rj := root(i), root(j); ri != rj {
+				parent[max(ri, rj)] = min(ri, rj)
+			}
+		}
+	}
+	groups := make([][]int, 0, len(chunks))
+	byRoot := make(map[int]int)
+	for i := range chunks {
+		r := root(i)
+		g, ok := byRoot[r]
+		if !ok {
+			g = len(groups)
+			byRoot[r] = g
+			groups = append(groups, nil)
+		}
+		groups[g] = append(groups[g], i)
+	}
+	return groups
+}
+
+// unprocessed returns the pending writes of the unprocessed items matched by their primary key
+func unprocessed(pending []batchWrite, items []types.WriteRequest) []batchWrite {
+	if len(items) == 0 {
+		return nil
+	}
+	keys := make(map[string]bool, len(items))
+	for _, r := range items {
+		if pk, ok := r.PutRequest.Item[CPoIItemPK].(*types.AttributeValueMemberS); ok {
+			keys[pk.Value] = true
+		}
+	}
+	left := make([]batchWrite, 0, len(items))
+	for _, w := range pending {
+		if keys[w.pk] {
+			left = append(left, w)
+		}
+	}
+	return left
+}
+
+func fail(report *poi.BatchWriteReport, writes []batchWrite, err error) {
+	for _, w := range writes {
+		report.Results[w.index].Err = err
+	}
+}
+
+// retryable reports whether the request has been throttled or failed temporarily
+func retryable(err error) bool {
+	var throughputErr *types.ProvisionedThroughputExceededException
+	var limitErr *types.RequestLimitExceeded
+	var internalErr *types.InternalServerError
+	return errors.As(err, &throughputErr) || errors.As(err, &limitErr) || errors.As(err, &internalErr)
+}
+
+// backoff returns the exponential backoff of the attempt with jitter between half and the full backoff
+func backoff(attempt int) time.Duration {
+	d := min(baseBackoff<<(attempt-1), maxBackoff)
+	return d/2 + rand.N(d/2+1) //nolint:gosec // jitter does not need a secure random number
+}
+
+// sleepWithContext sleeps for the duration unless the context is done before
+func sleepWithContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,153 @@
+package dynamo
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/segmentio/ksuid"
+	"go.uber.org/zap"
+
+	"github.com/grntlrduck-cloud/go-grpc-geohasing-service-sample/internal/domain/poi"
+)
+
+// batchWriteClient fakes BatchWriteItem requests, all other requests of the DBClient are not implemented
+type batchWriteClient struct {
+	DBClient
+	mu      sync.Mutex
+	calls   int
+	written map[string]int
+	respond func(call int, requests []types.WriteRequest) ([]types.WriteRequest, error)
+}
+
+func (c *batchWriteClient) BatchPutItem(
+	_ context.Context,
+	input *dynamodb.BatchWriteItemInput,
+) (*dynamodb.BatchWriteItemOutput, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.calls++
+	requests := input.RequestItems["table"]
+	unprocessed, err := c.respond(c.calls, requests)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range requests[len(unprocessed):] {
+		c.written[r.PutRequest.Item[CPoIItemPK].(*types.AttributeValueMemberS).Value]++
+	}
+	return &dynamodb.BatchWriteItemOutput{
+		UnprocessedItems: map[string][]types.WriteRequest{"table": unprocessed},
+	}, nil
+}
+
+var _ = Describe("given a batch upsert", func() {
+	ctx := context.Background()
+	logger := zap.NewNop()
+
+	newLocations := func(n int) []*poi.PoILocation {
+		locations := make([]*poi.PoILocation, n)
+		for i := range locations {
+			locations[i] = &poi.PoILocation{
+				ID:       ksuid.New(),
+				Location: poi.Coordinates{Latitude: 48.137154, Longitude: 11.576124},
+			}
+		}
+		return locations
+	}
+	newRepository := func(client *batchWriteClient) *PoIGeoRepository {
+		client.written = make(map[string]int)
+		return &PoIGeoRepository{dynamoClient: client, tableName: "table", index: NewS2Index()}
+	}
+
+	When("items are unprocessed", func() {
+		It("resubmits them until all locations are written once", func() {
+			client := &batchWriteClient{
+				respond: func(_ int, requests []types.WriteRequest) ([]types.WriteRequest, error) {
+					// the first half of each request is left unprocessed
+					return requests[:len(requests)/2], nil
+				},
+			}
+			locations := newLocations(60)
+			report, err := newRepository(client).UpsertBatch(ctx, locations, logger)
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(report.Failed()).To(BeEmpty())
+			Expect(client.written).To(HaveLen(60))
+			for _, l := range locations {
+				Expect(client.written[l.ID.String()]).To(Equal(1))
+			}
+		})
+	})
+
+	When("requests are throttled", func() {
+		It("retries the request", func() {
+			client := &batchWriteClient{
+				respond: func(call int, _ []types.WriteRequest) ([]types.WriteRequest, error) {
+					if call == 1 {
+						return nil, &types.ProvisionedThroughputExceededException{Message: aws.String("slow down")}
+					}
+					return nil, nil
+				},
+			}
+			report, err := newRepository(client).UpsertBatch(ctx, newLocations(10), logger)
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(report.Failed()).To(BeEmpty())
+			Expect(client.calls).To(Equal(2))
+		})
+	})
+
+	When("request fails", func() {
+		It("reports the locations of the chunk as failed", func() {
+			client := &batchWriteClient{
+				respond: func(call int, _ []types.WriteRequest) ([]types.WriteRequest, error) {
+					if call == 1 {
+						return nil, errors.New("validation failed")
+					}
+					return nil, nil
+				},
+			}
+			report, err := newRepository(client).UpsertBatch(ctx, newLocations(30), logger)
+			Expect(err).To(Equal(poi.ErrDBBatchUpsert))
+			Expect(report.Results).To(HaveLen(30))
+			Expect(report.Failed()).To(HaveLen(30 - len(client.written)))
+			Expect(errors.Is(report.Failed()[0].Err, poi.ErrDBBatchUpsert)).To(BeTrue())
+		})
+	})
+
+	When("location is invalid", func() {
+		It("reports the location as failed and writes all others", func() {
+			client := &batchWriteClient{
+				respond: func(_ int, _ []types.WriteRequest) ([]types.WriteRequest, error) {
+					return nil, nil
+				},
+			}
+			locations := newLocations(5)
+			locations[2].Location.Latitude = 91
+			report, err := newRepository(client).UpsertBatch(ctx, locations, logger)
+			Expect(err).To(Equal(poi.ErrDBBatchUpsert))
+			Expect(report.Failed()).To(HaveLen(1))
+			Expect(report.Failed()[0].ID).To(Equal(locations[2].ID))
+			Expect(client.written).To(HaveLen(4))
+		})
+	})
+
+	When("context is canceled while backing off", func() {
+		It("reports the unprocessed locations as failed", func() {
+			cancelCtx, cancel := context.WithCancel(ctx)
+			client := &batchWriteClient{
+				respond: func(_ int, requests []types.WriteRequest) ([]types.WriteRequest, error) {
+					cancel()
+					return requests, nil
+				},
+			}
+			report, err := newRepository(client).UpsertBatch(cancelCtx, newLocations(5), logger)
+			Expect(err).To(Equal(poi.ErrDBBatchUpsert))
+			Expect(report.Failed()).To(HaveLen(5))
+			Expect(report.Failed()[0].Err).To(Equal(context.Canceled))
+		})
+	})
+})
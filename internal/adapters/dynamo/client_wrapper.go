@@ -17,11 +17,28 @@ type DBClient interface {
 	) (*dynamodb.BatchWriteItemOutput, error)
 	PutItem(ctx context.Context, input *dynamodb.PutItemInput) (*dynamodb.PutItemOutput, error)
 	GetItem(ctx context.Context, input *dynamodb.GetItemInput) (*dynamodb.GetItemOutput, error)
+	BatchGetItem(
+		ctx context.Context,
+		input *dynamodb.BatchGetItemInput,
+	) (*dynamodb.BatchGetItemOutput, error)
+	DeleteItem(
+		ctx context.Context,
+		input *dynamodb.DeleteItemInput,
+	) (*dynamodb.DeleteItemOutput, error)
 	QueryItem(ctx context.Context, input *dynamodb.QueryInput) (*dynamodb.QueryOutput, error)
+	ScanItem(ctx context.Context, input *dynamodb.ScanInput) (*dynamodb.ScanOutput, error)
+	UpdateItem(
+		ctx context.Context,
+		input *dynamodb.UpdateItemInput,
+	) (*dynamodb.UpdateItemOutput, error)
 	CreateTable(
 		ctx context.Context,
 		input *dynamodb.CreateTableInput,
 	) (*dynamodb.CreateTableOutput, error)
+	TransactWriteItems(
+		ctx context.Context,
+		input *dynamodb.TransactWriteItemsInput,
+	) (*dynamodb.TransactWriteItemsOutput, error)
 }
 
 type ClientWrapper struct {
@@ -79,6 +96,22 @@ func (client *ClientWrapper) GetItem(
 	return output, err
 }
 
+func (client *ClientWrapper) BatchGetItem(
+	ctx context.Context,
+	input *dynamodb.BatchGetItemInput,
+) (*dynamodb.BatchGetItemOutput, error) {
+	output, err := client.dynamoClient.BatchGetItem(ctx, input)
+	return output, err
+}
+
+func (client *ClientWrapper) DeleteItem(
+	ctx context.Context,
+	input *dynamodb.DeleteItemInput,
+) (*dynamodb.DeleteItemOutput, error) {
+	output, err := client.dynamoClient.DeleteItem(ctx, input)
+	return output, err
+}
+
 func (client *ClientWrapper) QueryItem(
 	ctx context.Context,
 	input *dynamodb.QueryInput,
@@ -87,6 +120,22 @@ func (client *ClientWrapper) QueryItem(
 	return output, err
 }
 
+func (client *ClientWrapper) ScanItem(
+	ctx context.Context,
+	input *dynamodb.ScanInput,
+) (*dynamodb.ScanOutput, error) {
+	output, err := client.dynamoClient.Scan(ctx, input)
+	return output, err
+}
+
+func (client *ClientWrapper) UpdateItem(
+	ctx context.Context,
+	input *dynamodb.UpdateItemInput,
+) (*dynamodb.UpdateItemOutput, error) {
+	output, err := client.dynamoClient.UpdateItem(ctx, input)
+	return output, err
+}
+
 func (client *ClientWrapper) CreateTable(
 	ctx context.Context,
 	input *dynamodb.CreateTableInput,
@@ -95,6 +144,14 @@ func (client *ClientWrapper) CreateTable(
 	return output, err
 }
 
+func (client *ClientWrapper) TransactWriteItems(
+	ctx context.Context,
+	input *dynamodb.TransactWriteItemsInput,
+) (*dynamodb.TransactWriteItemsOutput, error) {
+	output, err := client.dynamoClient.TransactWriteItems(ctx, input)
+	return output, err
+}
+
 func NewClientWrapper(opts ...ClientOptions) (DBClient, error) {
 	cw := &ClientWrapper{
 		region: "eu-west-1",
@@ -0,0 +1,112 @@
+package dynamo
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+
+	"github.com/grntlrduck-cloud/go-grpc-geohasing-service-sample/internal/domain/poi"
+)
+
+// A writeCondition guards a write by the state of the stored item, the write fails with the error of the
+// condition if the stored item does not satisfy the expression
+type writeCondition struct {
+	expression string
+	names      map[string]string
+	values     map[string]types.AttributeValue
+	failed     error
+	// check evaluates the condition for the stored item, nil if no item is stored
+	check func(stored *CPoIItem) error
+}
+
+// notExistsCondition requires that no item with the same key is stored
+func notExistsCondition() *writeCondition {
+	return &writeCondition{
+		expression: "attribute_not_exists(#pk)",
+		names:      map[string]string{"#pk": CPoIItemPK},
+		failed:     poi.ErrLocationAlreadyExists,
+		check: func(stored *CPoIItem) error {
+			if stored != nil {
+				return poi.ErrLocationAlreadyExists
+			}
+			return nil
+		},
+	}
+}
+
+// versionCondition requires the stored item to have the version, any version is accepted for poi.AnyVersion.
+// Items imported without version have version zero.
+func versionCondition(version int64) *writeCondition {
+	if version == poi.AnyVersion {
+		return &writeCondition{
+			expression: "attribute_exists(#pk)",
+			names:      map[string]string{"#pk": CPoIItemPK},
+			check: func(stored *CPoIItem) error {
+				if stored == nil {
+					return poi.ErrLocationNotFound
+				}
+				return nil
+			},
+		}
+	}
+	condition := &writeCondition{
+		expression: "attribute_exists(#pk) AND #version = :version",
+		names:      map[string]string{"#pk": CPoIItemPK, "#version": CPoIItemVersion},
+		values: map[string]types.AttributeValue{
+			":version": &types.AttributeValueMemberN{Value: strconv.FormatInt(version, 10)},
+		},
+		failed: poi.ErrVersionMismatch,
+		check: func(stored *CPoIItem) error {
+			if stored == nil {
+				return poi.ErrLocationNotFound
+			}
+			if stored.Version != version {
+				return poi.ErrVersionMismatch
+			}
+			return nil
+		},
+	}
+	if version == 0 {
+		condition.expression = "attribute_exists(#pk) AND (attribute_not_exists(#version) OR #version = :version)"
+	}
+	return condition
+}
+
+// and combines the conditions, the names and values of both are merged
+func (c *writeCondition) and(o *writeCondition) *writeCondition {
+	if c == nil {
+		return o
+	}
+	combined := &writeCondition{
+		expression: fmt.Sprintf("(%s) AND (%s)", c.expression, o.expression),
+		names:      maps.Clone(c.names),
+		values:     maps.Clone(c.values),
+		failed:     c.failed,
+		check:      c.check,
+	}
+	if combined.values == nil && len(o.values) > 0 {
+		combined.values = make(map[string]types.AttributeValue, len(o.values))
+	}
+	maps.Copy(combined.names, o.names)
+	maps.Copy(combined.values, o.values)
+	return combined
+}
+
+// conditionFailed reports whether the error is the error of a failed write condition
+func conditionFailed(err error) bool {
+	return errors.Is(err, poi.ErrLocationAlreadyExists) ||
+		errors.Is(err, poi.ErrLocationNotFound) ||
+		errors.Is(err, poi.ErrVersionMismatch)
+}
+
+// err returns the error of the failed condition, requires the stored item to be returned on failure to tell
+// a missing item apart
+func (c *writeCondition) err(failed *types.ConditionalCheckFailedException) error {
+	if len(failed.Item) == 0 {
+		return poi.ErrLocationNotFound
+	}
+	return c.failed
+}
@@ -0,0 +1,57 @@
+package dynamo
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/grntlrduck-cloud/go-grpc-geohasing-service-sample/internal/domain/poi"
+)
+
+var _ = Describe("given a write condition", func() {
+	When("version is any version", func() {
+		It("requires the item to exist only", func() {
+			condition := versionCondition(poi.AnyVersion)
+			Expect(condition.expression).To(Equal("attribute_exists(#pk)"))
+			Expect(condition.values).To(BeNil())
+		})
+	})
+
+	When("version is zero", func() {
+		It("accepts items without version", func() {
+			condition := versionCondition(0)
+			Expect(condition.expression).To(Equal(
+				"attribute_exists(#pk) AND (attribute_not_exists(#version) OR #version = :version)",
+			))
+			Expect(condition.values).To(HaveKeyWithValue(":version", &types.AttributeValueMemberN{Value: "0"}))
+		})
+	})
+
+	When("version condition failed", func() {
+		condition := versionCondition(3)
+		It("returns location not found if no item is stored", func() {
+			err := condition.err(&types.ConditionalCheckFailedException{})
+			Expect(err).To(Equal(poi.ErrLocationNotFound))
+		})
+
+		It("returns version mismatch if the item is stored", func() {
+			err := condition.err(&types.ConditionalCheckFailedException{
+				Item: map[string]types.AttributeValue{
+					CPoIItemVersion: &types.AttributeValueMemberN{Value: "4"},
+				},
+			})
+			Expect(err).To(Equal(poi.ErrVersionMismatch))
+		})
+	})
+
+	When("not exists condition failed", func() {
+		It("returns location already exists", func() {
+			err := notExistsCondition().err(&types.ConditionalCheckFailedException{
+				Item: map[string]types.AttributeValue{
+					CPoIItemPK: &types.AttributeValueMemberS{Value: "2ofD9hciu5kGIGdGXjPuJy3tUvH"},
+				},
+			})
+			Expect(err).To(Equal(poi.ErrLocationAlreadyExists))
+		})
+	})
+})
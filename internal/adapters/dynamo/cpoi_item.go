@@ -12,23 +12,34 @@ import (
 )
 
 const (
-	CPoIItemPK           = "pk"
-	CPoIItemGeoIndexName = "gsi1_geo"
-	CPoIItemGeoIndexPK   = "gsi1_geo_pk"
-	CPoIItemGeoIndexSK   = "gsi1_geo_sk"
-	CPoIItemCellLevel    = 9 //  edge length of min 27 km and max 38 km http://s2geometry.io/resources/s2cell_statistics.html
-	countryCodeDeu       = "DEU"
-	ac                   = "AC"
-	dc                   = "DC"
+	CPoIItemPK                 = "pk"
+	CPoIItemGeoIndexName       = "gsi1_geo"
+	CPoIItemGeoIndexPK         = "gsi1_geo_pk"
+	CPoIItemGeoIndexSK         = "gsi1_geo_sk"
+	CPoIItemCoarseGeoIndexName = "gsi2_geo"
+	CPoIItemCoarseGeoIndexPK   = "gsi2_geo_pk"
+	CPoIItemFineGeoIndexName   = "gsi3_geo"
+	CPoIItemFineGeoIndexPK     = "gsi3_geo_pk"
+	CPoIItemVersion            = "version"
+	CPoIItemRevision           = "rev"
+	CPoIItemCellLevel          = 9  //  edge length of min 27 km and max 38 km http://s2geometry.io/resources/s2cell_statistics.html
+	CPoIItemCoarseCellLevel    = 6  // for country-scale queries, edge length of min 108 km and max 156 km
+	CPoIItemFineCellLevel      = 12 // for city-scale queries, edge length of min 1.7 km and max 2.4 km
+	countryCodeDeu             = "DEU"
+	ac                         = "AC"
+	dc                         = "DC"
 )
 
 // The CPoIItem is a flattened representation of the domain with a primary key (hashkey) to get a cPoI by its id
 // and a global secondary geo index where the primary key (hashkey) is the trimmed geohash and the sortkey is the full precision geohash.
+// The coarse and fine geo indexes are partitioned by the geohash trimmed to their level and share the sortkey of the geo index.
 // The structure is flattened so that a import of the dataset from csv on table creation through IaC is easier and less errorprone.
 type CPoIItem struct {
 	Pk                string   `json:"pk"             csv:"pk"            dynamodbav:"pk"`
 	GeoIndexPk        uint64   `json:"gsi1_geo_pk_pk" csv:"gsi1_geo_pk"   dynamodbav:"gsi1_geo_pk"` // the geohash with trimmed precision
 	GeoIndexSk        uint64   `json:"gsi1_geo_pk"    csv:"gsi1_geo_sk"   dynamodbav:"gsi1_geo_sk"` // the geohash with full precision
+	CoarseGeoIndexPk  uint64   `json:"gsi2_geo_pk"    csv:"gsi2_geo_pk"   dynamodbav:"gsi2_geo_pk"` // the geohash with coarse precision
+	FineGeoIndexPk    uint64   `json:"gsi3_geo_pk"    csv:"gsi3_geo_pk"   dynamodbav:"gsi3_geo_pk"` // the geohash with fine precision
 	ID                string   `json:"id"             csv:"id"            dynamodbav:"id"`
 	Street            string   `json:"street"         csv:"street"        dynamodbav:"street"`
 	StreetNumber      string   `json:"street_number"  csv:"street_number" dynamodbav:"street_number"`
@@ -40,6 +51,20 @@ type CPoIItem struct {
 	Latitude          float64  `json:"lat"            csv:"lat"           dynamodbav:"lat"`
 	EntranceLongitude float64  `json:"entrance_lon"   csv:"entrance_lon"  dynamodbav:"entrance_lon"`
 	EntranceLatitude  float64  `json:"entrance_lat"   csv:"entrance_lat"  dynamodbav:"entrance_lat"`
+	// the charging info is flattened as well, all zero for items ingested before it was introduced
+	MaxPowerKW   float64         `json:"max_power_kw"  csv:"max_power_kw"  dynamodbav:"max_power_kw"`
+	ChargePoints int             `json:"charge_points" csv:"charge_points" dynamodbav:"charge_points"`
+	Connectors   []CPoIConnector `json:"connectors"    csv:"connectors"    dynamodbav:"connectors"`
+	// the version for optimistic locking, missing for imported items which equals version zero
+	Version int64 `json:"version" csv:"version" dynamodbav:"version,omitempty"`
+	// the revision changes with each write updating the density aggregates, missing for items written without
+	Revision string `json:"rev" csv:"-" dynamodbav:"rev,omitempty"`
+}
+
+// The CPoIConnector is a plug of a charging location, the current is either "AC", "DC", or empty if unknown
+type CPoIConnector struct {
+	PlugType string `json:"plug_type" dynamodbav:"plug_type" ion:"plug_type"`
+	Current  string `json:"current"   dynamodbav:"current"   ion:"current"`
 }
 
 func (cp *CPoIItem) Domain() (*poi.PoILocation, error) {
@@ -65,21 +90,61 @@ func (cp *CPoIItem) Domain() (*poi.PoILocation, error) {
 			Longitude: cp.EntranceLongitude,
 		},
 		Features: cp.Features,
+		Charging: cp.chargingInfo(),
+		Version:  cp.Version,
 	}, nil
 }
 
+func (cp *CPoIItem) chargingInfo() *poi.ChargingInfo {
+	if cp.MaxPowerKW == 0 && cp.ChargePoints == 0 && len(cp.Connectors) == 0 {
+		return nil
+	}
+	connectors := make([]poi.ChargingConnector, len(cp.Connectors))
+	for i, c := range cp.Connectors {
+		connectors[i] = poi.ChargingConnector{PlugType: c.PlugType, Current: currentFromItem(c.Current)}
+	}
+	return &poi.ChargingInfo{
+		MaxPowerKW:   cp.MaxPowerKW,
+		ChargePoints: cp.ChargePoints,
+		Connectors:   connectors,
+	}
+}
+
+func currentFromItem(current string) poi.Current {
+	switch current {
+	case ac:
+		return poi.CurrentAC
+	case dc:
+		return poi.CurrentDC
+	default:
+		return poi.CurrentAny
+	}
+}
+
+func currentToItem(current poi.Current) string {
+	switch current {
+	case poi.CurrentAC:
+		return ac
+	case poi.CurrentDC:
+		return dc
+	case poi.CurrentAny:
+	}
+	return ""
+}
+
+// NewItemFromDomain maps the location to an item with the keys of the s2 spatial index
 func NewItemFromDomain(poiL *poi.PoILocation) (*CPoIItem, error) {
-	gh, err := newGeoHash(poiL.Location.Latitude, poiL.Location.Longitude)
+	return newItemFromDomain(poiL, defaultSpatialIndex)
+}
+
+func newItemFromDomain(poiL *poi.PoILocation, index SpatialIndex) (*CPoIItem, error) {
+	key, err := index.Key(poiL.Location.Latitude, poiL.Location.Longitude)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create geo hash: %w", err)
 	}
 	id := poiL.ID.String()
-	return &CPoIItem{
-		Pk: id,
-		GeoIndexPk: gh.trimmed(
-			CPoIItemCellLevel,
-		), // the trimmed geo hash adjusted to the level
-		GeoIndexSk:        gh.hash(), // the full length geo hash
+	item := &CPoIItem{
+		Pk:                id,
 		ID:                id,
 		Street:            poiL.Address.Street,
 		StreetNumber:      poiL.Address.StreetNumber,
@@ -91,15 +156,28 @@ func NewItemFromDomain(poiL *poi.PoILocation) (*CPoIItem, error) {
 		EntranceLongitude: poiL.LocationEntrance.Longitude,
 		EntranceLatitude:  poiL.LocationEntrance.Latitude,
 		Features:          poiL.Features,
-	}, nil
+		Version:           poiL.Version,
+	}
+	key.apply(item)
+	if poiL.Charging != nil {
+		item.MaxPowerKW = poiL.Charging.MaxPowerKW
+		item.ChargePoints = poiL.Charging.ChargePoints
+		item.Connectors = make([]CPoIConnector, len(poiL.Charging.Connectors))
+		for i, c := range poiL.Charging.Connectors {
+			item.Connectors[i] = CPoIConnector{PlugType: c.PlugType, Current: currentToItem(c.Current)}
+		}
+	}
+	return item, nil
 }
 
 func (cp *CPoIItem) IonItem() *IonItem {
 	return &IonItem{
 		CPoIIonItem{
 			Pk:                cp.Pk,
-			GeoIndexPk:        *ion.NewDecimalInt(int64(cp.GeoIndexPk)), //nolint:gosec // no relevant risk
-			GeoIndexSk:        *ion.NewDecimalInt(int64(cp.GeoIndexSk)), //nolint:gosec // no relevant risk
+			GeoIndexPk:        *ion.NewDecimalInt(int64(cp.GeoIndexPk)),       //nolint:gosec // no relevant risk
+			GeoIndexSk:        *ion.NewDecimalInt(int64(cp.GeoIndexSk)),       //nolint:gosec // no relevant risk
+			CoarseGeoIndexPk:  *ion.NewDecimalInt(int64(cp.CoarseGeoIndexPk)), //nolint:gosec // no relevant risk
+			FineGeoIndexPk:    *ion.NewDecimalInt(int64(cp.FineGeoIndexPk)),   //nolint:gosec // no relevant risk
 			ID:                cp.ID,
 			Street:            cp.Street,
 			StreetNumber:      cp.StreetNumber,
@@ -111,6 +189,9 @@ func (cp *CPoIItem) IonItem() *IonItem {
 			Latitude:          floatToDecimal(cp.Latitude),
 			EntranceLongitude: floatToDecimal(cp.EntranceLongitude),
 			EntranceLatitude:  floatToDecimal(cp.EntranceLatitude),
+			MaxPowerKW:        floatToDecimal(cp.MaxPowerKW),
+			ChargePoints:      cp.ChargePoints,
+			Connectors:        cp.Connectors,
 		},
 	}
 }
@@ -161,17 +242,13 @@ func EntriesToDynamo(ctes []*ChargingCSVEntry) []*CPoIItem {
 }
 
 func (cte *ChargingCSVEntry) MapToDynamo() (*CPoIItem, error) {
-	gh, err := newGeoHash(cte.Latitude, cte.Longitude)
+	key, err := defaultSpatialIndex.Key(cte.Latitude, cte.Longitude)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create geohash: %w", err)
 	}
 	id := ksuid.New().String()
-	return &CPoIItem{
-		Pk: id,
-		GeoIndexPk: gh.trimmed(
-			CPoIItemCellLevel,
-		), // the trimmed geo hash representing a tile
-		GeoIndexSk:        gh.hash(), // the full length geo hash
+	item := &CPoIItem{
+		Pk:                id,
 		ID:                id,
 		Street:            cte.Street,
 		StreetNumber:      cte.StreetNumber,
@@ -183,7 +260,12 @@ func (cte *ChargingCSVEntry) MapToDynamo() (*CPoIItem, error) {
 		EntranceLongitude: cte.Longitude,
 		EntranceLatitude:  cte.Latitude,
 		Features:          cte.features(),
-	}, nil
+		MaxPowerKW:        float64(cte.Power),
+		ChargePoints:      int(cte.NumberOfChargePoints),
+		Connectors:        cte.connectors(),
+	}
+	key.apply(item)
+	return item, nil
 }
 
 func (cte *ChargingCSVEntry) features() []string {
@@ -199,6 +281,26 @@ func (cte *ChargingCSVEntry) features() []string {
 	return features
 }
 
+// connectors maps the non empty plug types, the current is derived from the plug type, e.g. "DC Kupplung Combo"
+func (cte *ChargingCSVEntry) connectors() []CPoIConnector {
+	connectors := make([]CPoIConnector, 0, 4)
+	for _, plug := range []string{cte.PlugType1, cte.PlugType2, cte.PlugType3, cte.PlugType4} {
+		plug = strings.TrimSpace(plug)
+		if plug == "" {
+			continue
+		}
+		connector := CPoIConnector{PlugType: plug}
+		switch {
+		case strings.Contains(plug, dc):
+			connector.Current = dc
+		case strings.Contains(plug, ac):
+			connector.Current = ac
+		}
+		connectors = append(connectors, connector)
+	}
+	return connectors
+}
+
 func (cte *ChargingCSVEntry) hasAcCharging() bool {
 	return strings.Contains(cte.PlugType1, ac) || strings.Contains(cte.PlugType2, ac) ||
 		strings.Contains(cte.PlugType3, ac) ||
@@ -216,18 +318,23 @@ type IonItem struct {
 }
 
 type CPoIIonItem struct {
-	Pk                string      `ion:"pk"`
-	GeoIndexPk        ion.Decimal `ion:"gsi1_geo_pk"`
-	GeoIndexSk        ion.Decimal `ion:"gsi1_geo_sk"`
-	ID                string      `ion:"id"`
-	Street            string      `ion:"street"`
-	StreetNumber      string      `ion:"street_number"`
-	ZipCode           string      `ion:"zip_code"`
-	City              string      `ion:"city"`
-	CountryCode       string      `ion:"country_code"`
-	Features          []string    `ion:"features"`
-	Longitude         ion.Decimal `ion:"lon"`
-	Latitude          ion.Decimal `ion:"lat"`
-	EntranceLongitude ion.Decimal `ion:"entrance_lon"`
-	EntranceLatitude  ion.Decimal `ion:"entrance_lat"`
+	Pk                string          `ion:"pk"`
+	GeoIndexPk        ion.Decimal     `ion:"gsi1_geo_pk"`
+	GeoIndexSk        ion.Decimal     `ion:"gsi1_geo_sk"`
+	CoarseGeoIndexPk  ion.Decimal     `ion:"gsi2_geo_pk"`
+	FineGeoIndexPk    ion.Decimal     `ion:"gsi3_geo_pk"`
+	ID                string          `ion:"id"`
+	Street            string          `ion:"street"`
+	StreetNumber      string          `ion:"street_number"`
+	ZipCode           string          `ion:"zip_code"`
+	City              string          `ion:"city"`
+	CountryCode       string          `ion:"country_code"`
+	Features          []string        `ion:"features"`
+	Longitude         ion.Decimal     `ion:"lon"`
+	Latitude          ion.Decimal     `ion:"lat"`
+	EntranceLongitude ion.Decimal     `ion:"entrance_lon"`
+	EntranceLatitude  ion.Decimal     `ion:"entrance_lat"`
+	MaxPowerKW        ion.Decimal     `ion:"max_power_kw"`
+	ChargePoints      int             `ion:"charge_points"`
+	Connectors        []CPoIConnector `ion:"connectors"`
 }
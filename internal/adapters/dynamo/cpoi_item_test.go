@@ -28,6 +28,12 @@ var _ = Describe("Given charging location CPoIItem", func() {
 			Latitude:          15.5,
 			EntranceLongitude: 12.53,
 			EntranceLatitude:  15.52,
+			MaxPowerKW:        150.0,
+			ChargePoints:      2,
+			Connectors: []dynamo.CPoIConnector{
+				{PlugType: "DC Kupplung Combo", Current: "DC"},
+				{PlugType: "AC Steckdose Typ 2", Current: "AC"},
+			},
 		}
 		It("does not return an error when parsed to Domain", func() {
 			expectedDomain := poi.PoILocation{
@@ -48,6 +54,14 @@ var _ = Describe("Given charging location CPoIItem", func() {
 					Longitude: 12.53,
 				},
 				Features: []string{"1", "2"},
+				Charging: &poi.ChargingInfo{
+					MaxPowerKW:   150.0,
+					ChargePoints: 2,
+					Connectors: []poi.ChargingConnector{
+						{PlugType: "DC Kupplung Combo", Current: poi.CurrentDC},
+						{PlugType: "AC Steckdose Typ 2", Current: poi.CurrentAC},
+					},
+				},
 			}
 			actual, err := poiItem.Domain()
 			Expect(err).To(Not(HaveOccurred()))
@@ -55,6 +69,25 @@ var _ = Describe("Given charging location CPoIItem", func() {
 		})
 	})
 
+	When("item has no charging info", func() {
+		pk := ksuid.New()
+		poiItem := dynamo.CPoIItem{
+			Pk:         pk.String(),
+			GeoIndexPk: 1234,
+			GeoIndexSk: 12456789012,
+			ID:         pk.String(),
+			Features:   []string{"2_CHARGEPOINTS", "22_KW_CHARGING", "AC_CHARGING"},
+			Longitude:  12.5,
+			Latitude:   15.5,
+		}
+		It("is parsed to Domain without charging info", func() {
+			actual, err := poiItem.Domain()
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(actual.Charging).To(BeNil())
+			Expect(actual.Features).To(Equal(poiItem.Features))
+		})
+	})
+
 	When("item has invalid id", func() {
 		poiItem := dynamo.CPoIItem{
 			Pk:                "NOT A KSUID",
@@ -97,6 +130,13 @@ var _ = Describe("Given charging location CPoIItem", func() {
 				Longitude: 15.4,
 			},
 			Features: []string{"blub"},
+			Charging: &poi.ChargingInfo{
+				MaxPowerKW:   22.0,
+				ChargePoints: 1,
+				Connectors: []poi.ChargingConnector{
+					{PlugType: "AC Steckdose Typ 2", Current: poi.CurrentAC},
+				},
+			},
 		}
 		It("is valid location", func() {
 			expected := dynamo.CPoIItem{
@@ -108,12 +148,19 @@ var _ = Describe("Given charging location CPoIItem", func() {
 				EntranceLatitude:  domain.LocationEntrance.Latitude,
 				GeoIndexPk:        1231351868039364608,
 				GeoIndexSk:        1231347589921125375,
+				CoarseGeoIndexPk:  1231453023109120000,
+				FineGeoIndexPk:    1231347538712330240,
 				Street:            domain.Address.Street,
 				StreetNumber:      domain.Address.StreetNumber,
 				ZipCode:           domain.Address.ZipCode,
 				City:              domain.Address.City,
 				CountryCode:       domain.Address.CountryCode,
 				Features:          domain.Features,
+				MaxPowerKW:        22.0,
+				ChargePoints:      1,
+				Connectors: []dynamo.CPoIConnector{
+					{PlugType: "AC Steckdose Typ 2", Current: "AC"},
+				},
 			}
 			actual, err := dynamo.NewItemFromDomain(&domain)
 			Expect(err).To(Not(HaveOccurred()))
@@ -151,6 +198,8 @@ var _ = Describe("Given charging location CPoIItem", func() {
 					EntranceLatitude:  14.5,
 					GeoIndexPk:        1231351868039364608,
 					GeoIndexSk:        1231347589921125375,
+					CoarseGeoIndexPk:  1231453023109120000,
+					FineGeoIndexPk:    1231347538712330240,
 					Street:            "Strasse",
 					StreetNumber:      "12a",
 					ZipCode:           "123456",
@@ -162,6 +211,13 @@ var _ = Describe("Given charging location CPoIItem", func() {
 						"AC_CHARGING",
 						"DC_CHARGING",
 					},
+					MaxPowerKW:   250.0,
+					ChargePoints: 3,
+					Connectors: []dynamo.CPoIConnector{
+						{PlugType: "AC CCS COMBO1", Current: "AC"},
+						{PlugType: "DC CSS COMBO2", Current: "DC"},
+						{PlugType: "DC CSS COMBO2", Current: "DC"},
+					},
 				},
 			}
 
@@ -178,6 +234,8 @@ var _ = Describe("Given charging location CPoIItem", func() {
 			Pk:                pk.String(),
 			GeoIndexPk:        1234,
 			GeoIndexSk:        123456789012,
+			CoarseGeoIndexPk:  12,
+			FineGeoIndexPk:    123456,
 			ID:                pk.String(),
 			Street:            "Some Street",
 			StreetNumber:      "12b",
@@ -194,6 +252,8 @@ var _ = Describe("Given charging location CPoIItem", func() {
 			Pk:                pk.String(),
 			GeoIndexPk:        *ion.NewDecimalInt(1234),
 			GeoIndexSk:        *ion.NewDecimalInt(123456789012),
+			CoarseGeoIndexPk:  *ion.NewDecimalInt(12),
+			FineGeoIndexPk:    *ion.NewDecimalInt(123456),
 			ID:                pk.String(),
 			Street:            "Some Street",
 			StreetNumber:      "12b",
@@ -205,6 +265,7 @@ var _ = Describe("Given charging location CPoIItem", func() {
 			Latitude:          *ion.MustParseDecimal("15.5"),
 			EntranceLongitude: *ion.MustParseDecimal("12.53"),
 			EntranceLatitude:  *ion.MustParseDecimal("15.52"),
+			MaxPowerKW:        *ion.MustParseDecimal("0"),
 		}
 		expectedIonItem := dynamo.IonItem{expectedIonCp}
 
@@ -230,6 +291,7 @@ func assertDomainToEqual(actual, expected *poi.PoILocation) {
 	)
 	Expect(actual.Address).To(Equal(expected.Address))
 	Expect(actual.Features).To(Equal(expected.Features))
+	Expect(actual.Charging).To(Equal(expected.Charging))
 	Expect(actual.ID).To(Equal(expected.ID))
 }
 
@@ -252,6 +314,11 @@ func assertItemToEqualWithoutID(actual, expected *dynamo.CPoIItem) {
 	Expect(actual.ZipCode).To(Equal(expected.ZipCode))
 	Expect(actual.GeoIndexPk).To(Equal(expected.GeoIndexPk))
 	Expect(actual.GeoIndexSk).To(Equal(expected.GeoIndexSk))
+	Expect(actual.CoarseGeoIndexPk).To(Equal(expected.CoarseGeoIndexPk))
+	Expect(actual.FineGeoIndexPk).To(Equal(expected.FineGeoIndexPk))
 	Expect(actual.CountryCode).To(Equal(expected.CountryCode))
 	Expect(actual.Features).To(Equal(expected.Features))
+	Expect(actual.MaxPowerKW).To(BeNumerically("~", expected.MaxPowerKW))
+	Expect(actual.ChargePoints).To(Equal(expected.ChargePoints))
+	Expect(actual.Connectors).To(Equal(expected.Connectors))
 }
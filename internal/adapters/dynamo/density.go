@@ -0,0 +1,433 @@
+package dynamo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/golang/geo/s2"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/grntlrduck-cloud/go-grpc-geohasing-service-sample/internal/adapters/geo"
+	"github.com/grntlrduck-cloud/go-grpc-geohasing-service-sample/internal/domain/poi"
+)
+
+// The density aggregates are stored in the table as one item per cell of the partition level, keyed by the prefix
+// and the token of the cell. For each cell of the aggregate level within, the item has a number attribute for the
+// count, the charge points, and the power, named by their prefix and the token of the cell. Writes add the change
+// of the cells to the attributes, so that concurrent writes do not overwrite each other. The items have no geo index
+// attributes and are not part of the geo indexes.
+const (
+	densityPartitionLevel     = CPoIItemCoarseCellLevel // 256 cells of the aggregate level per item
+	densityPKPrefix           = "density#"
+	densityCountPrefix        = "n_"
+	densityChargePointsPrefix = "cp_"
+	densityPowerPrefix        = "kw_"
+	densityUpdateCells        = 50 // cells per update, keeps the update expression below the limit of 4 KB
+)
+
+// the attributes of the items required to count the density of the locations
+var densityAttributes = []string{"id", "lat", "lon", "features", "max_power_kw", "charge_points"}
+
+// WithDensityAggregates enables the density aggregates maintained on writes, enabled by default. The locations are
+// written in transactions with their aggregates then. If disabled, the density is always counted from the locations,
+// e.g. for tables whose aggregates have not been rebuilt yet.
+func WithDensityAggregates(enabled bool) PoIGeoRepositoryOptions {
+	return func(p *PoIGeoRepository) {
+		p.density = enabled
+	}
+}
+
+// GetDensity sums up the density aggregates of the area for levels up to the aggregate level, finer levels are
+// counted from the locations queried from the geo index
+func (pgr *PoIGeoRepository) GetDensity(
+	ctx context.Context,
+	area *poi.DensityArea,
+	level int,
+	logger *zap.Logger,
+) (*poi.DensityResult, error) {
+	// handle context cancelation
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+	covering, err := geo.CoverDensityArea(area, logger)
+	if err != nil {
+		return nil, err
+	}
+	cells := geo.DensityCells(covering, level)
+	if pgr.density && level <= geo.DensityAggregateLevel {
+		aggregates, err := pgr.getDensityAggregates(ctx, densityPartitions(cells), logger)
+		if err != nil {
+			return nil, err
+		}
+		return geo.NewDensityResultFromAggregates(aggregates, cells, covering, level, logger), nil
+	}
+	queries := pgr.queryInputFromCells(logger, cells, nil)
+	for _, q := range queries {
+		applyProjection(q, densityAttributes)
+	}
+	locations := make([]*poi.PoILocation, 0, len(queries))
+	err = pgr.streamQueries(ctx, logger, queries, func(l []*poi.PoILocation) error {
+		locations = append(locations, l...)
+		return nil
+	})
+	if err != nil {
+		logger.Error("failed to query density",
+			zap.Error(err),
+			zap.Int("num_cells", len(cells)),
+		)
+		return nil, poi.ErrDBQuery
+	}
+	return geo.NewDensityResult(locations, cells, covering, level, logger), nil
+}
+
+// getDensityAggregates gets the items of the partitions and returns the aggregates they hold. The batches of
+// partitions are read in parallel, since the world-wide clusters of the lowest zoom levels read all partitions.
+func (pgr *PoIGeoRepository) getDensityAggregates(
+	ctx context.Context,
+	partitions []s2.CellID,
+	logger *zap.Logger,
+) (geo.DensityAggregates, error) {
+	logger.Info("getting density aggregates", zap.Int("num_partitions", len(partitions)))
+	aggregates := make(geo.DensityAggregates)
+	var mu sync.Mutex
+	errGrp, gctx := errgroup.WithContext(ctx)
+	errGrp.SetLimit(maxConcurrentQueries)
+	for start := 0; start < len(partitions); start += dynamoMaxBatchGetKeys {
+		chunk := partitions[start:min(start+dynamoMaxBatchGetKeys, len(partitions))]
+		errGrp.Go(func() error {
+			keys := make([]map[string]types.AttributeValue, len(chunk))
+			for i, p := range chunk {
+				keys[i] = densityKey(p)
+			}
+			avs, err := pgr.batchGet(gctx, keys, false, logger)
+			if err != nil {
+				return err
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			for _, av := range avs {
+				if err := addDensityItem(aggregates, av); err != nil {
+					logger.Error("failed to map density item", zap.Error(err))
+					return poi.ErrDBEntityMapping
+				}
+			}
+			return nil
+		})
+	}
+	if err := errGrp.Wait(); err != nil {
+		return nil, err
+	}
+	return aggregates, nil
+}
+
+// a densityWrite puts the item of the location, or deletes the item if the location is nil
+type densityWrite struct {
+	pk        string
+	location  *poi.PoILocation
+	item      map[string]types.AttributeValue
+	condition *writeCondition // optional
+}
+
+// writeWithDensity writes the items and adds the change of the density aggregates in one transaction, so that the
+// aggregates do not drift from the locations. The change is computed from the stored items, which are read before,
+// and each write requires its stored item to be unchanged since. If any stored item has changed in between, the
+// items are read again. At most dynamoMaxBatchSize items are written, so that the changes of each partition fit
+// into a single update.
+func (pgr *PoIGeoRepository) writeWithDensity(
+	ctx context.Context,
+	writes []*densityWrite,
+	logger *zap.Logger,
+) error {
+	keys := make([]map[string]types.AttributeValue, len(writes))
+	for i, w := range writes {
+		keys[i] = map[string]types.AttributeValue{CPoIItemPK: &types.AttributeValueMemberS{Value: w.pk}}
+	}
+	for attempt := 0; ; attempt++ {
+		if attempt == batchWriteMaxAttempts {
+			return fmt.Errorf("stored items changed concurrently in %d attempts", attempt)
+		}
+		if attempt > 0 {
+			if err := sleepWithContext(ctx, backoff(attempt)); err != nil {
+				return err
+			}
+		}
+		avs, err := pgr.batchGet(ctx, keys, true, logger)
+		if err != nil {
+			return err
+		}
+		stored := make(map[string]*CPoIItem, len(avs))
+		for _, av := range avs {
+			item := new(CPoIItem)
+			if err := attributevalue.UnmarshalMap(av, item); err != nil {
+				return fmt.Errorf("failed to unmarshal stored item: %w", err)
+			}
+			stored[item.Pk] = item
+		}
+		input, err := pgr.densityTransaction(writes, stored)
+		if err != nil {
+			return err
+		}
+		err = transactWithRetry(ctx, pgr.dynamoClient, input)
+		var canceled *types.TransactionCanceledException
+		if errors.As(err, &canceled) && canceledByConcurrentWrite(canceled) {
+			logger.Debug("density transaction canceled by concurrent write, retrying",
+				zap.Int("attempt", attempt),
+				zap.Error(err),
+			)
+			continue
+		}
+		return err
+	}
+}
+
+// densityTransaction returns the transaction of the writes and the update of the density aggregates with their change.
+// The conditions of the writes are checked for the stored items before, so that their errors are returned.
+func (pgr *PoIGeoRepository) densityTransaction(
+	writes []*densityWrite,
+	stored map[string]*CPoIItem,
+) (*dynamodb.TransactWriteItemsInput, error) {
+	changes := make(geo.DensityAggregates)
+	items := make([]types.TransactWriteItem, 0, len(writes))
+	for _, w := range writes {
+		s := stored[w.pk]
+		if w.condition != nil {
+			if err := w.condition.check(s); err != nil {
+				return nil, err
+			}
+		}
+		var old *poi.PoILocation
+		if s != nil {
+			var err error
+			if old, err = s.Domain(); err != nil {
+				return nil, fmt.Errorf("failed to map stored item %s: %w", s.Pk, err)
+			}
+		}
+		changes.Replace(old, w.location)
+		condition := w.condition.and(unchangedCondition(s))
+		if w.location == nil {
+			items = append(items, types.TransactWriteItem{Delete: &types.Delete{
+				TableName:                 aws.String(pgr.tableName),
+				Key:                       map[string]types.AttributeValue{CPoIItemPK: &types.AttributeValueMemberS{Value: w.pk}},
+				ConditionExpression:       aws.String(condition.expression),
+				ExpressionAttributeNames:  condition.names,
+				ExpressionAttributeValues: condition.values,
+			}})
+			continue
+		}
+		w.item[CPoIItemRevision] = &types.AttributeValueMemberS{Value: uuid.NewString()}
+		items = append(items, types.TransactWriteItem{Put: &types.Put{
+			TableName:                 aws.String(pgr.tableName),
+			Item:                      w.item,
+			ConditionExpression:       aws.String(condition.expression),
+			ExpressionAttributeNames:  condition.names,
+			ExpressionAttributeValues: condition.values,
+		}})
+	}
+	for _, u := range densityUpdates(pgr.tableName, changes) {
+		items = append(items, types.TransactWriteItem{Update: &types.Update{
+			TableName:                 u.TableName,
+			Key:                       u.Key,
+			UpdateExpression:          u.UpdateExpression,
+			ExpressionAttributeNames:  u.ExpressionAttributeNames,
+			ExpressionAttributeValues: u.ExpressionAttributeValues,
+		}})
+	}
+	return &dynamodb.TransactWriteItemsInput{
+		TransactItems:      items,
+		ClientRequestToken: aws.String(uuid.NewString()),
+	}, nil
+}
+
+// unchangedCondition requires the stored item, nil if no item is stored, to be unchanged by its revision. Items
+// written without the density aggregates have no revision, their first write with the aggregates sets it.
+func unchangedCondition(stored *CPoIItem) *writeCondition {
+	switch {
+	case stored == nil:
+		return &writeCondition{
+			expression: "attribute_not_exists(#pk)",
+			names:      map[string]string{"#pk": CPoIItemPK},
+		}
+	case stored.Revision == "":
+		return &writeCondition{
+			expression: "attribute_exists(#pk) AND attribute_not_exists(#rev)",
+			names:      map[string]string{"#pk": CPoIItemPK, "#rev": CPoIItemRevision},
+		}
+	default:
+		return &writeCondition{
+			expression: "#rev = :rev",
+			names:      map[string]string{"#rev": CPoIItemRevision},
+			values:     map[string]types.AttributeValue{":rev": &types.AttributeValueMemberS{Value: stored.Revision}},
+		}
+	}
+}
+
+// transactWithRetry writes the transaction and retries failed requests with the same client request token, hence a
+// transaction which has been applied despite the error, e.g. an internal server error, is not applied twice
+func transactWithRetry(ctx context.Context, client DBClient, input *dynamodb.TransactWriteItemsInput) error {
+	for attempt := 0; ; attempt++ {
+		if attempt > 0 {
+			if err := sleepWithContext(ctx, backoff(attempt)); err != nil {
+				return err
+			}
+		}
+		_, err := client.TransactWriteItems(ctx, input)
+		var inProgress *types.TransactionInProgressException
+		if err == nil || !(retryable(err) || errors.As(err, &inProgress)) || attempt+1 == batchWriteMaxAttempts {
+			return err
+		}
+	}
+}
+
+// canceledByConcurrentWrite reports whether the transaction has been canceled since a stored item has changed, a
+// concurrent transaction wrote the same items, or it has been throttled, nothing has been written in any case
+func canceledByConcurrentWrite(canceled *types.TransactionCanceledException) bool {
+	for _, r := range canceled.CancellationReasons {
+		switch aws.ToString(r.Code) {
+		case "ConditionalCheckFailed", "TransactionConflict", "ThrottlingError", "ProvisionedThroughputExceeded":
+			return true
+		}
+	}
+	return false
+}
+
+func densityKey(partition s2.CellID) map[string]types.AttributeValue {
+	return map[string]types.AttributeValue{
+		CPoIItemPK: &types.AttributeValueMemberS{Value: densityPKPrefix + partition.ToToken()},
+	}
+}
+
+// isDensityItem reports whether the item holds density aggregates instead of a location
+func isDensityItem(av map[string]types.AttributeValue) bool {
+	pk, ok := av[CPoIItemPK].(*types.AttributeValueMemberS)
+	return ok && strings.HasPrefix(pk.Value, densityPKPrefix)
+}
+
+// densityPartitions returns the cells of the partition level containing or contained by the cells
+func densityPartitions(cells []s2.CellID) []s2.CellID {
+	partitions := make([]s2.CellID, 0, len(cells))
+	for _, c := range cells {
+		if c.Level() >= densityPartitionLevel {
+			partitions = append(partitions, c.Parent(densityPartitionLevel))
+			continue
+		}
+		for p := c.ChildBeginAtLevel(densityPartitionLevel); p != c.ChildEndAtLevel(densityPartitionLevel); p = p.Next() {
+			partitions = append(partitions, p)
+		}
+	}
+	slices.Sort(partitions)
+	return slices.Compact(partitions)
+}
+
+// densityPartition returns the density partition the location adds to
+func densityPartition(l *poi.PoILocation) s2.CellID {
+	return geo.DensityAggregateCell(l.Location).Parent(densityPartitionLevel)
+}
+
+// densityUpdates returns the updates adding the changes to the items of their partitions
+func densityUpdates(tableName string, changes geo.DensityAggregates) []*dynamodb.UpdateItemInput {
+	cells := make([]s2.CellID, 0, len(changes))
+	for c := range changes {
+		cells = append(cells, c)
+	}
+	slices.Sort(cells)
+	updates := make([]*dynamodb.UpdateItemInput, 0)
+	for start := 0; start < len(cells); {
+		// the cells of a partition are contiguous, since the cells are sorted
+		partition := cells[start].Parent(densityPartitionLevel)
+		end := start
+		for end < len(cells) && end-start < densityUpdateCells && cells[end].Parent(densityPartitionLevel) == partition {
+			end++
+		}
+		adds := make([]string, 0, end-start)
+		names := make(map[string]string, 3*(end-start))
+		values := make(map[string]types.AttributeValue, 3*(end-start))
+		for i, c := range cells[start:end] {
+			count := changes[c]
+			for _, a := range []struct {
+				prefix string
+				value  string
+			}{
+				{densityCountPrefix, strconv.Itoa(count.Count)},
+				{densityChargePointsPrefix, strconv.Itoa(count.ChargePoints)},
+				{densityPowerPrefix, strconv.FormatFloat(count.PowerKW, 'f', -1, 64)},
+			} {
+				placeholder := a.prefix + strconv.Itoa(i)
+				adds = append(adds, fmt.Sprintf("#%s :%s", placeholder, placeholder))
+				names["#"+placeholder] = a.prefix + c.ToToken()
+				values[":"+placeholder] = &types.AttributeValueMemberN{Value: a.value}
+			}
+		}
+		updates = append(updates, &dynamodb.UpdateItemInput{
+			TableName:                 aws.String(tableName),
+			Key:                       densityKey(partition),
+			UpdateExpression:          aws.String("ADD " + strings.Join(adds, ", ")),
+			ExpressionAttributeNames:  names,
+			ExpressionAttributeValues: values,
+		})
+		start = end
+	}
+	return updates
+}
+
+// densityItem returns the item holding the aggregates of the partition
+func densityItem(partition s2.CellID, aggregates geo.DensityAggregates) map[string]types.AttributeValue {
+	item := densityKey(partition)
+	for c, count := range aggregates {
+		if c.Parent(densityPartitionLevel) != partition {
+			continue
+		}
+		token := c.ToToken()
+		item[densityCountPrefix+token] = &types.AttributeValueMemberN{Value: strconv.Itoa(count.Count)}
+		item[densityChargePointsPrefix+token] = &types.AttributeValueMemberN{Value: strconv.Itoa(count.ChargePoints)}
+		item[densityPowerPrefix+token] = &types.AttributeValueMemberN{
+			Value: strconv.FormatFloat(count.PowerKW, 'f', -1, 64),
+		}
+	}
+	return item
+}
+
+// addDensityItem adds the aggregates of the item to the aggregates
+func addDensityItem(aggregates geo.DensityAggregates, av map[string]types.AttributeValue) error {
+	for name, value := range av {
+		prefix, token, ok := strings.Cut(name, "_")
+		if !ok {
+			continue
+		}
+		n, ok := value.(*types.AttributeValueMemberN)
+		if !ok {
+			return fmt.Errorf("density attribute %s is not a number", name)
+		}
+		cell := s2.CellIDFromToken(token)
+		if !cell.IsValid() || cell.Level() != geo.DensityAggregateLevel {
+			return fmt.Errorf("density attribute %s is not of a cell of the aggregate level", name)
+		}
+		var count poi.DensityCount
+		var err error
+		switch prefix + "_" {
+		case densityCountPrefix:
+			count.Count, err = strconv.Atoi(n.Value)
+		case densityChargePointsPrefix:
+			count.ChargePoints, err = strconv.Atoi(n.Value)
+		case densityPowerPrefix:
+			count.PowerKW, err = strconv.ParseFloat(n.Value, 64)
+		default:
+			return fmt.Errorf("unknown density attribute %s", name)
+		}
+		if err != nil {
+			return fmt.Errorf("invalid density attribute %s: %w", name, err)
+		}
+		aggregates.Add(cell, count)
+	}
+	return nil
+}
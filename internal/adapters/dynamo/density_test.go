@@ -0,0 +1,427 @@
+package dynamo
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/golang/geo/s2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/segmentio/ksuid"
+	"go.uber.org/zap"
+
+	"github.com/grntlrduck-cloud/go-grpc-geohasing-service-sample/internal/adapters/geo"
+	"github.com/grntlrduck-cloud/go-grpc-geohasing-service-sample/internal/domain/poi"
+)
+
+// addUpdates applies the ADD actions of the updates to the items like the table does
+func addUpdates(items map[string]map[string]types.AttributeValue, updates []*dynamodb.UpdateItemInput) {
+	for _, u := range updates {
+		pk := u.Key[CPoIItemPK].(*types.AttributeValueMemberS).Value
+		if items[pk] == nil {
+			items[pk] = map[string]types.AttributeValue{CPoIItemPK: u.Key[CPoIItemPK]}
+		}
+		for _, action := range strings.Split(strings.TrimPrefix(*u.UpdateExpression, "ADD "), ", ") {
+			name, value, _ := strings.Cut(action, " ")
+			attribute := u.ExpressionAttributeNames[name]
+			sum, _ := strconv.ParseFloat(u.ExpressionAttributeValues[value].(*types.AttributeValueMemberN).Value, 64)
+			if old, ok := items[pk][attribute]; ok {
+				n, _ := strconv.ParseFloat(old.(*types.AttributeValueMemberN).Value, 64)
+				sum += n
+			}
+			items[pk][attribute] = &types.AttributeValueMemberN{Value: strconv.FormatFloat(sum, 'f', -1, 64)}
+		}
+	}
+}
+
+// transactClient fakes BatchGetItem and TransactWriteItems requests, the conditions of the writes are evaluated for the
+// revision guard only, all other requests of the DBClient are not implemented. Like the table, transactions updating an
+// item which is updated by a transaction in progress are canceled with a transaction conflict.
+type transactClient struct {
+	DBClient
+	mu           sync.Mutex
+	items        map[string]map[string]types.AttributeValue
+	tokens       map[string]bool
+	inProgress   map[string]bool // the items updated by the transactions in progress
+	transactions int             // the transactions which have been applied
+	conflicts    int             // the transactions which have been canceled by a conflict
+	failApplied  int             // the number of requests to fail after the transaction has been applied
+	before       func()          // called once before the next transaction is applied
+	duration     time.Duration   // the time a transaction is in progress
+}
+
+func (c *transactClient) BatchGetItem(
+	_ context.Context,
+	input *dynamodb.BatchGetItemInput,
+) (*dynamodb.BatchGetItemOutput, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	out := &dynamodb.BatchGetItemOutput{Responses: make(map[string][]map[string]types.AttributeValue)}
+	for table, request := range input.RequestItems {
+		for _, key := range request.Keys {
+			if item, ok := c.items[key[CPoIItemPK].(*types.AttributeValueMemberS).Value]; ok {
+				out.Responses[table] = append(out.Responses[table], item)
+			}
+		}
+	}
+	return out, nil
+}
+
+func (c *transactClient) TransactWriteItems(
+	_ context.Context,
+	input *dynamodb.TransactWriteItemsInput,
+) (*dynamodb.TransactWriteItemsOutput, error) {
+	c.mu.Lock()
+	before := c.before
+	c.before = nil
+	c.mu.Unlock()
+	if before != nil {
+		before()
+	}
+	if err := c.begin(input); err != nil {
+		return nil, err
+	}
+	time.Sleep(c.duration)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	defer c.end(input)
+	if !c.tokens[*input.ClientRequestToken] {
+		var updates []*dynamodb.UpdateItemInput
+		for _, item := range input.TransactItems {
+			switch {
+			case item.Put != nil:
+				if !c.unchanged(item.Put.Item[CPoIItemPK], item.Put.ExpressionAttributeValues) {
+					return nil, canceledTransaction()
+				}
+			case item.Delete != nil:
+				if !c.unchanged(item.Delete.Key[CPoIItemPK], item.Delete.ExpressionAttributeValues) {
+					return nil, canceledTransaction()
+				}
+			}
+		}
+		for _, item := range input.TransactItems {
+			switch {
+			case item.Put != nil:
+				c.items[item.Put.Item[CPoIItemPK].(*types.AttributeValueMemberS).Value] = item.Put.Item
+			case item.Delete != nil:
+				delete(c.items, item.Delete.Key[CPoIItemPK].(*types.AttributeValueMemberS).Value)
+			case item.Update != nil:
+				updates = append(updates, &dynamodb.UpdateItemInput{
+					Key:                       item.Update.Key,
+					UpdateExpression:          item.Update.UpdateExpression,
+					ExpressionAttributeNames:  item.Update.ExpressionAttributeNames,
+					ExpressionAttributeValues: item.Update.ExpressionAttributeValues,
+				})
+			}
+		}
+		addUpdates(c.items, updates)
+		c.tokens[*input.ClientRequestToken] = true
+		c.transactions++
+	}
+	if c.failApplied > 0 {
+		c.failApplied--
+		return nil, &types.InternalServerError{}
+	}
+	return &dynamodb.TransactWriteItemsOutput{}, nil
+}
+
+// begin marks the items updated by the transaction as in progress, unless any of them is in progress already
+func (c *transactClient) begin(input *dynamodb.TransactWriteItemsInput) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, item := range input.TransactItems {
+		if item.Update != nil && c.inProgress[item.Update.Key[CPoIItemPK].(*types.AttributeValueMemberS).Value] {
+			c.conflicts++
+			return &types.TransactionCanceledException{
+				CancellationReasons: []types.CancellationReason{{Code: aws.String("TransactionConflict")}},
+			}
+		}
+	}
+	if c.inProgress == nil {
+		c.inProgress = make(map[string]bool)
+	}
+	for _, item := range input.TransactItems {
+		if item.Update != nil {
+			c.inProgress[item.Update.Key[CPoIItemPK].(*types.AttributeValueMemberS).Value] = true
+		}
+	}
+	return nil
+}
+
+// end removes the items updated by the transaction from the items in progress
+func (c *transactClient) end(input *dynamodb.TransactWriteItemsInput) {
+	for _, item := range input.TransactItems {
+		if item.Update != nil {
+			delete(c.inProgress, item.Update.Key[CPoIItemPK].(*types.AttributeValueMemberS).Value)
+		}
+	}
+}
+
+// unchanged reports whether the stored item has the revision of the guard, no revision is guarded for missing items
+func (c *transactClient) unchanged(pk types.AttributeValue, values map[string]types.AttributeValue) bool {
+	stored, ok := c.items[pk.(*types.AttributeValueMemberS).Value]
+	rev, guarded := values[":rev"]
+	if !guarded {
+		return !ok
+	}
+	return ok && stored[CPoIItemRevision].(*types.AttributeValueMemberS).Value == rev.(*types.AttributeValueMemberS).Value
+}
+
+func canceledTransaction() error {
+	return &types.TransactionCanceledException{
+		CancellationReasons: []types.CancellationReason{{Code: aws.String("ConditionalCheckFailed")}},
+	}
+}
+
+var _ = Describe("given density aggregates", func() {
+	munich := &poi.PoILocation{
+		Location: poi.Coordinates{Latitude: 48.137154, Longitude: 11.576124},
+		Charging: &poi.ChargingInfo{ChargePoints: 4, MaxPowerKW: 150},
+	}
+	berlin := &poi.PoILocation{
+		Location: poi.Coordinates{Latitude: 52.520008, Longitude: 13.404954},
+		Features: []string{"AC_CHARGING"},
+	}
+
+	readItems := func(items map[string]map[string]types.AttributeValue) geo.DensityAggregates {
+		aggregates := make(geo.DensityAggregates)
+		for _, av := range items {
+			Expect(isDensityItem(av)).To(BeTrue())
+			Expect(addDensityItem(aggregates, av)).To(Succeed())
+		}
+		return aggregates
+	}
+
+	When("changes are added", func() {
+		It("updates one item per partition which sums up to the changes", func() {
+			changes := make(geo.DensityAggregates)
+			changes.Replace(nil, munich)
+			changes.Replace(nil, berlin)
+			updates := densityUpdates("table", changes)
+			Expect(updates).To(HaveLen(2))
+			items := make(map[string]map[string]types.AttributeValue)
+			addUpdates(items, updates)
+			Expect(readItems(items)).To(Equal(changes))
+
+			moved := make(geo.DensityAggregates)
+			moved.Replace(berlin, munich)
+			addUpdates(items, densityUpdates("table", moved))
+			expected := make(geo.DensityAggregates)
+			expected.Replace(nil, munich)
+			expected.Replace(nil, munich)
+			Expect(readItems(items)).To(Equal(expected))
+		})
+
+		It("splits the updates of a partition into chunks", func() {
+			partition := geo.DensityAggregateCell(munich.Location).Parent(densityPartitionLevel)
+			changes := make(geo.DensityAggregates)
+			c := partition.ChildBeginAtLevel(geo.DensityAggregateLevel)
+			for range densityUpdateCells + 10 {
+				changes.Add(c, poi.DensityCount{Count: 1, ChargePoints: 2, PowerKW: 22})
+				c = c.Next()
+			}
+			updates := densityUpdates("table", changes)
+			Expect(updates).To(HaveLen(2))
+			for _, u := range updates {
+				Expect(u.Key).To(Equal(densityKey(partition)))
+				Expect(len(u.ExpressionAttributeNames)).To(BeNumerically("<=", 3*densityUpdateCells))
+			}
+			items := make(map[string]map[string]types.AttributeValue)
+			addUpdates(items, updates)
+			Expect(readItems(items)).To(Equal(changes))
+		})
+	})
+
+	When("item of partition is written", func() {
+		It("holds the aggregates of the partition only", func() {
+			aggregates := make(geo.DensityAggregates)
+			aggregates.Replace(nil, munich)
+			aggregates.Replace(nil, berlin)
+			partition := geo.DensityAggregateCell(munich.Location).Parent(densityPartitionLevel)
+			actual := make(geo.DensityAggregates)
+			Expect(addDensityItem(actual, densityItem(partition, aggregates))).To(Succeed())
+			Expect(actual).To(Equal(geo.DensityAggregates{
+				geo.DensityAggregateCell(munich.Location): poi.NewDensityCount(munich),
+			}))
+		})
+
+		It("returns error for attribute of other level", func() {
+			cell := geo.DensityAggregateCell(munich.Location).Parent(geo.DensityAggregateLevel - 1)
+			av := map[string]types.AttributeValue{
+				densityCountPrefix + cell.ToToken(): &types.AttributeValueMemberN{Value: "1"},
+			}
+			Expect(addDensityItem(make(geo.DensityAggregates), av)).To(Not(Succeed()))
+		})
+	})
+
+	When("partitions of cells are selected", func() {
+		It("returns the partitions containing or contained by the cells", func() {
+			fine := geo.DensityAggregateCell(munich.Location)
+			coarse := fine.Parent(densityPartitionLevel - 1)
+			partitions := densityPartitions([]s2.CellID{fine, fine.Next(), coarse})
+			Expect(partitions).To(HaveLen(4))
+			Expect(partitions).To(ContainElement(fine.Parent(densityPartitionLevel)))
+		})
+	})
+
+	When("locations are written with the aggregates", func() {
+		ctx := context.Background()
+		var (
+			client *transactClient
+			repo   poi.Repository
+		)
+
+		BeforeEach(func() {
+			client = &transactClient{
+				items:  make(map[string]map[string]types.AttributeValue),
+				tokens: make(map[string]bool),
+			}
+			var err error
+			repo, err = NewPoIGeoRepository(zap.NewNop(),
+				WithDynamoClientWrapper(client),
+				WithTableName("table"),
+				WithPageTokenSecret("secret"),
+			)
+			Expect(err).To(Not(HaveOccurred()))
+		})
+
+		storedAggregates := func() geo.DensityAggregates {
+			aggregates := make(geo.DensityAggregates)
+			for _, av := range client.items {
+				if isDensityItem(av) {
+					Expect(addDensityItem(aggregates, av)).To(Succeed())
+				}
+			}
+			for c, count := range aggregates {
+				if count == (poi.DensityCount{}) {
+					delete(aggregates, c)
+				}
+			}
+			return aggregates
+		}
+
+		It("moves and removes the location from the aggregates", func() {
+			location := *munich
+			location.ID = ksuid.New()
+			Expect(repo.Create(ctx, &location, zap.NewNop())).To(Succeed())
+			Expect(storedAggregates()).To(Equal(geo.DensityAggregates{
+				geo.DensityAggregateCell(munich.Location): poi.NewDensityCount(munich),
+			}))
+
+			location.Location = berlin.Location
+			Expect(repo.Update(ctx, &location, zap.NewNop())).To(Succeed())
+			Expect(storedAggregates()).To(Equal(geo.DensityAggregates{
+				geo.DensityAggregateCell(berlin.Location): poi.NewDensityCount(&location),
+			}))
+
+			Expect(repo.Delete(ctx, location.ID, location.Version, zap.NewNop())).To(Succeed())
+			Expect(storedAggregates()).To(BeEmpty())
+		})
+
+		It("does not count the location twice if an applied transaction fails", func() {
+			location := *munich
+			location.ID = ksuid.New()
+			client.failApplied = 2
+			Expect(repo.Upsert(ctx, &location, zap.NewNop())).To(Succeed())
+			Expect(client.transactions).To(Equal(1))
+			Expect(storedAggregates()).To(Equal(geo.DensityAggregates{
+				geo.DensityAggregateCell(munich.Location): poi.NewDensityCount(munich),
+			}))
+		})
+
+		It("computes the change from the item written concurrently", func() {
+			location := *munich
+			location.ID = ksuid.New()
+			Expect(repo.Upsert(ctx, &location, zap.NewNop())).To(Succeed())
+			concurrent := *berlin
+			concurrent.ID = location.ID
+			client.before = func() {
+				Expect(repo.Upsert(ctx, &concurrent, zap.NewNop())).To(Succeed())
+			}
+			Expect(repo.Delete(ctx, location.ID, poi.AnyVersion, zap.NewNop())).To(Succeed())
+			Expect(client.transactions).To(Equal(3))
+			Expect(storedAggregates()).To(BeEmpty())
+		})
+
+		It("returns the error of the condition without writing", func() {
+			location := *munich
+			location.ID = ksuid.New()
+			Expect(repo.Create(ctx, &location, zap.NewNop())).To(Succeed())
+			Expect(repo.Create(ctx, &location, zap.NewNop())).To(MatchError(poi.ErrLocationAlreadyExists))
+			Expect(repo.Delete(ctx, ksuid.New(), poi.AnyVersion, zap.NewNop())).To(MatchError(poi.ErrLocationNotFound))
+			Expect(client.transactions).To(Equal(1))
+		})
+
+		It("sums up the clusters of low zoom levels from the aggregates without querying the locations", func() {
+			first, second := *munich, *berlin
+			first.ID, second.ID = ksuid.New(), ksuid.New()
+			Expect(repo.Create(ctx, &first, zap.NewNop())).To(Succeed())
+			Expect(repo.Create(ctx, &second, zap.NewNop())).To(Succeed())
+			// the client does not implement queries, the locations are not read
+			sw := poi.Coordinates{Latitude: 35.0, Longitude: -10.0}
+			ne := poi.Coordinates{Latitude: 70.0, Longitude: 40.0}
+			res, err := repo.GetClusters(ctx, sw, ne, 4, zap.NewNop())
+			Expect(err).To(Not(HaveOccurred()))
+			count := 0
+			for _, c := range res.Clusters {
+				count += c.Count
+				Expect(c.Features).To(BeEmpty())
+			}
+			Expect(count).To(Equal(2))
+			Expect(res.Truncated).To(BeFalse())
+		})
+
+		It("writes the chunks of batches adding to the same aggregates one after another", func() {
+			client.duration = 5 * time.Millisecond
+			locations := make([]*poi.PoILocation, 4*dynamoMaxBatchSize)
+			expected := make(geo.DensityAggregates)
+			for i := range locations {
+				location := *munich
+				location.ID = ksuid.New()
+				if i%2 == 1 {
+					location = *berlin
+					location.ID = ksuid.New()
+				}
+				locations[i] = &location
+				expected.Replace(nil, &location)
+			}
+			report, err := repo.UpsertBatch(ctx, locations, zap.NewNop())
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(report.Failed()).To(BeEmpty())
+			Expect(client.conflicts).To(BeZero())
+			Expect(client.transactions).To(Equal(4))
+			Expect(storedAggregates()).To(Equal(expected))
+		})
+
+		It("groups the chunks by the partitions of their locations", func() {
+			location := func(p *poi.PoILocation) *poi.PoILocation {
+				l := *p
+				l.ID = ksuid.New()
+				return &l
+			}
+			paris := &poi.PoILocation{Location: poi.Coordinates{Latitude: 48.856613, Longitude: 2.352222}}
+			pois := []*poi.PoILocation{location(munich), location(berlin), location(munich), location(paris)}
+			chunks := [][]batchWrite{{{index: 0}}, {{index: 1}}, {{index: 2}, {index: 3}}, {{index: 3}}}
+			Expect(densityChunkGroups(chunks, pois)).To(Equal([][]int{{0, 2, 3}, {1}}))
+		})
+
+		It("writes batches with the aggregates", func() {
+			first, second := *munich, *berlin
+			first.ID, second.ID = ksuid.New(), ksuid.New()
+			locations := []*poi.PoILocation{&first, &second}
+			report, err := repo.UpsertBatch(ctx, locations, zap.NewNop())
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(report.Failed()).To(BeEmpty())
+			expected := make(geo.DensityAggregates)
+			expected.Replace(nil, munich)
+			expected.Replace(nil, berlin)
+			Expect(storedAggregates()).To(Equal(expected))
+		})
+	})
+})
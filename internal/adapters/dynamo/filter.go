@@ -0,0 +1,70 @@
+package dynamo
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+
+	"github.com/grntlrduck-cloud/go-grpc-geohasing-service-sample/internal/domain/poi"
+)
+
+// applyFilter pushes the parts of the search filter down into the query which can be evaluated by DynamoDB, so that
+// fewer items are read over the wire. The connectors are nested maps which cannot be matched partially, they are
+// evaluated by the LocationService only. Items ingested without charging info never have a power, hence they
+// are not discarded by the minimum power and are evaluated by their legacy features in the LocationService.
+func applyFilter(query *dynamodb.QueryInput, filter *poi.SearchFilter) {
+	if filter.Empty() {
+		return
+	}
+	conditions := make([]string, 0)
+	names := make(map[string]string)
+	for i, f := range filter.Features {
+		names["#features"] = "features"
+		key := fmt.Sprintf(":feature%d", i)
+		query.ExpressionAttributeValues[key] = &types.AttributeValueMemberS{Value: f}
+		conditions = append(conditions, fmt.Sprintf("contains(#features, %s)", key))
+	}
+	if len(filter.CountryCodes) > 0 {
+		names["#country"] = "country_code"
+		keys := make([]string, len(filter.CountryCodes))
+		for i, c := range filter.CountryCodes {
+			keys[i] = fmt.Sprintf(":country%d", i)
+			query.ExpressionAttributeValues[keys[i]] = &types.AttributeValueMemberS{Value: c}
+		}
+		conditions = append(conditions, fmt.Sprintf("#country IN (%s)", strings.Join(keys, ", ")))
+	}
+	if filter.MinPowerKW > 0 {
+		names["#power"] = "max_power_kw"
+		query.ExpressionAttributeValues[":min_power"] = &types.AttributeValueMemberN{
+			Value: strconv.FormatFloat(filter.MinPowerKW, 'f', -1, 64),
+		}
+		query.ExpressionAttributeValues[":no_power"] = &types.AttributeValueMemberN{Value: "0"}
+		conditions = append(
+			conditions,
+			"(attribute_not_exists(#power) OR #power = :no_power OR #power >= :min_power)",
+		)
+	}
+	if len(conditions) == 0 {
+		return
+	}
+	query.FilterExpression = aws.String(strings.Join(conditions, " AND "))
+	query.ExpressionAttributeNames = names
+}
+
+// applyProjection restricts the attributes of the items returned by the query, which reduces the data sent over the
+// wire but not the consumed read capacity
+func applyProjection(query *dynamodb.QueryInput, attributes []string) {
+	names := make([]string, len(attributes))
+	if query.ExpressionAttributeNames == nil {
+		query.ExpressionAttributeNames = make(map[string]string, len(attributes))
+	}
+	for i, a := range attributes {
+		names[i] = "#p_" + a
+		query.ExpressionAttributeNames[names[i]] = a
+	}
+	query.ProjectionExpression = aws.String(strings.Join(names, ", "))
+}
@@ -0,0 +1,82 @@
+package dynamo
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/grntlrduck-cloud/go-grpc-geohasing-service-sample/internal/domain/poi"
+)
+
+var _ = Describe("given a search filter", func() {
+	newQuery := func() *dynamodb.QueryInput {
+		return &dynamodb.QueryInput{
+			ExpressionAttributeValues: map[string]types.AttributeValue{
+				":pk": &types.AttributeValueMemberN{Value: "1"},
+			},
+		}
+	}
+
+	When("filter is empty", func() {
+		It("does not set a filter expression", func() {
+			query := newQuery()
+			applyFilter(query, nil)
+			applyFilter(query, &poi.SearchFilter{})
+			Expect(query.FilterExpression).To(BeNil())
+			Expect(query.ExpressionAttributeNames).To(BeNil())
+			Expect(query.ExpressionAttributeValues).To(HaveLen(1))
+		})
+	})
+
+	When("filter has features, countries and power", func() {
+		It("pushes all conditions down", func() {
+			query := newQuery()
+			applyFilter(query, &poi.SearchFilter{
+				Features:     []string{"DC_CHARGING", "AC_CHARGING"},
+				CountryCodes: []string{"DEU", "AUT"},
+				MinPowerKW:   50,
+			})
+			Expect(*query.FilterExpression).To(Equal(
+				"contains(#features, :feature0) AND contains(#features, :feature1) AND " +
+					"#country IN (:country0, :country1) AND " +
+					"(attribute_not_exists(#power) OR #power = :no_power OR #power >= :min_power)",
+			))
+			Expect(query.ExpressionAttributeNames).To(Equal(map[string]string{
+				"#features": "features",
+				"#country":  "country_code",
+				"#power":    "max_power_kw",
+			}))
+			Expect(query.ExpressionAttributeValues).To(HaveKeyWithValue(
+				":min_power", &types.AttributeValueMemberN{Value: "50"},
+			))
+			Expect(query.ExpressionAttributeValues).To(HaveKeyWithValue(
+				":country1", &types.AttributeValueMemberS{Value: "AUT"},
+			))
+			Expect(query.ExpressionAttributeValues).To(HaveKey(":pk"))
+		})
+	})
+
+	When("filter has connector conditions only", func() {
+		It("does not set a filter expression", func() {
+			query := newQuery()
+			applyFilter(query, &poi.SearchFilter{
+				Currents:  []poi.Current{poi.CurrentDC},
+				PlugTypes: []string{"combo"},
+			})
+			Expect(query.FilterExpression).To(BeNil())
+		})
+	})
+
+	When("projection is applied to filtered query", func() {
+		It("keeps the names of the filter", func() {
+			query := newQuery()
+			applyFilter(query, &poi.SearchFilter{Features: []string{"DC_CHARGING"}})
+			applyProjection(query, clusterAttributes)
+			Expect(*query.ProjectionExpression).To(Equal("#p_id, #p_lat, #p_lon, #p_features"))
+			Expect(query.ExpressionAttributeNames).To(HaveKeyWithValue("#features", "features"))
+			Expect(query.ExpressionAttributeNames).To(HaveKeyWithValue("#p_features", "features"))
+			Expect(query.ExpressionAttributeNames).To(HaveLen(5))
+		})
+	})
+})
@@ -0,0 +1,160 @@
+package dynamo
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+
+	"github.com/golang/geo/s2"
+
+	"github.com/grntlrduck-cloud/go-grpc-geohasing-service-sample/internal/adapters/geo"
+)
+
+const (
+	geohashCharBits = 5
+	geohashMaxChars = 12
+	geohashBits     = geohashCharBits * geohashMaxChars
+	geohashAlphabet = "0123456789bcdefghjkmnpqrstuvwxyz"
+	// the characters of the partitions of the geo indexes, about the size of the cells of the s2 index
+	geohashFineChars    = 5 // 4.9 km x 4.9 km
+	geohashDefaultChars = 4 // 39 km x 19.5 km
+	geohashCoarseChars  = 3 // 156 km x 156 km
+)
+
+// The geohashIndex keys points by their base32 geohash of 12 characters as integer, i.e. the interleaved bits of
+// the longitude and the latitude, which orders the keys like the geohash strings. The geo indexes are partitioned
+// by the geohash prefixes of their number of characters.
+type geohashIndex struct {
+	chars [numGeoIndexes]int
+}
+
+func NewGeohashIndex() SpatialIndex {
+	return &geohashIndex{chars: [numGeoIndexes]int{
+		FineGeoIndex:    geohashFineChars,
+		DefaultGeoIndex: geohashDefaultChars,
+		CoarseGeoIndex:  geohashCoarseChars,
+	}}
+}
+
+func (x *geohashIndex) Key(lat, lon float64) (SpatialKey, error) {
+	if !geo.ValidLatLon(lat, lon) {
+		return SpatialKey{}, fmt.Errorf("invalid coordinates: lat=%f, lon=%f", lat, lon)
+	}
+	h := interleave(quantize(lon, -180, 180, geohashBits-geohashBits/2), quantize(lat, -90, 90, geohashBits/2), geohashBits)
+	key := SpatialKey{Sort: h}
+	for i, chars := range x.chars {
+		key.Partitions[i] = h >> (geohashBits - geohashCharBits*chars)
+	}
+	return key, nil
+}
+
+// Ranges covers the bounding rectangle of each cell with the geohashes of about the size of the cell and picks the
+// finest geo index whose partitions contain the coarsest of those geohashes. Geohashes larger than the partitions
+// are split into the geohashes of the partitions, adjacent geohashes within a partition are merged into one range.
+func (x *geohashIndex) Ranges(cells []s2.CellID) (GeoIndex, []KeyRange) {
+	chars := make([]int, len(cells))
+	coarsest := geohashMaxChars
+	for i, c := range cells {
+		chars[i] = geohashCharsOfLevel(c.Level())
+		coarsest = min(coarsest, chars[i])
+	}
+	index := CoarseGeoIndex
+	for i, c := range x.chars {
+		if c <= coarsest {
+			index = GeoIndex(i)
+			break
+		}
+	}
+	partition := x.chars[index]
+	ranges := make([]KeyRange, 0, len(cells))
+	for i, c := range cells {
+		n := max(chars[i], partition)
+		shift := geohashBits - geohashCharBits*n
+		for _, h := range geohashesInRect(s2.CellFromCellID(c).RectBound(), n) {
+			ranges = append(ranges, KeyRange{
+				Partition: h >> (geohashCharBits * (n - partition)),
+				Min:       h << shift,
+				Max:       (h+1)<<shift - 1,
+			})
+		}
+	}
+	return index, mergeRanges(ranges)
+}
+
+// geohashCharsOfLevel returns the number of characters of the geohashes with about the edge length of the cells
+// of the level, each character halves the edge length 2.5 times while each level halves it once
+func geohashCharsOfLevel(level int) int {
+	return min(max(2*(level+2)/geohashCharBits, 1), geohashMaxChars)
+}
+
+// geohashesInRect returns the geohashes with the number of characters intersecting the rectangle
+func geohashesInRect(rect s2.Rect, chars int) []uint64 {
+	bits := geohashCharBits * chars
+	lonBits, latBits := bits-bits/2, bits/2
+	lo, hi := rect.Lo(), rect.Hi()
+	latLo, latHi := quantize(lo.Lat.Degrees(), -90, 90, latBits), quantize(hi.Lat.Degrees(), -90, 90, latBits)
+	lonIntervals := [][2]float64{{lo.Lng.Degrees(), hi.Lng.Degrees()}}
+	if rect.Lng.IsInverted() {
+		// the rectangle crosses the antimeridian
+		lonIntervals = [][2]float64{{lo.Lng.Degrees(), 180}, {-180, hi.Lng.Degrees()}}
+	}
+	var geohashes []uint64
+	for _, lng := range lonIntervals {
+		lonLo, lonHi := quantize(lng[0], -180, 180, lonBits), quantize(lng[1], -180, 180, lonBits)
+		for i := lonLo; i <= lonHi; i++ {
+			for j := latLo; j <= latHi; j++ {
+				geohashes = append(geohashes, interleave(i, j, bits))
+			}
+		}
+	}
+	return geohashes
+}
+
+// quantize returns the index of the interval containing the value, when dividing the range into 2^bits intervals
+func quantize(v, lo, hi float64, bits int) uint64 {
+	n := uint64(1) << bits
+	v = min(max(v, lo), hi)
+	return min(uint64((v-lo)/(hi-lo)*float64(n)), n-1)
+}
+
+// interleave returns the geohash of the bits of the longitude and latitude intervals, starting with the longitude
+func interleave(lon, lat uint64, bits int) uint64 {
+	var h uint64
+	lonBit, latBit := bits-bits/2, bits/2
+	for i := range bits {
+		h <<= 1
+		if i%2 == 0 {
+			lonBit--
+			h |= lon >> lonBit & 1
+		} else {
+			latBit--
+			h |= lat >> latBit & 1
+		}
+	}
+	return h
+}
+
+// geohashString returns the base32 geohash string of the first characters of the key
+func geohashString(key uint64, chars int) string {
+	s := make([]byte, chars)
+	for i := range chars {
+		s[i] = geohashAlphabet[key>>(geohashBits-geohashCharBits*(i+1))&(1<<geohashCharBits-1)]
+	}
+	return string(s)
+}
+
+// mergeRanges sorts the ranges and merges overlapping and adjacent ranges within the same partition
+func mergeRanges(ranges []KeyRange) []KeyRange {
+	slices.SortFunc(ranges, func(a, b KeyRange) int {
+		return cmp.Compare(a.Min, b.Min)
+	})
+	merged := ranges[:0]
+	for _, r := range ranges {
+		if n := len(merged); n > 0 && merged[n-1].Partition == r.Partition && r.Min <= merged[n-1].Max+1 {
+			merged[n-1].Max = max(merged[n-1].Max, r.Max)
+			continue
+		}
+		merged = append(merged, r)
+	}
+	return merged
+}
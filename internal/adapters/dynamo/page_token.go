@@ -0,0 +1,70 @@
+package dynamo
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/golang/geo/s2"
+
+	"github.com/grntlrduck-cloud/go-grpc-geohasing-service-sample/internal/adapters/geo"
+	"github.com/grntlrduck-cloud/go-grpc-geohasing-service-sample/internal/domain/poi"
+)
+
+// The pageToken is the position at which a paged geo query continues.
+// Cell is the index of the covering cell to query next and LastKey is the
+// LastEvaluatedKey of the previous query within that cell, if any.
+// Covering is a fingerprint of the covering and the search options the token has been issued for,
+// so that a token can not be used to resume a different search.
+type pageToken struct {
+	Covering uint64                  `json:"h"`
+	Cell     int                     `json:"c"`
+	LastKey  map[string]pageTokenKey `json:"k,omitempty"`
+}
+
+// pageTokenKey is the json representation of a key attribute, keys of the table and index are of type S or N only
+type pageTokenKey struct {
+	S *string `json:"s,omitempty"`
+	N *string `json:"n,omitempty"`
+}
+
+func newPageToken(covering uint64, cell int, lastKey map[string]types.AttributeValue) (*pageToken, error) {
+	t := &pageToken{Covering: covering, Cell: cell}
+	if len(lastKey) == 0 {
+		return t, nil
+	}
+	t.LastKey = make(map[string]pageTokenKey, len(lastKey))
+	for k, v := range lastKey {
+		switch av := v.(type) {
+		case *types.AttributeValueMemberS:
+			t.LastKey[k] = pageTokenKey{S: &av.Value}
+		case *types.AttributeValueMemberN:
+			t.LastKey[k] = pageTokenKey{N: &av.Value}
+		default:
+			return nil, fmt.Errorf("unsupported key attribute type %T for %s", v, k)
+		}
+	}
+	return t, nil
+}
+
+func (t *pageToken) exclusiveStartKey() (map[string]types.AttributeValue, error) {
+	if len(t.LastKey) == 0 {
+		return nil, nil
+	}
+	key := make(map[string]types.AttributeValue, len(t.LastKey))
+	for k, v := range t.LastKey {
+		switch {
+		case v.S != nil:
+			key[k] = &types.AttributeValueMemberS{Value: *v.S}
+		case v.N != nil:
+			key[k] = &types.AttributeValueMemberN{Value: *v.N}
+		default:
+			return nil, fmt.Errorf("key attribute %s has no value", k)
+		}
+	}
+	return key, nil
+}
+
+// coveringFingerprint identifies the cells queried for a search and the options applied to the locations found
+func coveringFingerprint(cells []s2.CellID, options *poi.SearchOptions) uint64 {
+	return geo.Fingerprint(cells, options)
+}
@@ -0,0 +1,99 @@
+package dynamo
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/zap"
+
+	"github.com/grntlrduck-cloud/go-grpc-geohasing-service-sample/internal/adapters/geo"
+	"github.com/grntlrduck-cloud/go-grpc-geohasing-service-sample/internal/adapters/pagetoken"
+	"github.com/grntlrduck-cloud/go-grpc-geohasing-service-sample/internal/domain/poi"
+)
+
+var _ = Describe("given a page token", func() {
+	lastKey := map[string]types.AttributeValue{
+		CPoIItemPK:         &types.AttributeValueMemberS{Value: "2ofD9hciu5kGIGdGXjPuJy3tUvH"},
+		CPoIItemGeoIndexPK: &types.AttributeValueMemberN{Value: "5158820596594769920"},
+		CPoIItemGeoIndexSK: &types.AttributeValueMemberN{Value: "5158837096744667913"},
+	}
+
+	When("encoded and decoded with the same secret", func() {
+		It("restores the query position and last evaluated key", func() {
+			codec, err := pagetoken.NewCodec("secret")
+			Expect(err).To(Not(HaveOccurred()))
+			t, err := newPageToken(42, 3, lastKey)
+			Expect(err).To(Not(HaveOccurred()))
+			encoded, err := codec.Encode(t)
+			Expect(err).To(Not(HaveOccurred()))
+
+			decoded := new(pageToken)
+			Expect(codec.Decode(encoded, decoded)).To(Succeed())
+			Expect(decoded.Covering).To(Equal(uint64(42)))
+			Expect(decoded.Cell).To(Equal(3))
+			key, err := decoded.exclusiveStartKey()
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(key).To(Equal(lastKey))
+		})
+	})
+
+	When("the token has no last evaluated key", func() {
+		It("has no exclusive start key", func() {
+			t, err := newPageToken(42, 3, nil)
+			Expect(err).To(Not(HaveOccurred()))
+			key, err := t.exclusiveStartKey()
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(key).To(BeNil())
+		})
+	})
+})
+
+var _ = Describe("given a covering", func() {
+	When("fingerprinted", func() {
+		It("differs for different search areas", func() {
+			prox, err := geo.CoverProximity(
+				poi.Coordinates{Longitude: 9.147263, Latitude: 49.333418},
+				30_000.0,
+				zap.NewNop(),
+			)
+			Expect(err).To(Not(HaveOccurred()))
+			bbox, err := geo.CoverBbox(
+				poi.Coordinates{Longitude: 8.494772, Latitude: 49.425026},
+				poi.Coordinates{Longitude: 10.040508, Latitude: 50.089540},
+				zap.NewNop(),
+			)
+			Expect(err).To(Not(HaveOccurred()))
+			options := poi.NewSearchOptions()
+			Expect(coveringFingerprint(prox.Cells, options)).To(Equal(coveringFingerprint(prox.Cells, options)))
+			Expect(coveringFingerprint(prox.Cells, options)).To(Not(Equal(coveringFingerprint(bbox.Cells, options))))
+		})
+
+		It("differs for different search options", func() {
+			prox, err := geo.CoverProximity(
+				poi.Coordinates{Longitude: 9.147263, Latitude: 49.333418},
+				30_000.0,
+				zap.NewNop(),
+			)
+			Expect(err).To(Not(HaveOccurred()))
+			fingerprint := coveringFingerprint(prox.Cells, poi.NewSearchOptions(poi.WithPage(10, "")))
+			Expect(coveringFingerprint(prox.Cells, poi.NewSearchOptions(poi.WithPage(20, "token")))).To(Equal(fingerprint))
+			for _, opt := range []poi.SearchOption{
+				poi.WithStrict(true),
+				poi.WithSortBy(poi.SortByRoutePosition),
+				poi.WithCorridor(500),
+				poi.WithFilter(&poi.SearchFilter{Features: []string{"AC_CHARGING"}}),
+				poi.WithFilter(&poi.SearchFilter{MinPowerKW: 50}),
+				poi.WithFilter(&poi.SearchFilter{Currents: []poi.Current{poi.CurrentDC}}),
+				poi.WithFilter(&poi.SearchFilter{PlugTypes: []string{"combo"}}),
+				poi.WithFilter(&poi.SearchFilter{CountryCodes: []string{"DEU"}}),
+			} {
+				Expect(coveringFingerprint(prox.Cells, poi.NewSearchOptions(opt))).To(Not(Equal(fingerprint)))
+			}
+			Expect(coveringFingerprint(prox.Cells, poi.NewSearchOptions(
+				poi.WithFilter(&poi.SearchFilter{Features: []string{"A", "B"}}),
+			))).To(Not(Equal(coveringFingerprint(prox.Cells, poi.NewSearchOptions(
+				poi.WithFilter(&poi.SearchFilter{Features: []string{"AB"}}),
+			)))))
+		})
+	})
+})
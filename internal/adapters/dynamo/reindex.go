@@ -0,0 +1,491 @@
+package dynamo
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/golang/geo/s2"
+	"go.uber.org/zap"
+
+	"github.com/grntlrduck-cloud/go-grpc-geohasing-service-sample/internal/adapters/geo"
+)
+
+const (
+	reindexDefaultRate       = 50  // updates per second
+	reindexDefaultPageSize   = 100 // items per scan request
+	reindexDefaultCheckpoint = "reindex_checkpoint.json"
+)
+
+// ErrReindexVerification is returned if the number of items of a cell in the geo index differs from the table
+var ErrReindexVerification = errors.New("geo index does not match the items of the table")
+
+// ReindexStats counts the items of a reindex. Skipped items are either up to date or have been moved
+// since they have been scanned, the latter are indexed by the write that moved them.
+type ReindexStats struct {
+	Scanned int `json:"scanned"`
+	Updated int `json:"updated"`
+	Skipped int `json:"skipped"`
+}
+
+// The reindexCheckpoint is saved after each scan page so that an interrupted reindex continues after the last
+// completed page. Cells counts the scanned items per cell of the geo index for the verification.
+type reindexCheckpoint struct {
+	SpatialIndex string                  `json:"spatial_index,omitempty"`
+	Level        int                     `json:"level"`
+	LastKey      map[string]pageTokenKey `json:"last_key,omitempty"`
+	Done         bool                    `json:"done"`
+	Stats        ReindexStats            `json:"stats"`
+	Cells        map[uint64]int          `json:"cells"`
+}
+
+// The Reindexer recomputes the geo index attributes of all items of the table, so that the spatial index or the level
+// of the geo index can be changed and the coarse and fine geo indexes can be backfilled without reloading the table.
+// Writes of the service should be paused while reindexing, since they index items with the level of the service.
+type Reindexer struct {
+	dynamoClient   DBClient
+	tableName      string
+	indexName      string
+	index          SpatialIndex
+	level          int
+	rate           int
+	pageSize       int32
+	checkpointPath string
+	density        bool
+}
+
+type ReindexerOptions func(r *Reindexer)
+
+func WithReindexClient(client DBClient) ReindexerOptions {
+	return func(r *Reindexer) {
+		r.dynamoClient = client
+	}
+}
+
+func WithReindexTableName(tableName string) ReindexerOptions {
+	return func(r *Reindexer) {
+		r.tableName = tableName
+	}
+}
+
+// WithReindexSpatialIndex sets the name of the spatial index the items are indexed with, defaults to SpatialIndexS2
+func WithReindexSpatialIndex(name string) ReindexerOptions {
+	return func(r *Reindexer) {
+		r.indexName = name
+	}
+}
+
+// WithReindexLevel sets the level of the cells partitioning the default geo index of the s2 spatial index,
+// defaults to CPoIItemCellLevel. The service reads the items with the level of its DynamoDB config.
+func WithReindexLevel(level int) ReindexerOptions {
+	return func(r *Reindexer) {
+		r.level = level
+	}
+}
+
+// WithReindexRate limits the number of updates per second
+func WithReindexRate(rate int) ReindexerOptions {
+	return func(r *Reindexer) {
+		r.rate = rate
+	}
+}
+
+func WithReindexPageSize(pageSize int32) ReindexerOptions {
+	return func(r *Reindexer) {
+		r.pageSize = pageSize
+	}
+}
+
+// WithReindexCheckpoint sets the path of the checkpoint file, the reindex continues at the checkpoint if it exists
+func WithReindexCheckpoint(path string) ReindexerOptions {
+	return func(r *Reindexer) {
+		r.checkpointPath = path
+	}
+}
+
+// WithReindexDensity rebuilds the density aggregates from all locations once the reindex is completed, e.g. for
+// tables written before the aggregates have been introduced or while they were disabled
+func WithReindexDensity(rebuild bool) ReindexerOptions {
+	return func(r *Reindexer) {
+		r.density = rebuild
+	}
+}
+
+func NewReindexer(opts ...ReindexerOptions) (*Reindexer, error) {
+	r := &Reindexer{
+		tableName:      "NOT_DEFINED",
+		indexName:      SpatialIndexS2,
+		level:          CPoIItemCellLevel,
+		rate:           reindexDefaultRate,
+		pageSize:       reindexDefaultPageSize,
+		checkpointPath: reindexDefaultCheckpoint,
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+	if r.indexName == "" {
+		r.indexName = SpatialIndexS2
+	}
+	index, err := NewSpatialIndex(r.indexName, r.level)
+	if err != nil {
+		return nil, err
+	}
+	r.index = index
+	if r.rate <= 0 || r.pageSize <= 0 {
+		return nil, fmt.Errorf("invalid rate %d or page size %d, must be positive", r.rate, r.pageSize)
+	}
+	if r.dynamoClient == nil {
+		cl, err := NewClientWrapper()
+		if err != nil {
+			return nil, fmt.Errorf("dyanmo client was nil but failed to initialize: %w", err)
+		}
+		r.dynamoClient = cl
+	}
+	return r, nil
+}
+
+// Run reindexes all items page by page, starting after the checkpoint if it exists, and verifies the number of
+// items per cell of the geo index once all items are reindexed. Since the geo index is updated asynchronously,
+// the verification might fail right after the reindex, running again with the completed checkpoint only verifies.
+// The density aggregates are rebuilt after a successful verification if enabled.
+func (r *Reindexer) Run(ctx context.Context, logger *zap.Logger) (*ReindexStats, error) {
+	cp, err := r.loadCheckpoint()
+	if err != nil {
+		return nil, err
+	}
+	if cp.Done {
+		logger.Info("reindex already completed, verifying geo index")
+	} else if err := r.reindex(ctx, cp, logger); err != nil {
+		return &cp.Stats, err
+	}
+	if err := r.verify(ctx, cp.Cells, logger); err != nil {
+		return &cp.Stats, err
+	}
+	if r.density {
+		return &cp.Stats, r.rebuildDensity(ctx, logger)
+	}
+	return &cp.Stats, nil
+}
+
+func (r *Reindexer) reindex(ctx context.Context, cp *reindexCheckpoint, logger *zap.Logger) error {
+	throttle := time.NewTicker(time.Second / time.Duration(r.rate))
+	defer throttle.Stop()
+	for {
+		startKey, err := (&pageToken{LastKey: cp.LastKey}).exclusiveStartKey()
+		if err != nil {
+			return fmt.Errorf("invalid checkpoint: %w", err)
+		}
+		out, err := r.dynamoClient.ScanItem(ctx, &dynamodb.ScanInput{
+			TableName:         aws.String(r.tableName),
+			Limit:             aws.Int32(r.pageSize),
+			ExclusiveStartKey: startKey,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to scan table: %w", err)
+		}
+		for _, av := range out.Items {
+			if err := r.reindexItem(ctx, av, cp, throttle, logger); err != nil {
+				return err
+			}
+		}
+		next, err := newPageToken(0, 0, out.LastEvaluatedKey)
+		if err != nil {
+			return fmt.Errorf("failed to create checkpoint: %w", err)
+		}
+		cp.LastKey = next.LastKey
+		cp.Done = len(out.LastEvaluatedKey) == 0
+		if err := r.saveCheckpoint(cp); err != nil {
+			return err
+		}
+		logger.Info("reindexed page",
+			zap.Int("num_scanned", cp.Stats.Scanned),
+			zap.Int("num_updated", cp.Stats.Updated),
+			zap.Int("num_skipped", cp.Stats.Skipped),
+		)
+		if cp.Done {
+			return nil
+		}
+	}
+}
+
+func (r *Reindexer) reindexItem(
+	ctx context.Context,
+	av map[string]types.AttributeValue,
+	cp *reindexCheckpoint,
+	throttle *time.Ticker,
+	logger *zap.Logger,
+) error {
+	if isDensityItem(av) {
+		return nil
+	}
+	item := new(CPoIItem)
+	if err := attributevalue.UnmarshalMap(av, item); err != nil {
+		return fmt.Errorf("failed to unmarshal item: %w", err)
+	}
+	cp.Stats.Scanned++
+	key, err := r.index.Key(item.Latitude, item.Longitude)
+	if err != nil {
+		return fmt.Errorf("failed to create geo hash of item %s: %w", item.Pk, err)
+	}
+	reindexed := *item
+	key.apply(&reindexed)
+	cp.Cells[reindexed.GeoIndexPk]++
+	if reindexed.GeoIndexPk == item.GeoIndexPk && reindexed.GeoIndexSk == item.GeoIndexSk &&
+		reindexed.CoarseGeoIndexPk == item.CoarseGeoIndexPk && reindexed.FineGeoIndexPk == item.FineGeoIndexPk {
+		cp.Stats.Skipped++
+		return nil
+	}
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-throttle.C:
+	}
+	err = r.updateItem(ctx, &reindexed)
+	var conditionErr *types.ConditionalCheckFailedException
+	if errors.As(err, &conditionErr) {
+		logger.Warn("item has been moved or deleted while reindexing",
+			zap.String("location_id", item.Pk),
+		)
+		cp.Cells[reindexed.GeoIndexPk]--
+		cp.Stats.Skipped++
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to update item %s: %w", item.Pk, err)
+	}
+	cp.Stats.Updated++
+	return nil
+}
+
+// updateItem sets the geo index attributes if the location of the item has not changed since it has been scanned,
+// throttled requests are retried with exponential backoff
+func (r *Reindexer) updateItem(ctx context.Context, item *CPoIItem) error {
+	lat, err := attributevalue.Marshal(item.Latitude)
+	if err != nil {
+		return fmt.Errorf("failed to marshal latitude: %w", err)
+	}
+	lon, err := attributevalue.Marshal(item.Longitude)
+	if err != nil {
+		return fmt.Errorf("failed to marshal longitude: %w", err)
+	}
+	input := &dynamodb.UpdateItemInput{
+		TableName: aws.String(r.tableName),
+		Key: map[string]types.AttributeValue{
+			CPoIItemPK: &types.AttributeValueMemberS{Value: item.Pk},
+		},
+		UpdateExpression:    aws.String("SET #gsi1pk = :gsi1pk, #gsi1sk = :gsi1sk, #gsi2pk = :gsi2pk, #gsi3pk = :gsi3pk"),
+		ConditionExpression: aws.String("#lat = :lat AND #lon = :lon"),
+		ExpressionAttributeNames: map[string]string{
+			"#gsi1pk": CPoIItemGeoIndexPK,
+			"#gsi1sk": CPoIItemGeoIndexSK,
+			"#gsi2pk": CPoIItemCoarseGeoIndexPK,
+			"#gsi3pk": CPoIItemFineGeoIndexPK,
+			"#lat":    "lat",
+			"#lon":    "lon",
+		},
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":gsi1pk": numberValue(item.GeoIndexPk),
+			":gsi1sk": numberValue(item.GeoIndexSk),
+			":gsi2pk": numberValue(item.CoarseGeoIndexPk),
+			":gsi3pk": numberValue(item.FineGeoIndexPk),
+			":lat":    lat,
+			":lon":    lon,
+		},
+	}
+	return updateItemWithRetry(ctx, r.dynamoClient, input)
+}
+
+// updateItemWithRetry updates the item and retries throttled requests with exponential backoff
+func updateItemWithRetry(ctx context.Context, client DBClient, input *dynamodb.UpdateItemInput) error {
+	for attempt := 0; ; attempt++ {
+		if attempt > 0 {
+			if err := sleepWithContext(ctx, backoff(attempt)); err != nil {
+				return err
+			}
+		}
+		_, err := client.UpdateItem(ctx, input)
+		if err == nil || !retryable(err) || attempt+1 == batchWriteMaxAttempts {
+			return err
+		}
+	}
+}
+
+// verify compares the number of items per cell counted while scanning with the number of items in the geo index
+func (r *Reindexer) verify(ctx context.Context, cells map[uint64]int, logger *zap.Logger) error {
+	mismatches := 0
+	for cell, expected := range cells {
+		actual, err := r.countCell(ctx, cell)
+		if err != nil {
+			return err
+		}
+		if actual != expected {
+			logger.Warn("number of items in geo index does not match",
+				zap.Uint64("cell", cell),
+				zap.Int("expected", expected),
+				zap.Int("actual", actual),
+			)
+			mismatches++
+		}
+	}
+	if mismatches > 0 {
+		return fmt.Errorf("%w: %d of %d cells differ", ErrReindexVerification, mismatches, len(cells))
+	}
+	logger.Info("verified geo index", zap.Int("num_cells", len(cells)))
+	return nil
+}
+
+func (r *Reindexer) countCell(ctx context.Context, cell uint64) (int, error) {
+	input := &dynamodb.QueryInput{
+		TableName:              aws.String(r.tableName),
+		IndexName:              aws.String(CPoIItemGeoIndexName),
+		KeyConditionExpression: aws.String(fmt.Sprintf("%s = :pk", CPoIItemGeoIndexPK)),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":pk": numberValue(cell),
+		},
+		Select: types.SelectCount,
+	}
+	count := 0
+	for {
+		out, err := r.dynamoClient.QueryItem(ctx, input)
+		if err != nil {
+			return 0, fmt.Errorf("failed to count items of cell %d: %w", cell, err)
+		}
+		count += int(out.Count)
+		if len(out.LastEvaluatedKey) == 0 {
+			return count, nil
+		}
+		input.ExclusiveStartKey = out.LastEvaluatedKey
+	}
+}
+
+// rebuildDensity scans all locations and replaces the density aggregates with the aggregates of the locations,
+// the items of partitions without locations are deleted. Writes during the rebuild might not be aggregated.
+func (r *Reindexer) rebuildDensity(ctx context.Context, logger *zap.Logger) error {
+	aggregates := make(geo.DensityAggregates)
+	stale := make(map[string]bool)
+	input := &dynamodb.ScanInput{
+		TableName: aws.String(r.tableName),
+		Limit:     aws.Int32(r.pageSize),
+	}
+	for {
+		out, err := r.dynamoClient.ScanItem(ctx, input)
+		if err != nil {
+			return fmt.Errorf("failed to scan table: %w", err)
+		}
+		for _, av := range out.Items {
+			if isDensityItem(av) {
+				stale[av[CPoIItemPK].(*types.AttributeValueMemberS).Value] = true
+				continue
+			}
+			item := new(CPoIItem)
+			if err := attributevalue.UnmarshalMap(av, item); err != nil {
+				return fmt.Errorf("failed to unmarshal item: %w", err)
+			}
+			l, err := item.Domain()
+			if err != nil {
+				return fmt.Errorf("failed to map item %s: %w", item.Pk, err)
+			}
+			aggregates.Replace(nil, l)
+		}
+		if len(out.LastEvaluatedKey) == 0 {
+			break
+		}
+		input.ExclusiveStartKey = out.LastEvaluatedKey
+	}
+
+	partitions := make([]s2.CellID, 0)
+	for c := range aggregates {
+		partitions = append(partitions, c.Parent(densityPartitionLevel))
+	}
+	slices.Sort(partitions)
+	partitions = slices.Compact(partitions)
+	throttle := time.NewTicker(time.Second / time.Duration(r.rate))
+	defer throttle.Stop()
+	for _, p := range partitions {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-throttle.C:
+		}
+		item := densityItem(p, aggregates)
+		delete(stale, item[CPoIItemPK].(*types.AttributeValueMemberS).Value)
+		_, err := r.dynamoClient.PutItem(ctx, &dynamodb.PutItemInput{TableName: aws.String(r.tableName), Item: item})
+		if err != nil {
+			return fmt.Errorf("failed to put density aggregates of partition %s: %w", p.ToToken(), err)
+		}
+	}
+	for pk := range stale {
+		_, err := r.dynamoClient.DeleteItem(ctx, &dynamodb.DeleteItemInput{
+			TableName: aws.String(r.tableName),
+			Key:       map[string]types.AttributeValue{CPoIItemPK: &types.AttributeValueMemberS{Value: pk}},
+		})
+		if err != nil {
+			return fmt.Errorf("failed to delete density aggregates %s: %w", pk, err)
+		}
+	}
+	logger.Info("rebuilt density aggregates",
+		zap.Int("num_partitions", len(partitions)),
+		zap.Int("num_cells", len(aggregates)),
+		zap.Int("num_deleted", len(stale)),
+	)
+	return nil
+}
+
+func (r *Reindexer) loadCheckpoint() (*reindexCheckpoint, error) {
+	data, err := os.ReadFile(r.checkpointPath)
+	if errors.Is(err, os.ErrNotExist) {
+		return &reindexCheckpoint{SpatialIndex: r.indexName, Level: r.level, Cells: make(map[uint64]int)}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read checkpoint: %w", err)
+	}
+	cp := new(reindexCheckpoint)
+	if err := json.Unmarshal(data, cp); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal checkpoint: %w", err)
+	}
+	if cp.SpatialIndex == "" {
+		// checkpoints without spatial index have been written by the s2 reindex
+		cp.SpatialIndex = SpatialIndexS2
+	}
+	if cp.SpatialIndex != r.indexName {
+		return nil, fmt.Errorf("checkpoint %s is for spatial index %s, remove it to reindex with spatial index %s",
+			r.checkpointPath, cp.SpatialIndex, r.indexName)
+	}
+	if cp.Level != r.level {
+		return nil, fmt.Errorf("checkpoint %s is for level %d, remove it to reindex with level %d",
+			r.checkpointPath, cp.Level, r.level)
+	}
+	if cp.Cells == nil {
+		cp.Cells = make(map[uint64]int)
+	}
+	return cp, nil
+}
+
+// saveCheckpoint replaces the checkpoint file, so that the checkpoint is not corrupted if the reindex is interrupted
+func (r *Reindexer) saveCheckpoint(cp *reindexCheckpoint) error {
+	data, err := json.Marshal(cp)
+	if err != nil {
+		return fmt.Errorf("failed to marshal checkpoint: %w", err)
+	}
+	tmp := r.checkpointPath + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o600); err != nil {
+		return fmt.Errorf("failed to write checkpoint: %w", err)
+	}
+	if err := os.Rename(tmp, r.checkpointPath); err != nil {
+		return fmt.Errorf("failed to replace checkpoint: %w", err)
+	}
+	return nil
+}
+
+func numberValue(n uint64) *types.AttributeValueMemberN {
+	return &types.AttributeValueMemberN{Value: strconv.FormatUint(n, 10)}
+}
@@ -0,0 +1,283 @@
+package dynamo
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/golang/geo/s2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/segmentio/ksuid"
+	"go.uber.org/zap"
+
+	"github.com/grntlrduck-cloud/go-grpc-geohasing-service-sample/internal/adapters/geo"
+	"github.com/grntlrduck-cloud/go-grpc-geohasing-service-sample/internal/domain/poi"
+)
+
+// reindexClient fakes Scan, PutItem, UpdateItem, DeleteItem, and count Query requests on a table ordered by the
+// primary key, all other requests of the DBClient are not implemented
+type reindexClient struct {
+	DBClient
+	items       map[string]map[string]types.AttributeValue
+	scans       int
+	failScan    int // the scan request to fail, zero if none fails
+	updates     map[string]int
+	countOffset int32
+}
+
+func (c *reindexClient) ScanItem(_ context.Context, input *dynamodb.ScanInput) (*dynamodb.ScanOutput, error) {
+	c.scans++
+	if c.scans == c.failScan {
+		return nil, errors.New("scan failed")
+	}
+	keys := make([]string, 0, len(c.items))
+	for k := range c.items {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	start := 0
+	if input.ExclusiveStartKey != nil {
+		last := input.ExclusiveStartKey[CPoIItemPK].(*types.AttributeValueMemberS).Value
+		start, _ = slices.BinarySearch(keys, last)
+		start++
+	}
+	end := min(start+int(*input.Limit), len(keys))
+	out := &dynamodb.ScanOutput{}
+	for _, k := range keys[start:end] {
+		out.Items = append(out.Items, c.items[k])
+	}
+	if end < len(keys) {
+		out.LastEvaluatedKey = map[string]types.AttributeValue{
+			CPoIItemPK: &types.AttributeValueMemberS{Value: keys[end-1]},
+		}
+	}
+	return out, nil
+}
+
+func (c *reindexClient) UpdateItem(
+	_ context.Context,
+	input *dynamodb.UpdateItemInput,
+) (*dynamodb.UpdateItemOutput, error) {
+	pk := input.Key[CPoIItemPK].(*types.AttributeValueMemberS).Value
+	c.updates[pk]++
+	item := c.items[pk]
+	for name, attribute := range input.ExpressionAttributeNames {
+		if v, ok := input.ExpressionAttributeValues[":"+name[1:]]; ok && name != "#lat" && name != "#lon" {
+			item[attribute] = v
+		}
+	}
+	return &dynamodb.UpdateItemOutput{}, nil
+}
+
+func (c *reindexClient) PutItem(_ context.Context, input *dynamodb.PutItemInput) (*dynamodb.PutItemOutput, error) {
+	c.items[input.Item[CPoIItemPK].(*types.AttributeValueMemberS).Value] = input.Item
+	return &dynamodb.PutItemOutput{}, nil
+}
+
+func (c *reindexClient) DeleteItem(
+	_ context.Context,
+	input *dynamodb.DeleteItemInput,
+) (*dynamodb.DeleteItemOutput, error) {
+	delete(c.items, input.Key[CPoIItemPK].(*types.AttributeValueMemberS).Value)
+	return &dynamodb.DeleteItemOutput{}, nil
+}
+
+func (c *reindexClient) QueryItem(_ context.Context, input *dynamodb.QueryInput) (*dynamodb.QueryOutput, error) {
+	cell := input.ExpressionAttributeValues[":pk"].(*types.AttributeValueMemberN).Value
+	count := c.countOffset
+	for _, item := range c.items {
+		if pk, ok := item[CPoIItemGeoIndexPK].(*types.AttributeValueMemberN); ok && pk.Value == cell {
+			count++
+		}
+	}
+	return &dynamodb.QueryOutput{Count: count}, nil
+}
+
+var _ = Describe("given a table with items indexed by an outdated level", func() {
+	ctx := context.Background()
+	logger := zap.NewNop()
+	coordinates := []poi.Coordinates{
+		{Latitude: 48.137154, Longitude: 11.576124},
+		{Latitude: 48.138, Longitude: 11.577},
+		{Latitude: 52.520008, Longitude: 13.404954},
+		{Latitude: 53.551086, Longitude: 9.993682},
+		{Latitude: 50.110924, Longitude: 8.682127},
+	}
+	var (
+		client     *reindexClient
+		checkpoint string
+	)
+
+	BeforeEach(func() {
+		client = &reindexClient{
+			items:   make(map[string]map[string]types.AttributeValue),
+			updates: make(map[string]int),
+		}
+		for _, c := range coordinates {
+			item, err := NewItemFromDomain(&poi.PoILocation{ID: ksuid.New(), Location: c})
+			Expect(err).To(Not(HaveOccurred()))
+			// items written before the coarse and fine geo indexes have been introduced
+			gh, err := newGeoHash(c.Latitude, c.Longitude)
+			Expect(err).To(Not(HaveOccurred()))
+			item.GeoIndexPk = gh.trimmed(8)
+			item.CoarseGeoIndexPk = 0
+			item.FineGeoIndexPk = 0
+			av, err := attributevalue.MarshalMap(item)
+			Expect(err).To(Not(HaveOccurred()))
+			client.items[item.Pk] = av
+		}
+		checkpoint = filepath.Join(GinkgoT().TempDir(), "checkpoint.json")
+	})
+
+	newReindexer := func(level int) *Reindexer {
+		r, err := NewReindexer(
+			WithReindexClient(client),
+			WithReindexTableName("table"),
+			WithReindexLevel(level),
+			WithReindexRate(1000),
+			WithReindexPageSize(2),
+			WithReindexCheckpoint(checkpoint),
+		)
+		Expect(err).To(Not(HaveOccurred()))
+		return r
+	}
+
+	When("table is reindexed", func() {
+		It("updates the geo index attributes of all items and verifies the counts", func() {
+			stats, err := newReindexer(CPoIItemCellLevel).Run(ctx, logger)
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(*stats).To(Equal(ReindexStats{Scanned: 5, Updated: 5}))
+			for _, av := range client.items {
+				item := new(CPoIItem)
+				Expect(attributevalue.UnmarshalMap(av, item)).To(Succeed())
+				expected, err := NewItemFromDomain(&poi.PoILocation{
+					Location: poi.Coordinates{Latitude: item.Latitude, Longitude: item.Longitude},
+				})
+				Expect(err).To(Not(HaveOccurred()))
+				Expect(item.GeoIndexPk).To(Equal(expected.GeoIndexPk))
+				Expect(item.CoarseGeoIndexPk).To(Equal(expected.CoarseGeoIndexPk))
+				Expect(item.FineGeoIndexPk).To(Equal(expected.FineGeoIndexPk))
+			}
+		})
+
+		It("only verifies the counts if the checkpoint is completed", func() {
+			_, err := newReindexer(CPoIItemCellLevel).Run(ctx, logger)
+			Expect(err).To(Not(HaveOccurred()))
+			scans := client.scans
+			stats, err := newReindexer(CPoIItemCellLevel).Run(ctx, logger)
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(stats.Updated).To(Equal(5))
+			Expect(client.scans).To(Equal(scans))
+		})
+
+		It("skips items which are up to date", func() {
+			_, err := newReindexer(CPoIItemCellLevel).Run(ctx, logger)
+			Expect(err).To(Not(HaveOccurred()))
+			checkpoint = filepath.Join(GinkgoT().TempDir(), "checkpoint.json")
+			stats, err := newReindexer(CPoIItemCellLevel).Run(ctx, logger)
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(*stats).To(Equal(ReindexStats{Scanned: 5, Skipped: 5}))
+		})
+	})
+
+	When("reindex is interrupted", func() {
+		It("continues after the last completed page", func() {
+			client.failScan = 2
+			_, err := newReindexer(CPoIItemCellLevel).Run(ctx, logger)
+			Expect(err).To(HaveOccurred())
+			stats, err := newReindexer(CPoIItemCellLevel).Run(ctx, logger)
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(stats.Scanned).To(Equal(5))
+			Expect(client.updates).To(HaveLen(5))
+			for _, n := range client.updates {
+				Expect(n).To(Equal(1))
+			}
+		})
+
+		It("returns error for checkpoint of other level", func() {
+			client.failScan = 2
+			_, err := newReindexer(CPoIItemCellLevel).Run(ctx, logger)
+			Expect(err).To(HaveOccurred())
+			_, err = newReindexer(CPoIItemCellLevel+1).Run(ctx, logger)
+			Expect(err).To(MatchError(ContainSubstring("checkpoint")))
+		})
+	})
+
+	When("table is reindexed with other spatial index", func() {
+		It("updates the geo index attributes with the keys of the spatial index", func() {
+			r, err := NewReindexer(
+				WithReindexClient(client),
+				WithReindexSpatialIndex(SpatialIndexGeohash),
+				WithReindexRate(1000),
+				WithReindexCheckpoint(checkpoint),
+			)
+			Expect(err).To(Not(HaveOccurred()))
+			stats, err := r.Run(ctx, logger)
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(stats.Updated).To(Equal(5))
+			for _, av := range client.items {
+				item := new(CPoIItem)
+				Expect(attributevalue.UnmarshalMap(av, item)).To(Succeed())
+				expected, err := newItemFromDomain(&poi.PoILocation{
+					Location: poi.Coordinates{Latitude: item.Latitude, Longitude: item.Longitude},
+				}, NewGeohashIndex())
+				Expect(err).To(Not(HaveOccurred()))
+				Expect(item.GeoIndexSk).To(Equal(expected.GeoIndexSk))
+				Expect(item.GeoIndexPk).To(Equal(expected.GeoIndexPk))
+			}
+		})
+
+		It("returns error for level", func() {
+			_, err := NewReindexer(
+				WithReindexClient(client),
+				WithReindexSpatialIndex(SpatialIndexGeohash),
+				WithReindexLevel(CPoIItemCellLevel+1),
+			)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	When("table is reindexed with density", func() {
+		It("replaces the density aggregates with the aggregates of all items", func() {
+			stale := densityKey(s2.CellIDFromToken("1").Parent(densityPartitionLevel))
+			client.items[stale[CPoIItemPK].(*types.AttributeValueMemberS).Value] = stale
+			r, err := NewReindexer(
+				WithReindexClient(client),
+				WithReindexRate(1000),
+				WithReindexPageSize(2),
+				WithReindexCheckpoint(checkpoint),
+				WithReindexDensity(true),
+			)
+			Expect(err).To(Not(HaveOccurred()))
+			stats, err := r.Run(ctx, logger)
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(stats.Scanned).To(Equal(5))
+
+			expected := make(geo.DensityAggregates)
+			for _, c := range coordinates {
+				expected.Replace(nil, &poi.PoILocation{Location: c})
+			}
+			actual := make(geo.DensityAggregates)
+			for _, av := range client.items {
+				if isDensityItem(av) {
+					Expect(addDensityItem(actual, av)).To(Succeed())
+				}
+			}
+			Expect(actual).To(Equal(expected))
+			Expect(client.items).To(Not(HaveKey(stale[CPoIItemPK].(*types.AttributeValueMemberS).Value)))
+		})
+	})
+
+	When("geo index does not match the table", func() {
+		It("returns verification error", func() {
+			client.countOffset = 1
+			_, err := newReindexer(CPoIItemCellLevel).Run(ctx, logger)
+			Expect(err).To(MatchError(ErrReindexVerification))
+		})
+	})
+})
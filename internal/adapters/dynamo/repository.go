@@ -2,8 +2,8 @@ package dynamo
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"os"
 	"strconv"
 	"time"
 
@@ -11,28 +11,35 @@ import (
 	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
 	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
 	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
-	"github.com/gocarina/gocsv"
+	"github.com/golang/geo/s2"
 	"github.com/segmentio/ksuid"
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
 
+	"github.com/grntlrduck-cloud/go-grpc-geohasing-service-sample/internal/adapters/csv"
+	"github.com/grntlrduck-cloud/go-grpc-geohasing-service-sample/internal/adapters/geo"
+	"github.com/grntlrduck-cloud/go-grpc-geohasing-service-sample/internal/adapters/pagetoken"
 	"github.com/grntlrduck-cloud/go-grpc-geohasing-service-sample/internal/domain/poi"
 )
 
 const (
-	routeHashesLimit     = 200
-	bboxHashesLimit      = 150
-	proxHashesLimit      = 150
-	dynamoMaxBatchSize   = 10
-	maxConcurrentQueries = 10 // Configurable max concurrent queries
-	testInitDataPath     = "config/db/local/cpoi_dynamo_items_int_test.csv"
+	dynamoMaxBatchGetKeys = 100 // the maximum number of keys of a BatchGetItem request
+	batchGetMaxRetries    = 5   // retries of unprocessed keys with exponential backoff
+	maxConcurrentQueries  = 10  // Configurable max concurrent queries
 )
 
+// the attributes of the items required to cluster the locations
+var clusterAttributes = []string{"id", "lat", "lon", "features"}
+
 type PoIGeoRepository struct {
 	dynamoClient    DBClient
 	tableName       string
 	createInitTable bool
 	initDataPath    string
+	pageTokenSecret string
+	pageTokens      *pagetoken.Codec
+	index           SpatialIndex
+	density         bool
 }
 
 type PoIGeoRepositoryOptions func(p *PoIGeoRepository)
@@ -61,13 +68,31 @@ func WithTestInitDataOverrid(testInitDataPath string) PoIGeoRepositoryOptions {
 	}
 }
 
+// WithPageTokenSecret sets the secret used to sign page tokens. Tokens are only valid across instances
+// of the service if all instances use the same secret, otherwise a random secret is generated
+func WithPageTokenSecret(secret string) PoIGeoRepositoryOptions {
+	return func(p *PoIGeoRepository) {
+		p.pageTokenSecret = secret
+	}
+}
+
+// WithSpatialIndex sets the spatial index the items of the table are written with, the s2 index by default.
+// Changing the spatial index of an existing table requires to reindex its items.
+func WithSpatialIndex(index SpatialIndex) PoIGeoRepositoryOptions {
+	return func(p *PoIGeoRepository) {
+		p.index = index
+	}
+}
+
 func NewPoIGeoRepository(
 	logger *zap.Logger,
 	opts ...PoIGeoRepositoryOptions,
 ) (poi.Repository, error) {
 	repo := &PoIGeoRepository{
 		tableName:    "NOT_DEFINED",
-		initDataPath: testInitDataPath,
+		initDataPath: csv.TestInitDataPath,
+		index:        defaultSpatialIndex,
+		density:      true,
 	}
 	for _, opt := range opts {
 		opt(repo)
@@ -79,6 +104,14 @@ func NewPoIGeoRepository(
 		}
 		repo.dynamoClient = cl
 	}
+	if repo.pageTokenSecret == "" {
+		logger.Warn("no page token secret configured, page tokens are only valid for this instance")
+	}
+	codec, err := pagetoken.NewCodec(repo.pageTokenSecret)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create page token codec: %w", err)
+	}
+	repo.pageTokens = codec
 	if repo.createInitTable {
 		err := repo.createTableAndLoadData(logger)
 		if err != nil {
@@ -91,84 +124,47 @@ func NewPoIGeoRepository(
 	return repo, nil
 }
 
-func (pgr *PoIGeoRepository) UpsertBatch(
+func (pgr *PoIGeoRepository) Upsert(
 	ctx context.Context,
-	pois []*poi.PoILocation,
+	domain *poi.PoILocation,
 	logger *zap.Logger,
 ) error {
-	// handle context cancelation
-	if ctx.Err() != nil {
-		return ctx.Err()
-	}
-	// verify validity
-	if len(pois) == 0 {
-		logger.Warn(
-			"skipping batch upsert because pois is empty slice",
-		)
-		return nil
-	}
+	return pgr.putItem(ctx, domain, nil, logger)
+}
 
-	// map domain model to dynamo items and marshall to AttributeValues
-	// and assemble list of WriteRequests
-	chunks, err := createBatchRequests(pois)
-	if err != nil {
-		logger.Error(
-			"failed to create batch requests",
-			zap.Error(err),
-		)
-		return poi.ErrDBEntityMapping
+func (pgr *PoIGeoRepository) Create(
+	ctx context.Context,
+	domain *poi.PoILocation,
+	logger *zap.Logger,
+) error {
+	created := *domain
+	created.Version = 1
+	if err := pgr.putItem(ctx, &created, notExistsCondition(), logger); err != nil {
+		return err
 	}
+	domain.Version = created.Version
+	return nil
+}
 
-	// upsert chunks
-	var errs []error
-	for i, c := range chunks {
-		if len(c) == 0 {
-			logger.Warn("skipping to upsert chunk since it is empty",
-				zap.Int("batch_num", i),
-				zap.Int("total_num_batches", len(chunks)),
-				zap.Int("num_items", len(c)),
-			)
-			continue
-		}
-		input := &dynamodb.BatchWriteItemInput{
-			RequestItems: map[string][]types.WriteRequest{pgr.tableName: c},
-		}
-		_, err := pgr.dynamoClient.BatchPutItem(ctx, input)
-		if err != nil {
-			time.Sleep(200 * time.Millisecond)
-			_, err := pgr.dynamoClient.BatchPutItem(ctx, input)
-			if err != nil {
-				logger.Error(
-					"failed to perform batch PutItem after retry",
-					zap.Int("batch_num", i),
-					zap.Int("total_num_batches", len(chunks)),
-					zap.Int("num_items", len(c)),
-					zap.Error(err),
-				)
-				errs = append(errs, err)
-			}
-		}
-		logger.Debug(
-			"successfully inserted batch",
-			zap.Int("batch_num", i),
-			zap.Int("total_num_batches", len(chunks)),
-			zap.Int("num_items", len(c)),
-		)
-		time.Sleep(50 * time.Microsecond)
-	}
-	if len(errs) > 0 {
-		logger.Error("batch upsert incomplete",
-			zap.Error(errs[0]),
-			zap.Int("num_failed_batches", len(errs)),
-		)
-		return poi.ErrDBBatchUpsert
+func (pgr *PoIGeoRepository) Update(
+	ctx context.Context,
+	domain *poi.PoILocation,
+	logger *zap.Logger,
+) error {
+	updated := *domain
+	updated.Version = domain.Version + 1
+	if err := pgr.putItem(ctx, &updated, versionCondition(domain.Version), logger); err != nil {
+		return err
 	}
+	domain.Version = updated.Version
 	return nil
 }
 
-func (pgr *PoIGeoRepository) Upsert(
+// putItem writes the location if the stored item satisfies the optional condition
+func (pgr *PoIGeoRepository) putItem(
 	ctx context.Context,
 	domain *poi.PoILocation,
+	condition *writeCondition,
 	logger *zap.Logger,
 ) error {
 	// handle context cancelation
@@ -176,7 +172,7 @@ func (pgr *PoIGeoRepository) Upsert(
 		return ctx.Err()
 	}
 	// map domain to db item
-	item, err := NewItemFromDomain(domain)
+	item, err := newItemFromDomain(domain, pgr.index)
 	if err != nil {
 		logger.Warn("invalid coordinate for proximity search",
 			zap.Error(err),
@@ -191,12 +187,34 @@ func (pgr *PoIGeoRepository) Upsert(
 		)
 		return poi.ErrDBEntityMapping
 	}
+	if pgr.density {
+		err := pgr.writeWithDensity(ctx, []*densityWrite{
+			{pk: item.Pk, location: domain, item: avs, condition: condition},
+		}, logger)
+		if err != nil && !conditionFailed(err) {
+			logger.Error("failed to write item with density aggregates",
+				zap.Error(err),
+			)
+			return poi.ErrDBUpsert
+		}
+		return err
+	}
 	// create PutItemInput and perform request
 	putItemInput := &dynamodb.PutItemInput{
 		Item:      avs,
 		TableName: &pgr.tableName,
 	}
+	if condition != nil {
+		putItemInput.ConditionExpression = aws.String(condition.expression)
+		putItemInput.ExpressionAttributeNames = condition.names
+		putItemInput.ExpressionAttributeValues = condition.values
+		putItemInput.ReturnValuesOnConditionCheckFailure = types.ReturnValuesOnConditionCheckFailureAllOld
+	}
 	_, err = pgr.dynamoClient.PutItem(ctx, putItemInput)
+	var conditionErr *types.ConditionalCheckFailedException
+	if errors.As(err, &conditionErr) {
+		return condition.err(conditionErr)
+	}
 	if err != nil {
 		logger.Error("failed to PutItem",
 			zap.Error(err),
@@ -206,6 +224,51 @@ func (pgr *PoIGeoRepository) Upsert(
 	return nil
 }
 
+func (pgr *PoIGeoRepository) Delete(
+	ctx context.Context,
+	id ksuid.KSUID,
+	version int64,
+	logger *zap.Logger,
+) error {
+	// handle context cancelation
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	condition := versionCondition(version)
+	if pgr.density {
+		err := pgr.writeWithDensity(ctx, []*densityWrite{{pk: id.String(), condition: condition}}, logger)
+		if err != nil && !conditionFailed(err) {
+			logger.Error("failed to delete item with density aggregates",
+				zap.Error(err),
+			)
+			return poi.ErrDBDelete
+		}
+		return err
+	}
+	deleteItemInput := &dynamodb.DeleteItemInput{
+		TableName: aws.String(pgr.tableName),
+		Key: map[string]types.AttributeValue{
+			CPoIItemPK: &types.AttributeValueMemberS{Value: id.String()},
+		},
+		ConditionExpression:                 aws.String(condition.expression),
+		ExpressionAttributeNames:            condition.names,
+		ExpressionAttributeValues:           condition.values,
+		ReturnValuesOnConditionCheckFailure: types.ReturnValuesOnConditionCheckFailureAllOld,
+	}
+	_, err := pgr.dynamoClient.DeleteItem(ctx, deleteItemInput)
+	var conditionErr *types.ConditionalCheckFailedException
+	if errors.As(err, &conditionErr) {
+		return condition.err(conditionErr)
+	}
+	if err != nil {
+		logger.Error("failed to DeleteItem",
+			zap.Error(err),
+		)
+		return poi.ErrDBDelete
+	}
+	return nil
+}
+
 func (pgr *PoIGeoRepository) GetByID(
 	ctx context.Context,
 	id ksuid.KSUID,
@@ -245,31 +308,128 @@ func (pgr *PoIGeoRepository) GetByID(
 	return item.Domain()
 }
 
+func (pgr *PoIGeoRepository) GetByIDs(
+	ctx context.Context,
+	ids []ksuid.KSUID,
+	logger *zap.Logger,
+) ([]*poi.PoILocation, error) {
+	// handle context cancelation
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+	// BatchGetItem rejects duplicate keys
+	keys := make([]map[string]types.AttributeValue, 0, len(ids))
+	seen := make(map[ksuid.KSUID]bool, len(ids))
+	for _, id := range ids {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		keys = append(keys, map[string]types.AttributeValue{
+			CPoIItemPK: &types.AttributeValueMemberS{Value: id.String()},
+		})
+	}
+	locations := make([]*poi.PoILocation, 0, len(keys))
+	for start := 0; start < len(keys); start += dynamoMaxBatchGetKeys {
+		chunk := keys[start:min(start+dynamoMaxBatchGetKeys, len(keys))]
+		items, err := pgr.batchGetItems(ctx, chunk, logger)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range items {
+			l, err := item.Domain()
+			if err != nil {
+				logger.Error("failed to map item to domain", zap.Error(err))
+				return nil, poi.ErrDBEntityMapping
+			}
+			locations = append(locations, l)
+		}
+	}
+	return locations, nil
+}
+
+// batchGetItems gets the items of at most dynamoMaxBatchGetKeys keys
+func (pgr *PoIGeoRepository) batchGetItems(
+	ctx context.Context,
+	keys []map[string]types.AttributeValue,
+	logger *zap.Logger,
+) ([]*CPoIItem, error) {
+	avs, err := pgr.batchGet(ctx, keys, false, logger)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]*CPoIItem, len(avs))
+	for i, av := range avs {
+		items[i] = new(CPoIItem)
+		if err = attributevalue.UnmarshalMap(av, items[i]); err != nil {
+			logger.Error("failed to unmarshal BatchGetItem output",
+				zap.Error(err),
+			)
+			return nil, poi.ErrDBEntityMapping
+		}
+	}
+	return items, nil
+}
+
+// batchGet gets the attribute values of the items of at most dynamoMaxBatchGetKeys keys, the unprocessed keys are
+// retried with exponential backoff
+func (pgr *PoIGeoRepository) batchGet(
+	ctx context.Context,
+	keys []map[string]types.AttributeValue,
+	consistent bool,
+	logger *zap.Logger,
+) ([]map[string]types.AttributeValue, error) {
+	avs := make([]map[string]types.AttributeValue, 0, len(keys))
+	request := map[string]types.KeysAndAttributes{
+		pgr.tableName: {Keys: keys, ConsistentRead: aws.Bool(consistent)},
+	}
+	for retry := 0; len(request) > 0; retry++ {
+		if retry > batchGetMaxRetries {
+			logger.Error("batch get incomplete after retries",
+				zap.Int("num_unprocessed_keys", len(request[pgr.tableName].Keys)),
+			)
+			return nil, fmt.Errorf("unprocessed keys after %d retries: %w", batchGetMaxRetries, poi.ErrDBQuery)
+		}
+		if retry > 0 {
+			if err := sleepWithContext(ctx, backoff(retry)); err != nil {
+				return nil, err
+			}
+		}
+		output, err := pgr.dynamoClient.BatchGetItem(
+			ctx,
+			&dynamodb.BatchGetItemInput{RequestItems: request},
+		)
+		if err != nil {
+			logger.Error("failed to BatchGetItem",
+				zap.Error(err),
+			)
+			return nil, poi.ErrDBQuery
+		}
+		avs = append(avs, output.Responses[pgr.tableName]...)
+		request = output.UnprocessedKeys
+	}
+	return avs, nil
+}
+
 func (pgr *PoIGeoRepository) GetByProximity(
 	ctx context.Context,
 	cntr poi.Coordinates,
 	radius float64,
 	logger *zap.Logger,
-) ([]*poi.PoILocation, error) {
+	opts ...poi.SearchOption,
+) (*poi.SearchResult, error) {
 	// handle context cancelation
 	if ctx.Err() != nil {
 		return nil, ctx.Err()
 	}
-	// create hashes, validate, and check if we can perform the search without major performance cuts
-	hashes, err := newHashesFromRadiusCenter(cntr, radius, nil)
+	covering, err := geo.CoverProximity(cntr, radius, logger)
 	if err != nil {
-		return nil, poi.ErrInvalidSearchCoordinates
+		return nil, err
 	}
-	// google s2 does not guarantee that the set MaxCells can be fulfilled
-	// an arbitrary large list of hashes might be returned
-	if len(hashes) > proxHashesLimit {
-		logger.Error("too many hashes calculated for proximity",
-			zap.Int("num_hashes", len(hashes)),
-		)
-		return nil, poi.ErrTooLargeSearchArea
+	res, err := pgr.search(ctx, logger, covering.Cells, covering, opts)
+	if errors.Is(err, poi.ErrInvalidPageToken) {
+		return nil, err
 	}
-	// perform parallel queries
-	res, err := pgr.parallelQueryHashes(ctx, logger, hashes)
 	if err != nil {
 		logger.Error("failed to query by proximity",
 			zap.Error(err),
@@ -283,30 +443,83 @@ func (pgr *PoIGeoRepository) GetByBbox(
 	ctx context.Context,
 	sw, ne poi.Coordinates,
 	logger *zap.Logger,
-) ([]*poi.PoILocation, error) {
+	opts ...poi.SearchOption,
+) (*poi.SearchResult, error) {
 	// handle context cancelation
 	if ctx.Err() != nil {
 		return nil, ctx.Err()
 	}
-	// create hashes, validate, and check if we can perform the search without major performance cuts
-	hashes, err := newHashesFromBbox(ne, sw, nil)
+	covering, err := geo.CoverBbox(sw, ne, logger)
+	if err != nil {
+		return nil, err
+	}
+	res, err := pgr.search(ctx, logger, covering.Cells, covering, opts)
+	if errors.Is(err, poi.ErrInvalidPageToken) {
+		return nil, err
+	}
 	if err != nil {
-		logger.Warn("invalid coordinates for bounding box",
+		logger.Error("failed to query by bbox",
 			zap.Error(err),
 		)
-		return nil, poi.ErrInvalidSearchCoordinates
+		return nil, poi.ErrDBQuery
+	}
+	return res, nil
+}
+
+func (pgr *PoIGeoRepository) GetByRoute(
+	ctx context.Context,
+	path []poi.Coordinates,
+	logger *zap.Logger,
+	opts ...poi.SearchOption,
+) (*poi.SearchResult, error) {
+	// handle context cancelation
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+	covering, err := geo.CoverRoute(path, poi.NewSearchOptions(opts...).CorridorMeters, logger)
+	if err != nil {
+		return nil, err
 	}
-	// google s2 does not guarantee that the set MaxCells can be fulfilled
-	// an arbitrary large list of hashes might be returned
-	if len(hashes) > bboxHashesLimit {
-		logger.Error("too many hashes calculated for bbox",
-			zap.Int("num_hashes", len(hashes)),
+	// the covering of a bare route is not an exact search area, hence there is nothing to filter strictly,
+	// whereas a corridor describes the exact search area and the locations are always filtered strictly
+	if covering.Area != nil {
+		opts = append(opts, poi.WithStrict(true))
+	}
+	res, err := pgr.search(ctx, logger, covering.Cells, covering, opts)
+	if errors.Is(err, poi.ErrInvalidPageToken) {
+		return nil, err
+	}
+	if err != nil {
+		logger.Error("failed to query by route",
+			zap.Error(err),
 		)
-		return nil, poi.ErrTooLargeSearchArea
+		return nil, poi.ErrDBQuery
+	}
+	return res, nil
+}
+
+func (pgr *PoIGeoRepository) GetByPolygon(
+	ctx context.Context,
+	rings [][]poi.Coordinates,
+	logger *zap.Logger,
+	opts ...poi.SearchOption,
+) (*poi.SearchResult, error) {
+	// handle context cancelation
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
 	}
-	res, err := pgr.parallelQueryHashes(ctx, logger, hashes)
+	covering, err := geo.CoverPolygon(rings, logger)
 	if err != nil {
-		logger.Error("failed to query by bbox",
+		return nil, err
+	}
+	// a polygon describes the exact search area, hence the locations are always filtered strictly
+	opts = append(opts, poi.WithStrict(true))
+	res, err := pgr.search(ctx, logger, covering.Cells, covering, opts)
+	if errors.Is(err, poi.ErrInvalidPageToken) {
+		return nil, err
+	}
+	if err != nil {
+		logger.Error("failed to query by polygon",
 			zap.Error(err),
 		)
 		return nil, poi.ErrDBQuery
@@ -314,51 +527,207 @@ func (pgr *PoIGeoRepository) GetByBbox(
 	return res, nil
 }
 
-func (pgr *PoIGeoRepository) GetByRoute(
+func (pgr *PoIGeoRepository) StreamByProximity(
+	ctx context.Context,
+	cntr poi.Coordinates,
+	radius float64,
+	logger *zap.Logger,
+	handle poi.SearchResultHandler,
+	opts ...poi.SearchOption,
+) error {
+	// handle context cancelation
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	covering, err := geo.CoverProximity(cntr, radius, logger)
+	if err != nil {
+		return err
+	}
+	return pgr.stream(ctx, logger, covering.Cells, covering, handle, opts)
+}
+
+func (pgr *PoIGeoRepository) StreamByBbox(
+	ctx context.Context,
+	sw, ne poi.Coordinates,
+	logger *zap.Logger,
+	handle poi.SearchResultHandler,
+	opts ...poi.SearchOption,
+) error {
+	// handle context cancelation
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	covering, err := geo.CoverBbox(sw, ne, logger)
+	if err != nil {
+		return err
+	}
+	return pgr.stream(ctx, logger, covering.Cells, covering, handle, opts)
+}
+
+func (pgr *PoIGeoRepository) StreamByRoute(
 	ctx context.Context,
 	path []poi.Coordinates,
 	logger *zap.Logger,
+	handle poi.SearchResultHandler,
+	opts ...poi.SearchOption,
+) error {
+	// handle context cancelation
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	covering, err := geo.CoverRoute(path, poi.NewSearchOptions(opts...).CorridorMeters, logger)
+	if err != nil {
+		return err
+	}
+	if covering.Area != nil {
+		opts = append(opts, poi.WithStrict(true))
+	}
+	return pgr.stream(ctx, logger, covering.Cells, covering, handle, opts)
+}
+
+func (pgr *PoIGeoRepository) GetNearest(
+	ctx context.Context,
+	cntr poi.Coordinates,
+	k int,
+	maxRadius float64,
+	logger *zap.Logger,
+	opts ...poi.SearchOption,
 ) ([]*poi.PoILocation, error) {
 	// handle context cancelation
 	if ctx.Err() != nil {
 		return nil, ctx.Err()
 	}
-	// create hashes, validate, and check if we can perform the search without major performance cuts
-	hashes, err := newHashesFromRoute(path, nil)
+	if k <= 0 {
+		return []*poi.PoILocation{}, nil
+	}
+	filter := poi.NewSearchOptions(opts...).Filter
+	return geo.Nearest(cntr, k, maxRadius, filter, func(cells []s2.CellID) ([]*poi.PoILocation, error) {
+		return pgr.parallelQueryCells(ctx, logger, cells, filter)
+	}, logger)
+}
+
+// GetClusters sums up the density aggregates of the bbox for zoom levels up to the aggregate level. Finer zoom
+// levels query the geo index for the cells of the bbox and aggregate the locations, the queries only return the
+// attributes required for the clusters.
+func (pgr *PoIGeoRepository) GetClusters(
+	ctx context.Context,
+	sw, ne poi.Coordinates,
+	zoom int,
+	logger *zap.Logger,
+) (*poi.ClusterResult, error) {
+	// handle context cancelation
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+	if pgr.density && geo.ClustersFromAggregates(zoom) {
+		covering, err := geo.CoverClusterAggregates(sw, ne, logger)
+		if err != nil {
+			return nil, err
+		}
+		aggregates, err := pgr.getDensityAggregates(ctx, densityPartitions(covering.Cells), logger)
+		if err != nil {
+			return nil, err
+		}
+		return geo.NewClusterResultFromAggregates(aggregates, covering, zoom, logger), nil
+	}
+	covering, err := geo.CoverBbox(sw, ne, logger)
+	if err != nil {
+		return nil, err
+	}
+	queries := pgr.queryInputFromCells(logger, covering.Cells, nil)
+	for _, q := range queries {
+		applyProjection(q, clusterAttributes)
+	}
+	locations := make([]*poi.PoILocation, 0, len(queries))
+	err = pgr.streamQueries(ctx, logger, queries, func(l []*poi.PoILocation) error {
+		locations = append(locations, l...)
+		if len(locations) > geo.MaxClusterLocations {
+			return fmt.Errorf("%w: more than %d locations to cluster", poi.ErrTooLargeSearchArea, geo.MaxClusterLocations)
+		}
+		return nil
+	})
+	if errors.Is(err, poi.ErrTooLargeSearchArea) {
+		return nil, err
+	}
 	if err != nil {
-		logger.Warn("invalid coordinates in provided coordinate path",
+		logger.Error("failed to query clusters",
 			zap.Error(err),
+			zap.Int("num_cells", len(covering.Cells)),
 		)
-		return nil, poi.ErrInvalidSearchCoordinates
+		return nil, poi.ErrDBQuery
 	}
-	// google s2 does not guarantee that the set MaxCells can be fulfilled
-	// an arbitrary large list of hashes might be returned
-	if len(hashes) > routeHashesLimit {
-		logger.Error("too many hashes calculated for route",
-			zap.Int("num_hashes", len(hashes)),
-		)
-		return nil, poi.ErrTooLargeSearchArea
+	return geo.NewClusterResult(locations, covering, zoom, logger), nil
+}
+
+func (pgr *PoIGeoRepository) GetByCells(
+	ctx context.Context,
+	cells []s2.CellID,
+	logger *zap.Logger,
+) ([]*poi.PoILocation, error) {
+	// handle context cancelation
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
 	}
-	res, err := pgr.parallelQueryHashes(ctx, logger, hashes)
+	locations, err := pgr.parallelQueryCells(ctx, logger, cells, nil)
 	if err != nil {
-		logger.Error("failed to query by route",
+		logger.Error("failed to query cells",
 			zap.Error(err),
+			zap.Int("num_cells", len(cells)),
 		)
 		return nil, poi.ErrDBQuery
 	}
-	return res, nil
+	return locations, nil
 }
 
-func (pgr *PoIGeoRepository) parallelQueryHashes(
+func (pgr *PoIGeoRepository) parallelQueryCells(
 	ctx context.Context,
 	logger *zap.Logger,
-	hashes []geoHash,
+	cells []s2.CellID,
+	filter *poi.SearchFilter,
 ) ([]*poi.PoILocation, error) {
-	queries := pgr.queryInputFromHashes(hashes)
+	// Collect results with pre-allocated slice
+	pois := make([]*poi.PoILocation, 0, len(cells)*2) // Estimate capacity
+	err := pgr.streamQueryCells(ctx, logger, cells, filter, func(locations []*poi.PoILocation) error {
+		logger.Debug(
+			"appending pois from parallel query results",
+			zap.Int("num_results", len(locations)),
+		)
+		pois = append(pois, locations...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pois, nil
+}
+
+// streamQueryCells queries the cells in parallel and hands the locations of each query to the handler as soon as
+// the query completes. The handler is called sequentially, if it returns an error the pending queries are canceled.
+func (pgr *PoIGeoRepository) streamQueryCells(
+	ctx context.Context,
+	logger *zap.Logger,
+	cells []s2.CellID,
+	filter *poi.SearchFilter,
+	handle func(locations []*poi.PoILocation) error,
+) error {
+	return pgr.streamQueries(ctx, logger, pgr.queryInputFromCells(logger, cells, filter), handle)
+}
+
+// streamQueries sends the queries in parallel and hands the locations of each query to the handler as soon as
+// the query completes
+func (pgr *PoIGeoRepository) streamQueries(
+	ctx context.Context,
+	logger *zap.Logger,
+	queries []*dynamodb.QueryInput,
+	handle func(locations []*poi.PoILocation) error,
+) error {
 	logger.Info("sending parallel requests for geo query",
 		zap.Int("queries", len(queries)),
 	)
 
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
 	// Use buffered channel with exact size needed
 	resC := make(chan poiQueryResult, len(queries))
 	errGrp, gctx := errgroup.WithContext(ctx)
@@ -402,22 +771,168 @@ func (pgr *PoIGeoRepository) parallelQueryHashes(
 		close(resC)
 	}()
 
-	// Collect results with pre-allocated slice
-	pois := make([]*poi.PoILocation, 0, len(queries)*2) // Estimate capacity
+	// hand over results as they arrive, after a handler error the remaining results are drained
+	var handleErr error
 	for r := range resC {
-		logger.Debug(
-			"appending pois from parallel query results",
-			zap.Int("num_results", len(r.pois)),
-		)
-		pois = append(pois, r.pois...)
+		if handleErr != nil {
+			continue
+		}
+		if err := handle(r.pois); err != nil {
+			handleErr = err
+			cancel()
+		}
 	}
 
 	// Check for worker errors
-	if err := errGrp.Wait(); err != nil {
-		return nil, fmt.Errorf("parallel query failed: %w", err)
+	err := errGrp.Wait()
+	if handleErr != nil {
+		return handleErr
+	}
+	if err != nil {
+		return fmt.Errorf("parallel query failed: %w", err)
 	}
+	return nil
+}
 
-	return pois, nil
+// stream queries the cells of the covering in parallel and hands the search result of each cell to the handler
+func (pgr *PoIGeoRepository) stream(
+	ctx context.Context,
+	logger *zap.Logger,
+	cells []s2.CellID,
+	covering *geo.Covering,
+	handle poi.SearchResultHandler,
+	opts []poi.SearchOption,
+) error {
+	options := poi.NewSearchOptions(opts...)
+	var handleErr error
+	err := pgr.streamQueryCells(ctx, logger, cells, options.Filter, func(locations []*poi.PoILocation) error {
+		handleErr = handle(geo.NewSearchResult(locations, covering, options, logger))
+		return handleErr
+	})
+	if handleErr != nil {
+		return handleErr
+	}
+	if err != nil {
+		logger.Error("failed to stream geo query",
+			zap.Error(err),
+		)
+		return poi.ErrDBQuery
+	}
+	return nil
+}
+
+// search queries the cells of the covering either all at once or page by page and applies the search options
+// to the locations found. If area is nil the locations are not filtered strictly.
+func (pgr *PoIGeoRepository) search(
+	ctx context.Context,
+	logger *zap.Logger,
+	cells []s2.CellID,
+	covering *geo.Covering,
+	opts []poi.SearchOption,
+) (*poi.SearchResult, error) {
+	options := poi.NewSearchOptions(opts...)
+	if options.Paged() {
+		return geo.NewPagedSearchResult(func(token string) ([]*poi.PoILocation, string, error) {
+			return pgr.pagedQueryCells(ctx, logger, cells, options, token)
+		}, covering, options, logger)
+	}
+	locations, err := pgr.parallelQueryCells(ctx, logger, cells, options.Filter)
+	if err != nil {
+		return nil, err
+	}
+	return geo.NewSearchResult(locations, covering, options, logger), nil
+}
+
+// pagedQueryCells queries the cells of the covering in order until the page is full, starting at the position
+// of the page token. It returns the token for the next page, which is empty if all cells have been queried.
+func (pgr *PoIGeoRepository) pagedQueryCells(
+	ctx context.Context,
+	logger *zap.Logger,
+	cells []s2.CellID,
+	options *poi.SearchOptions,
+	token string,
+) ([]*poi.PoILocation, string, error) {
+	covering := coveringFingerprint(cells, options)
+	queries := pgr.queryInputFromCells(logger, cells, options.Filter)
+	start := &pageToken{Covering: covering}
+	if token != "" {
+		t := new(pageToken)
+		if err := pgr.pageTokens.Decode(token, t); err != nil {
+			logger.Warn("failed to decode page token", zap.Error(err))
+			return nil, "", poi.ErrInvalidPageToken
+		}
+		if t.Covering != covering || t.Cell < 0 || t.Cell >= len(queries) {
+			logger.Warn("page token does not match the search",
+				zap.Int("cell", t.Cell),
+				zap.Int("num_queries", len(queries)),
+			)
+			return nil, "", poi.ErrInvalidPageToken
+		}
+		start = t
+	}
+	startKey, err := start.exclusiveStartKey()
+	if err != nil {
+		logger.Warn("invalid start key in page token", zap.Error(err))
+		return nil, "", poi.ErrInvalidPageToken
+	}
+
+	logger.Info("sending paged requests for geo query",
+		zap.Int("queries", len(queries)),
+		zap.Int("start_cell", start.Cell),
+		zap.Int("page_size", options.PageSize),
+	)
+	items := make([]map[string]types.AttributeValue, 0, options.PageSize)
+	for i := start.Cell; i < len(queries); i++ {
+		input := queries[i]
+		if i == start.Cell {
+			input.ExclusiveStartKey = startKey
+		}
+		for {
+			input.Limit = aws.Int32(int32(options.PageSize - len(items))) //nolint:gosec // bound by page size
+			out, errQ := pgr.dynamoClient.QueryItem(ctx, input)
+			if errQ != nil {
+				return nil, "", fmt.Errorf("failed to query page of cell %d: %w", i, errQ)
+			}
+			items = append(items, out.Items...)
+			if out.LastEvaluatedKey == nil {
+				break
+			}
+			if len(items) >= options.PageSize {
+				// the page is full within the cell, continue in the same cell after the last evaluated key
+				return pgr.pageResult(items, covering, i, out.LastEvaluatedKey)
+			}
+			input.ExclusiveStartKey = out.LastEvaluatedKey
+		}
+		if len(items) >= options.PageSize && i+1 < len(queries) {
+			return pgr.pageResult(items, covering, i+1, nil)
+		}
+	}
+	locations, err := mapAvs(items)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to map results: %w", err)
+	}
+	return locations, "", nil
+}
+
+func (pgr *PoIGeoRepository) pageResult(
+	items []map[string]types.AttributeValue,
+	covering uint64,
+	cell int,
+	lastKey map[string]types.AttributeValue,
+) ([]*poi.PoILocation, string, error) {
+	locations, err := mapAvs(items)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to map results: %w", err)
+	}
+	t, err := newPageToken(covering, cell, lastKey)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to create page token: %w", err)
+	}
+	next, err := pgr.pageTokens.Encode(t)
+	if err != nil {
+		return nil, "", err
+	}
+	return locations, next, nil
 }
 
 type poiQueryResult struct {
@@ -437,11 +952,11 @@ func (pgr *PoIGeoRepository) query(
 	items = append(items, queryResult.Items...)
 	for queryResult.LastEvaluatedKey != nil {
 		input.ExclusiveStartKey = queryResult.LastEvaluatedKey
-		res, errQ := pgr.dynamoClient.QueryItem(ctx, input)
-		if errQ != nil {
-			return poiQueryResult{nil, fmt.Errorf("failed to call query page: %w", errQ)}
+		queryResult, err = pgr.dynamoClient.QueryItem(ctx, input)
+		if err != nil {
+			return poiQueryResult{nil, fmt.Errorf("failed to call query page: %w", err)}
 		}
-		items = append(items, res.Items...)
+		items = append(items, queryResult.Items...)
 	}
 	domain, err := mapAvs(items)
 	if err != nil {
@@ -450,26 +965,35 @@ func (pgr *PoIGeoRepository) query(
 	return poiQueryResult{domain, nil}
 }
 
-func (pgr *PoIGeoRepository) queryInputFromHashes(hashes []geoHash) []*dynamodb.QueryInput {
-	queries := make([]*dynamodb.QueryInput, 0)
-	for _, v := range hashes {
-		keyCondition := fmt.Sprintf(
-			"%s = :pk AND %s BETWEEN :skmin AND :skmax",
-			CPoIItemGeoIndexPK,
-			CPoIItemGeoIndexSK,
-		)
+func (pgr *PoIGeoRepository) queryInputFromCells(
+	logger *zap.Logger,
+	cells []s2.CellID,
+	filter *poi.SearchFilter,
+) []*dynamodb.QueryInput {
+	index, ranges := pgr.index.Ranges(cells)
+	logger.Debug("planned geo query",
+		zap.String("index", geoIndexes[index].name),
+		zap.Int("num_cells", len(cells)),
+		zap.Int("num_ranges", len(ranges)),
+	)
+	keyCondition := fmt.Sprintf(
+		"%s = :pk AND %s BETWEEN :skmin AND :skmax",
+		geoIndexes[index].pk,
+		CPoIItemGeoIndexSK,
+	)
+	queries := make([]*dynamodb.QueryInput, 0, len(ranges))
+	for _, r := range ranges {
 		query := &dynamodb.QueryInput{
 			TableName:              aws.String(pgr.tableName),
-			IndexName:              aws.String(CPoIItemGeoIndexName),
+			IndexName:              aws.String(geoIndexes[index].name),
 			KeyConditionExpression: aws.String(keyCondition),
 			ExpressionAttributeValues: map[string]types.AttributeValue{
-				":pk": &types.AttributeValueMemberN{
-					Value: strconv.FormatUint(v.trimmed(CPoIItemCellLevel), 10),
-				},
-				":skmin": &types.AttributeValueMemberN{Value: strconv.FormatUint(v.min(), 10)},
-				":skmax": &types.AttributeValueMemberN{Value: strconv.FormatUint(v.max(), 10)},
+				":pk":    &types.AttributeValueMemberN{Value: strconv.FormatUint(r.Partition, 10)},
+				":skmin": &types.AttributeValueMemberN{Value: strconv.FormatUint(r.Min, 10)},
+				":skmax": &types.AttributeValueMemberN{Value: strconv.FormatUint(r.Max, 10)},
 			},
 		}
+		applyFilter(query, filter)
 		queries = append(queries, query)
 	}
 	return queries
@@ -495,36 +1019,36 @@ func (pgr *PoIGeoRepository) createTableAndLoadData(logger *zap.Logger) error {
 }
 
 func (pgr *PoIGeoRepository) createInitPoiTable() error {
+	attributes := []types.AttributeDefinition{
+		{AttributeName: aws.String(CPoIItemPK), AttributeType: types.ScalarAttributeTypeS},
+		{AttributeName: aws.String(CPoIItemGeoIndexSK), AttributeType: types.ScalarAttributeTypeN},
+	}
+	indexes := make([]types.GlobalSecondaryIndex, len(geoIndexes))
+	for i, index := range geoIndexes {
+		attributes = append(attributes, types.AttributeDefinition{
+			AttributeName: aws.String(index.pk),
+			AttributeType: types.ScalarAttributeTypeN,
+		})
+		indexes[i] = types.GlobalSecondaryIndex{
+			IndexName: aws.String(index.name),
+			KeySchema: []types.KeySchemaElement{
+				{AttributeName: aws.String(index.pk), KeyType: types.KeyTypeHash},
+				{AttributeName: aws.String(CPoIItemGeoIndexSK), KeyType: types.KeyTypeRange},
+			},
+			Projection: &types.Projection{ProjectionType: types.ProjectionTypeAll},
+			ProvisionedThroughput: &types.ProvisionedThroughput{
+				ReadCapacityUnits:  aws.Int64(10),
+				WriteCapacityUnits: aws.Int64(10),
+			},
+		}
+	}
 	input := dynamodb.CreateTableInput{
 		TableName: aws.String(pgr.tableName),
 		KeySchema: []types.KeySchemaElement{
 			{AttributeName: aws.String(CPoIItemPK), KeyType: types.KeyTypeHash},
 		},
-		AttributeDefinitions: []types.AttributeDefinition{
-			{AttributeName: aws.String(CPoIItemPK), AttributeType: types.ScalarAttributeTypeS},
-			{
-				AttributeName: aws.String(CPoIItemGeoIndexPK),
-				AttributeType: types.ScalarAttributeTypeN,
-			},
-			{
-				AttributeName: aws.String(CPoIItemGeoIndexSK),
-				AttributeType: types.ScalarAttributeTypeN,
-			},
-		},
-		GlobalSecondaryIndexes: []types.GlobalSecondaryIndex{
-			{
-				IndexName: aws.String(CPoIItemGeoIndexName),
-				KeySchema: []types.KeySchemaElement{
-					{AttributeName: aws.String(CPoIItemGeoIndexPK), KeyType: types.KeyTypeHash},
-					{AttributeName: aws.String(CPoIItemGeoIndexSK), KeyType: types.KeyTypeRange},
-				},
-				Projection: &types.Projection{ProjectionType: types.ProjectionTypeAll},
-				ProvisionedThroughput: &types.ProvisionedThroughput{
-					ReadCapacityUnits:  aws.Int64(10),
-					WriteCapacityUnits: aws.Int64(10),
-				},
-			},
-		},
+		AttributeDefinitions:   attributes,
+		GlobalSecondaryIndexes: indexes,
 		ProvisionedThroughput: &types.ProvisionedThroughput{
 			ReadCapacityUnits:  aws.Int64(10),
 			WriteCapacityUnits: aws.Int64(10),
@@ -537,51 +1061,12 @@ func (pgr *PoIGeoRepository) createInitPoiTable() error {
 	return nil
 }
 
-func createBatchRequests(pois []*poi.PoILocation) ([][]types.WriteRequest, error) {
-	rqsts := make([]types.WriteRequest, len(pois))
-	for i, v := range pois {
-		item, err := NewItemFromDomain(v)
-		if err != nil {
-			return nil, fmt.Errorf("unable to map item to domain: %w", err)
-		}
-		av, err := attributevalue.MarshalMap(&item)
-		if err != nil {
-			return nil, fmt.Errorf("failed to markshall item: %w", err)
-		}
-		rqsts[i] = types.WriteRequest{PutRequest: &types.PutRequest{Item: av}}
-	}
-	numChunks := (len(pois) + dynamoMaxBatchSize - 1) / dynamoMaxBatchSize
-	chunks := make([][]types.WriteRequest, 0, numChunks)
-
-	// create chunks with max 25 items
-	for dynamoMaxBatchSize < len(rqsts) {
-		rqsts, chunks = rqsts[dynamoMaxBatchSize:], append(
-			chunks,
-			rqsts[0:dynamoMaxBatchSize:dynamoMaxBatchSize],
-		)
-	}
-	chunks = append(chunks, rqsts)
-	return chunks, nil
-}
-
 func (pgr *PoIGeoRepository) loadInitData(logger *zap.Logger) error {
-	csv, err := os.Open(pgr.initDataPath)
+	locations, err := csv.LocationsFromFile(pgr.initDataPath)
 	if err != nil {
-		return fmt.Errorf("failed to load csv from file: %w", err)
-	}
-	entries := []*CPoIItem{}
-	if errMarshall := gocsv.UnmarshalFile(csv, &entries); errMarshall != nil {
-		return fmt.Errorf("failed to map rows to struct, %w", errMarshall)
-	}
-	locations := make([]*poi.PoILocation, len(entries))
-	for i, v := range entries {
-		d, errD := v.Domain()
-		if errD != nil {
-			return fmt.Errorf("failed to map test data to domain struct: %w", errD)
-		}
-		locations[i] = d
+		return err
 	}
-	err = pgr.UpsertBatch(context.Background(), locations, logger)
+	_, err = pgr.UpsertBatch(context.Background(), locations, logger)
 	if err != nil {
 		return fmt.Errorf("failed to perform batch upsert: %w", err)
 	}
@@ -2,7 +2,9 @@ package dynamo_test
 
 import (
 	"context"
+	"errors"
 
+	"github.com/golang/geo/s2"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"github.com/segmentio/ksuid"
@@ -64,6 +66,39 @@ var _ = Describe("given db connected", Ordered, func() {
 			Expect(err).To(Equal(poi.ErrLocationNotFound))
 		})
 
+		// GetByIDs
+		It("get pois by ids returns existing locations only", func() {
+			kID, err := ksuid.Parse("2ofD9igSisfEtgC743gf3BnzO7L")
+			Expect(err).To(Not(HaveOccurred()))
+			missingID, err := ksuid.Parse("2ofD9i2CSiA4Oa0tIYRJlJv399H")
+			Expect(err).To(Not(HaveOccurred()))
+			locations, err := repository.GetByIDs(ctx, []ksuid.KSUID{kID, missingID, kID}, logger)
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(locations).To(HaveLen(1))
+			Expect(locations[0].ID).To(Equal(kID))
+		})
+
+		It("get pois by more ids than fit into one batch returns all locations", func() {
+			res, err := repository.GetByBbox(
+				ctx,
+				poi.Coordinates{Longitude: 8.494772, Latitude: 49.425026},
+				poi.Coordinates{Longitude: 10.040508, Latitude: 50.089540},
+				logger,
+			)
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(len(res.Locations)).To(BeNumerically(">", 70))
+			ids := make([]ksuid.KSUID, 0, 2*len(res.Locations))
+			for range 2 {
+				for _, l := range res.Locations {
+					ids = append(ids, ksuid.New())
+					ids = append(ids, l.ID)
+				}
+			}
+			locations, err := repository.GetByIDs(ctx, ids, logger)
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(locations).To(HaveLen(len(res.Locations)))
+		})
+
 		// Upsert
 		It("upsert poi does not error as expected", func() {
 			poi := poi.PoILocation{
@@ -87,21 +122,86 @@ var _ = Describe("given db connected", Ordered, func() {
 			Expect(*actualSafedPoi).To(Equal(poi))
 		})
 
+		// Create, Update and Delete
+		It("create, update and delete poi with conditions on versions as expected", func() {
+			location := poi.PoILocation{
+				ID:               ksuid.New(),
+				Location:         poi.Coordinates{Latitude: 64.135482, Longitude: -21.895410},
+				LocationEntrance: poi.Coordinates{Latitude: 64.135482, Longitude: -21.895410},
+				Address: poi.Address{
+					Street:      "Laugavegur",
+					City:        "Reykjavik",
+					ZipCode:     "101",
+					CountryCode: "ISL",
+				},
+			}
+			err := repository.Update(ctx, &location, logger)
+			Expect(err).To(Equal(poi.ErrLocationNotFound))
+
+			err = repository.Create(ctx, &location, logger)
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(location.Version).To(Equal(int64(1)))
+			err = repository.Create(ctx, &location, logger)
+			Expect(err).To(Equal(poi.ErrLocationAlreadyExists))
+
+			location.Address.StreetNumber = "2b"
+			stale := location
+			err = repository.Update(ctx, &location, logger)
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(location.Version).To(Equal(int64(2)))
+			actual, err := repository.GetByID(ctx, location.ID, logger)
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(actual.Address.StreetNumber).To(Equal("2b"))
+			Expect(actual.Version).To(Equal(int64(2)))
+
+			err = repository.Update(ctx, &stale, logger)
+			Expect(err).To(Equal(poi.ErrVersionMismatch))
+			err = repository.Delete(ctx, location.ID, stale.Version, logger)
+			Expect(err).To(Equal(poi.ErrVersionMismatch))
+
+			err = repository.Delete(ctx, location.ID, location.Version, logger)
+			Expect(err).To(Not(HaveOccurred()))
+			_, err = repository.GetByID(ctx, location.ID, logger)
+			Expect(err).To(Equal(poi.ErrLocationNotFound))
+			err = repository.Delete(ctx, location.ID, poi.AnyVersion, logger)
+			Expect(err).To(Equal(poi.ErrLocationNotFound))
+		})
+
 		// GetByProxinity
 		It("get location by proximity search returns locations as expected", func() {
 			cntr := poi.Coordinates{Longitude: 9.147263, Latitude: 49.333418}
 			radiusMeters := 50_000.0 // 50 km
-			pois, err := repository.GetByProximity(ctx, cntr, radiusMeters, logger)
+			res, err := repository.GetByProximity(ctx, cntr, radiusMeters, logger)
 			Expect(err).To(Not(HaveOccurred()))
-			Expect(len(pois)).To(BeNumerically(">", 30))
+			Expect(len(res.Locations)).To(BeNumerically(">", 30))
 		})
 
 		It("get location by proximity search returns locations as expected", func() {
 			cntr := poi.Coordinates{Longitude: 9.147263, Latitude: 49.333418}
 			radiusMeters := 100_000.0 // 100 km
-			pois, err := repository.GetByProximity(ctx, cntr, radiusMeters, logger)
+			res, err := repository.GetByProximity(ctx, cntr, radiusMeters, logger)
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(len(res.Locations)).To(BeNumerically(">", 70))
+		})
+
+		It("get location by proximity search in strict mode returns only locations in radius", func() {
+			cntr := poi.Coordinates{Longitude: 9.147263, Latitude: 49.333418}
+			radiusMeters := 30_000.0 // 30 km
+			all, err := repository.GetByProximity(ctx, cntr, radiusMeters, logger)
+			Expect(err).To(Not(HaveOccurred()))
+			res, err := repository.GetByProximity(
+				ctx,
+				cntr,
+				radiusMeters,
+				logger,
+				poi.WithStrict(true),
+			)
 			Expect(err).To(Not(HaveOccurred()))
-			Expect(len(pois)).To(BeNumerically(">", 70))
+			Expect(res.Discarded).To(BeNumerically(">", 0))
+			Expect(len(res.Locations) + res.Discarded).To(Equal(len(all.Locations)))
+			for _, p := range res.Locations {
+				Expect(distanceTo(cntr, p.Location)).To(BeNumerically("<=", radiusMeters))
+			}
 		})
 
 		It("get location by proximity with invalid coordinates returns error", func() {
@@ -116,9 +216,40 @@ var _ = Describe("given db connected", Ordered, func() {
 		It("get location by bbox search returns locations as expected", func() {
 			sw := poi.Coordinates{Longitude: 8.494772, Latitude: 49.425026}
 			ne := poi.Coordinates{Longitude: 10.040508, Latitude: 50.089540}
-			pois, err := repository.GetByBbox(ctx, sw, ne, logger)
+			res, err := repository.GetByBbox(ctx, sw, ne, logger)
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(len(res.Locations)).To(BeNumerically(">", 70))
+		})
+
+		It("get location by bbox search in strict mode returns only locations in bbox", func() {
+			sw := poi.Coordinates{Longitude: 8.494772, Latitude: 49.425026}
+			ne := poi.Coordinates{Longitude: 10.040508, Latitude: 50.089540}
+			all, err := repository.GetByBbox(ctx, sw, ne, logger)
+			Expect(err).To(Not(HaveOccurred()))
+			res, err := repository.GetByBbox(ctx, sw, ne, logger, poi.WithStrict(true))
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(len(res.Locations) + res.Discarded).To(Equal(len(all.Locations)))
+			for _, p := range res.Locations {
+				Expect(p.Location.Latitude).To(BeNumerically(">=", sw.Latitude))
+				Expect(p.Location.Latitude).To(BeNumerically("<=", ne.Latitude))
+				Expect(p.Location.Longitude).To(BeNumerically(">=", sw.Longitude))
+				Expect(p.Location.Longitude).To(BeNumerically("<=", ne.Longitude))
+			}
+		})
+
+		It("get location by bbox search with filter returns only locations with features", func() {
+			sw := poi.Coordinates{Longitude: 8.494772, Latitude: 49.425026}
+			ne := poi.Coordinates{Longitude: 10.040508, Latitude: 50.089540}
+			all, err := repository.GetByBbox(ctx, sw, ne, logger)
+			Expect(err).To(Not(HaveOccurred()))
+			filter := &poi.SearchFilter{Features: []string{"DC_CHARGING"}, CountryCodes: []string{"DEU"}}
+			res, err := repository.GetByBbox(ctx, sw, ne, logger, poi.WithFilter(filter))
 			Expect(err).To(Not(HaveOccurred()))
-			Expect(len(pois)).To(BeNumerically(">", 70))
+			Expect(len(res.Locations)).To(BeNumerically(">", 0))
+			Expect(len(res.Locations)).To(BeNumerically("<", len(all.Locations)))
+			for _, p := range res.Locations {
+				Expect(p.Features).To(ContainElement("DC_CHARGING"))
+			}
 		})
 
 		It("get location by bbox search with invalid coordinates returns nerror", func() {
@@ -129,6 +260,62 @@ var _ = Describe("given db connected", Ordered, func() {
 			Expect(err).To(Equal(poi.ErrInvalidSearchCoordinates))
 		})
 
+		It("get location by bbox search with page size returns all locations page by page", func() {
+			sw := poi.Coordinates{Longitude: 8.494772, Latitude: 49.425026}
+			ne := poi.Coordinates{Longitude: 10.040508, Latitude: 50.089540}
+			all, err := repository.GetByBbox(ctx, sw, ne, logger)
+			Expect(err).To(Not(HaveOccurred()))
+			pageSize := 7
+			ids := make(map[string]bool)
+			token := ""
+			for {
+				res, errP := repository.GetByBbox(ctx, sw, ne, logger, poi.WithPage(pageSize, token))
+				Expect(errP).To(Not(HaveOccurred()))
+				Expect(len(res.Locations)).To(BeNumerically("<=", pageSize))
+				for _, p := range res.Locations {
+					Expect(ids).To(Not(HaveKey(p.ID.String())))
+					ids[p.ID.String()] = true
+				}
+				if res.NextPageToken == "" {
+					break
+				}
+				token = res.NextPageToken
+			}
+			Expect(ids).To(HaveLen(len(all.Locations)))
+		})
+
+		It("get location by bbox search with tampered page token returns error", func() {
+			sw := poi.Coordinates{Longitude: 8.494772, Latitude: 49.425026}
+			ne := poi.Coordinates{Longitude: 10.040508, Latitude: 50.089540}
+			res, err := repository.GetByBbox(ctx, sw, ne, logger, poi.WithPage(5, ""))
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(res.NextPageToken).To(Not(BeEmpty()))
+			_, err = repository.GetByBbox(
+				ctx,
+				sw,
+				ne,
+				logger,
+				poi.WithPage(5, "x"+res.NextPageToken),
+			)
+			Expect(err).To(Equal(poi.ErrInvalidPageToken))
+		})
+
+		It("get location by proximity search with page token of other search returns error", func() {
+			sw := poi.Coordinates{Longitude: 8.494772, Latitude: 49.425026}
+			ne := poi.Coordinates{Longitude: 10.040508, Latitude: 50.089540}
+			res, err := repository.GetByBbox(ctx, sw, ne, logger, poi.WithPage(5, ""))
+			Expect(err).To(Not(HaveOccurred()))
+			cntr := poi.Coordinates{Longitude: 9.147263, Latitude: 49.333418}
+			_, err = repository.GetByProximity(
+				ctx,
+				cntr,
+				30_000.0,
+				logger,
+				poi.WithPage(5, res.NextPageToken),
+			)
+			Expect(err).To(Equal(poi.ErrInvalidPageToken))
+		})
+
 		// GetByRoute
 		It("get location by route search returns locations as expected", func() {
 			route := []poi.Coordinates{
@@ -138,9 +325,9 @@ var _ = Describe("given db connected", Ordered, func() {
 				{Longitude: 8.740714, Latitude: 50.144288},
 				{Longitude: 13.100310, Latitude: 52.551214},
 			}
-			pois, err := repository.GetByRoute(ctx, route, logger)
+			res, err := repository.GetByRoute(ctx, route, logger)
 			Expect(err).To(Not(HaveOccurred()))
-			Expect(len(pois)).To(BeNumerically(">", 10))
+			Expect(len(res.Locations)).To(BeNumerically(">", 10))
 		})
 
 		It("get location by route search with invalid coordinates returns error", func() {
@@ -155,6 +342,132 @@ var _ = Describe("given db connected", Ordered, func() {
 			Expect(err).To((HaveOccurred()))
 			Expect(err).To(Equal(poi.ErrInvalidSearchCoordinates))
 		})
+
+		It("get location by route search with corridor returns only locations within corridor", func() {
+			route := []poi.Coordinates{
+				{Longitude: 9.181946, Latitude: 48.796183},
+				{Longitude: 8.611994, Latitude: 49.75371},
+				{Longitude: 8.180723, Latitude: 49.558617},
+			}
+			wide, err := repository.GetByRoute(ctx, route, logger, poi.WithCorridor(10_000.0))
+			Expect(err).To(Not(HaveOccurred()))
+			res, err := repository.GetByRoute(ctx, route, logger, poi.WithCorridor(2000.0))
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(len(res.Locations)).To(BeNumerically(">", 0))
+			Expect(len(res.Locations)).To(BeNumerically("<", len(wide.Locations)))
+			for _, p := range res.Locations {
+				Expect(distanceToRoute(route, p.Location)).To(BeNumerically("<=", 2000.0))
+			}
+		})
+
+		// GetByPolygon
+		It("get location by polygon search returns only locations inside polygon", func() {
+			sw := poi.Coordinates{Longitude: 8.494772, Latitude: 49.425026}
+			ne := poi.Coordinates{Longitude: 10.040508, Latitude: 50.089540}
+			ring := []poi.Coordinates{
+				sw,
+				{Longitude: ne.Longitude, Latitude: sw.Latitude},
+				ne,
+				{Longitude: sw.Longitude, Latitude: ne.Latitude},
+			}
+			bbox, err := repository.GetByBbox(ctx, sw, ne, logger, poi.WithStrict(true))
+			Expect(err).To(Not(HaveOccurred()))
+			res, err := repository.GetByPolygon(ctx, [][]poi.Coordinates{ring}, logger)
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(len(res.Locations)).To(BeNumerically(">", 0))
+			Expect(len(res.Locations)).To(BeNumerically("<=", len(bbox.Locations)))
+		})
+
+		It("get location by polygon search with invalid coordinates returns error", func() {
+			ring := []poi.Coordinates{
+				{Longitude: 9000.181946, Latitude: 48888.796183},
+				{Longitude: 8.611994, Latitude: 49.75371},
+				{Longitude: 8.740714, Latitude: 50.144288},
+			}
+			_, err := repository.GetByPolygon(ctx, [][]poi.Coordinates{ring}, logger)
+			Expect(err).To((HaveOccurred()))
+			Expect(err).To(Equal(poi.ErrInvalidSearchCoordinates))
+		})
+
+		// StreamByBbox
+		It("stream location by bbox search hands over all locations cell by cell", func() {
+			sw := poi.Coordinates{Longitude: 8.494772, Latitude: 49.425026}
+			ne := poi.Coordinates{Longitude: 10.040508, Latitude: 50.089540}
+			all, err := repository.GetByBbox(ctx, sw, ne, logger)
+			Expect(err).To(Not(HaveOccurred()))
+			numResults, numLocations := 0, 0
+			err = repository.StreamByBbox(ctx, sw, ne, logger, func(res *poi.SearchResult) error {
+				numResults++
+				numLocations += len(res.Locations)
+				return nil
+			})
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(numResults).To(BeNumerically(">", 1))
+			Expect(numLocations).To(Equal(len(all.Locations)))
+		})
+
+		It("stream location by bbox search aborts with the error of the handler", func() {
+			sw := poi.Coordinates{Longitude: 8.494772, Latitude: 49.425026}
+			ne := poi.Coordinates{Longitude: 10.040508, Latitude: 50.089540}
+			handlerErr := errors.New("client gone")
+			err := repository.StreamByBbox(ctx, sw, ne, logger, func(_ *poi.SearchResult) error {
+				return handlerErr
+			})
+			Expect(err).To(Equal(handlerErr))
+		})
+
+		It("stream location by route search with invalid coordinates returns error", func() {
+			route := []poi.Coordinates{
+				{Longitude: 9000.181946, Latitude: 48888.796183},
+				{Longitude: 8.611994, Latitude: 49.75371},
+			}
+			err := repository.StreamByRoute(ctx, route, logger, func(_ *poi.SearchResult) error {
+				return nil
+			})
+			Expect(err).To(Equal(poi.ErrInvalidSearchCoordinates))
+		})
+
+		// GetNearest
+		It("get nearest locations returns k locations sorted by distance", func() {
+			cntr := poi.Coordinates{Longitude: 9.147263, Latitude: 49.333418}
+			k := 5
+			pois, err := repository.GetNearest(ctx, cntr, k, 100_000.0, logger)
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(pois).To(HaveLen(k))
+			for i := 1; i < len(pois); i++ {
+				Expect(distanceTo(cntr, pois[i].Location)).To(
+					BeNumerically(">=", distanceTo(cntr, pois[i-1].Location)),
+				)
+			}
+		})
+
+		It("get nearest locations returns only locations within max radius", func() {
+			cntr := poi.Coordinates{Longitude: 9.147263, Latitude: 49.333418}
+			maxRadius := 5_000.0 // 5 km
+			pois, err := repository.GetNearest(ctx, cntr, 100, maxRadius, logger)
+			Expect(err).To(Not(HaveOccurred()))
+			for _, p := range pois {
+				Expect(distanceTo(cntr, p.Location)).To(BeNumerically("<=", maxRadius))
+			}
+		})
+
+		It("get nearest locations with filter returns k matching locations", func() {
+			cntr := poi.Coordinates{Longitude: 9.147263, Latitude: 49.333418}
+			filter := &poi.SearchFilter{Features: []string{"DC_CHARGING"}}
+			pois, err := repository.GetNearest(ctx, cntr, 2, 100_000.0, logger, poi.WithFilter(filter))
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(pois).To(HaveLen(2))
+			for _, p := range pois {
+				Expect(p.Features).To(ContainElement("DC_CHARGING"))
+			}
+		})
+
+		It("get nearest locations with invalid coordinates returns error", func() {
+			cntr := poi.Coordinates{Longitude: 9000.147263, Latitude: 49000.333418}
+			_, err := repository.GetNearest(ctx, cntr, 5, 50_000.0, logger)
+			Expect(err).To((HaveOccurred()))
+			Expect(err).To(Equal(poi.ErrInvalidSearchCoordinates))
+		})
 	})
 
 	AfterAll(func() {
@@ -165,3 +478,19 @@ var _ = Describe("given db connected", Ordered, func() {
 		cancelFn()
 	})
 })
+
+func distanceTo(a, b poi.Coordinates) float64 {
+	la := s2.LatLngFromDegrees(a.Latitude, a.Longitude)
+	lb := s2.LatLngFromDegrees(b.Latitude, b.Longitude)
+	return la.Distance(lb).Radians() * 6371000.0
+}
+
+func distanceToRoute(route []poi.Coordinates, c poi.Coordinates) float64 {
+	line := make(s2.Polyline, len(route))
+	for i, r := range route {
+		line[i] = s2.PointFromLatLng(s2.LatLngFromDegrees(r.Latitude, r.Longitude))
+	}
+	p := s2.PointFromLatLng(s2.LatLngFromDegrees(c.Latitude, c.Longitude))
+	projected, _ := line.Project(p)
+	return p.Distance(projected).Radians() * 6371000.0
+}
@@ -0,0 +1,157 @@
+package dynamo
+
+import (
+	"fmt"
+
+	"github.com/golang/geo/s2"
+)
+
+const (
+	SpatialIndexS2      = "s2"
+	SpatialIndexGeohash = "geohash"
+)
+
+// the spatial index of items mapped without a table, e.g. for the csv import
+var defaultSpatialIndex = NewS2Index()
+
+// GeoIndex identifies one of the geo indexes of the table, ordered from the finest to the coarsest partitions
+type GeoIndex int
+
+const (
+	FineGeoIndex GeoIndex = iota
+	DefaultGeoIndex
+	CoarseGeoIndex
+	numGeoIndexes
+)
+
+// geoIndexAttributes are the name and the partition key of a geo index, all geo indexes are sorted by the full
+// precision key of the spatial index
+type geoIndexAttributes struct {
+	name string
+	pk   string
+}
+
+var geoIndexes = [numGeoIndexes]geoIndexAttributes{
+	FineGeoIndex:    {name: CPoIItemFineGeoIndexName, pk: CPoIItemFineGeoIndexPK},
+	DefaultGeoIndex: {name: CPoIItemGeoIndexName, pk: CPoIItemGeoIndexPK},
+	CoarseGeoIndex:  {name: CPoIItemCoarseGeoIndexName, pk: CPoIItemCoarseGeoIndexPK},
+}
+
+// A SpatialKey is the position of a point in the geo indexes
+type SpatialKey struct {
+	Sort       uint64                // the full precision key, the sort key of all geo indexes
+	Partitions [numGeoIndexes]uint64 // the partition key of the point in each geo index
+}
+
+func (k SpatialKey) apply(item *CPoIItem) {
+	item.GeoIndexSk = k.Sort
+	item.GeoIndexPk = k.Partitions[DefaultGeoIndex]
+	item.CoarseGeoIndexPk = k.Partitions[CoarseGeoIndex]
+	item.FineGeoIndexPk = k.Partitions[FineGeoIndex]
+}
+
+// A KeyRange is the range of sort keys within a single partition of a geo index, including both bounds
+type KeyRange struct {
+	Partition uint64
+	Min       uint64
+	Max       uint64
+}
+
+// The SpatialIndex maps points to the keys of the geo indexes and search areas to the key ranges to query, the
+// search areas are given by the cells of their covering. The spatial index is fixed per table, since the items
+// are written with its keys.
+type SpatialIndex interface {
+	Key(lat, lon float64) (SpatialKey, error)
+	// Ranges picks the geo index to query for the covering and returns the key ranges containing the covering
+	Ranges(cells []s2.CellID) (GeoIndex, []KeyRange)
+}
+
+// NewSpatialIndex returns the spatial index by its name, SpatialIndexS2 if the name is empty. The level partitions
+// the default geo index of the s2 index and must be between the levels of the coarse and the fine geo index, zero
+// uses CPoIItemCellLevel. The level must match the level the items of the table have been indexed with, see
+// cmd/reindex. The geohash index supports CPoIItemCellLevel only.
+func NewSpatialIndex(name string, level int) (SpatialIndex, error) {
+	if level == 0 {
+		level = CPoIItemCellLevel
+	}
+	switch name {
+	case SpatialIndexS2, "":
+		if level < CPoIItemCoarseCellLevel || level > CPoIItemFineCellLevel {
+			return nil, fmt.Errorf("invalid level %d, must be between %d and %d",
+				level, CPoIItemCoarseCellLevel, CPoIItemFineCellLevel)
+		}
+		return newS2IndexWithLevel(level), nil
+	case SpatialIndexGeohash:
+		if level != CPoIItemCellLevel {
+			return nil, fmt.Errorf("level is only supported by the %s spatial index", SpatialIndexS2)
+		}
+		return NewGeohashIndex(), nil
+	default:
+		return nil, fmt.Errorf("unknown spatial index %s", name)
+	}
+}
+
+// The s2Index keys points by their leaf cell and partitions the geo indexes by the parent cells of their level
+type s2Index struct {
+	levels [numGeoIndexes]int
+}
+
+func NewS2Index() SpatialIndex {
+	return newS2IndexWithLevel(CPoIItemCellLevel)
+}
+
+// newS2IndexWithLevel returns the s2 index with the given level for the default geo index
+func newS2IndexWithLevel(level int) *s2Index {
+	return &s2Index{levels: [numGeoIndexes]int{
+		FineGeoIndex:    CPoIItemFineCellLevel,
+		DefaultGeoIndex: level,
+		CoarseGeoIndex:  CPoIItemCoarseCellLevel,
+	}}
+}
+
+func (x *s2Index) Key(lat, lon float64) (SpatialKey, error) {
+	gh, err := newGeoHash(lat, lon)
+	if err != nil {
+		return SpatialKey{}, err
+	}
+	key := SpatialKey{Sort: gh.hash()}
+	for i, level := range x.levels {
+		key.Partitions[i] = gh.trimmed(level)
+	}
+	return key, nil
+}
+
+// Ranges picks the finest geo index whose partitions contain the coarsest cell of the covering. Hence, each cell
+// is queried within a single partition and small coverings read small partitions, while large coverings of coarse
+// cells query few large partitions. Cells coarser than the coarsest geo index are split into their children.
+func (x *s2Index) Ranges(cells []s2.CellID) (GeoIndex, []KeyRange) {
+	coarsest := s2.MaxLevel
+	for _, c := range cells {
+		coarsest = min(coarsest, c.Level())
+	}
+	index := CoarseGeoIndex
+	for i, level := range x.levels {
+		if level <= coarsest {
+			index = GeoIndex(i)
+			break
+		}
+	}
+	level := x.levels[index]
+	ranges := make([]KeyRange, 0, len(cells))
+	for _, c := range cells {
+		if c.Level() >= level {
+			ranges = append(ranges, newS2Range(c, level))
+			continue
+		}
+		end := c.ChildEndAtLevel(level)
+		for child := c.ChildBeginAtLevel(level); child != end; child = child.Next() {
+			ranges = append(ranges, newS2Range(child, level))
+		}
+	}
+	return index, ranges
+}
+
+func newS2Range(cell s2.CellID, level int) KeyRange {
+	h := geoHash{hashID: cell}
+	return KeyRange{Partition: h.trimmed(level), Min: h.min(), Max: h.max()}
+}
@@ -0,0 +1,137 @@
+package dynamo
+
+import (
+	"cmp"
+	"slices"
+	"testing"
+
+	"github.com/golang/geo/s1"
+	"github.com/golang/geo/s2"
+	"go.uber.org/zap"
+
+	"github.com/grntlrduck-cloud/go-grpc-geohasing-service-sample/internal/adapters/geo"
+	"github.com/grntlrduck-cloud/go-grpc-geohasing-service-sample/internal/domain/poi"
+)
+
+// the number of grid points per side sampling the surroundings of a search area for the over-fetch
+const overFetchGridSize = 300
+
+type benchmarkSearch struct {
+	name  string
+	cover func(logger *zap.Logger) (*geo.Covering, error)
+}
+
+var benchmarkSearches = []benchmarkSearch{
+	{"proximity_1km", func(logger *zap.Logger) (*geo.Covering, error) {
+		return geo.CoverProximity(poi.Coordinates{Latitude: 49.64636, Longitude: 8.78141}, 1_000, logger)
+	}},
+	{"proximity_10km", func(logger *zap.Logger) (*geo.Covering, error) {
+		return geo.CoverProximity(poi.Coordinates{Latitude: 49.64636, Longitude: 8.78141}, 10_000, logger)
+	}},
+	{"proximity_100km", func(logger *zap.Logger) (*geo.Covering, error) {
+		return geo.CoverProximity(poi.Coordinates{Latitude: 49.64636, Longitude: 8.78141}, 100_000, logger)
+	}},
+	{"bbox", func(logger *zap.Logger) (*geo.Covering, error) {
+		return geo.CoverBbox(
+			poi.Coordinates{Latitude: 49.9, Longitude: 8.5},
+			poi.Coordinates{Latitude: 50.2, Longitude: 8.9},
+			logger,
+		)
+	}},
+	{"route", func(logger *zap.Logger) (*geo.Covering, error) {
+		return geo.CoverRoute([]poi.Coordinates{
+			{Latitude: 50.110924, Longitude: 8.682127}, // Frankfurt
+			{Latitude: 49.872826, Longitude: 8.651193}, // Darmstadt
+			{Latitude: 49.398750, Longitude: 8.672434}, // Heidelberg
+		}, 2_000, logger)
+	}},
+	{"polygon", func(logger *zap.Logger) (*geo.Covering, error) {
+		return geo.CoverPolygon([][]poi.Coordinates{{
+			{Latitude: 49.5, Longitude: 8.6},
+			{Latitude: 49.5, Longitude: 9.0},
+			{Latitude: 49.8, Longitude: 9.0},
+			{Latitude: 49.8, Longitude: 8.6},
+		}}, logger)
+	}},
+}
+
+// BenchmarkSpatialIndexRanges compares the spatial indexes for the search types. Besides the time to plan the
+// queries, it reports the number of queries of the search and the over-fetch, i.e. the ratio of the points read by
+// the queries to the points within the search area, of a grid of points sampling the search area.
+//
+//	go test ./internal/adapters/dynamo -run '^$' -bench SpatialIndex
+func BenchmarkSpatialIndexRanges(b *testing.B) {
+	logger := zap.NewNop()
+	for _, search := range benchmarkSearches {
+		covering, err := search.cover(logger)
+		if err != nil {
+			b.Fatalf("failed to cover %s: %v", search.name, err)
+		}
+		points := gridPoints(covering)
+		for _, name := range []string{SpatialIndexS2, SpatialIndexGeohash} {
+			index, err := NewSpatialIndex(name, 0)
+			if err != nil {
+				b.Fatal(err)
+			}
+			_, ranges := index.Ranges(covering.Cells)
+			overFetch := overFetch(index, ranges, points, covering.Area)
+			b.Run(search.name+"/"+name, func(b *testing.B) {
+				for range b.N {
+					index.Ranges(covering.Cells)
+				}
+				b.ReportMetric(float64(len(ranges)), "queries/op")
+				b.ReportMetric(overFetch, "overfetch/op")
+			})
+		}
+	}
+}
+
+// gridPoints samples the bounding rectangle of the covering, extended by its size on each side, so that the
+// points read by queries of ranges exceeding the covering are sampled too. Points beyond the poles are invalid
+// and skipped by the over-fetch.
+func gridPoints(covering *geo.Covering) []s2.LatLng {
+	union := s2.CellUnion(covering.Cells)
+	rect := union.RectBound()
+	size := rect.Size()
+	lo := rect.Lo()
+	lo.Lat, lo.Lng = lo.Lat-size.Lat, lo.Lng-size.Lng
+	size.Lat, size.Lng = 3*size.Lat, 3*size.Lng
+	points := make([]s2.LatLng, 0, overFetchGridSize*overFetchGridSize)
+	for i := range overFetchGridSize {
+		for j := range overFetchGridSize {
+			points = append(points, s2.LatLng{
+				Lat: lo.Lat + size.Lat*s1.Angle(i)/overFetchGridSize,
+				Lng: lo.Lng + size.Lng*s1.Angle(j)/overFetchGridSize,
+			})
+		}
+	}
+	return points
+}
+
+// overFetch returns the ratio of the points within the key ranges to the points within the search area
+func overFetch(index SpatialIndex, ranges []KeyRange, points []s2.LatLng, area s2.Region) float64 {
+	fetched, contained := 0, 0
+	for _, p := range points {
+		key, err := index.Key(p.Lat.Degrees(), p.Lng.Degrees())
+		if err != nil {
+			continue
+		}
+		// the ranges are sorted and do not overlap, the key is within the last range starting before it
+		i, found := slices.BinarySearchFunc(ranges, key.Sort, func(r KeyRange, k uint64) int {
+			return cmp.Compare(r.Min, k)
+		})
+		if !found {
+			i--
+		}
+		if i >= 0 && key.Sort <= ranges[i].Max {
+			fetched++
+		}
+		if area.ContainsPoint(s2.PointFromLatLng(p)) {
+			contained++
+		}
+	}
+	if contained == 0 {
+		return 0
+	}
+	return float64(fetched) / float64(contained)
+}
@@ -0,0 +1,147 @@
+package dynamo
+
+import (
+	"github.com/golang/geo/s2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/zap"
+
+	"github.com/grntlrduck-cloud/go-grpc-geohasing-service-sample/internal/adapters/geo"
+	"github.com/grntlrduck-cloud/go-grpc-geohasing-service-sample/internal/domain/poi"
+)
+
+var _ = Describe("given a spatial index", func() {
+	logger := zap.NewNop()
+	cntr := poi.Coordinates{Latitude: 49.64636, Longitude: 8.78141}
+
+	// containsKey reports whether the key is within any of the ranges of the geo index
+	containsKey := func(index GeoIndex, ranges []KeyRange, key SpatialKey) bool {
+		for _, r := range ranges {
+			if r.Partition == key.Partitions[index] && r.Min <= key.Sort && key.Sort <= r.Max {
+				return true
+			}
+		}
+		return false
+	}
+
+	When("spatial index is created by name", func() {
+		It("returns the index", func() {
+			index, err := NewSpatialIndex(SpatialIndexGeohash, 0)
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(index).To(Equal(NewGeohashIndex()))
+			index, err = NewSpatialIndex("", 0)
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(index).To(Equal(NewS2Index()))
+			index, err = NewSpatialIndex(SpatialIndexS2, CPoIItemCellLevel+1)
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(index).To(Equal(newS2IndexWithLevel(CPoIItemCellLevel + 1)))
+		})
+
+		It("returns error for unknown name", func() {
+			_, err := NewSpatialIndex("h3", 0)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("returns error for level", func() {
+			_, err := NewSpatialIndex(SpatialIndexS2, CPoIItemFineCellLevel+1)
+			Expect(err).To(HaveOccurred())
+			_, err = NewSpatialIndex(SpatialIndexGeohash, CPoIItemCellLevel+1)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	When("s2 index plans geo query", func() {
+		cells := func(levels ...int) []s2.CellID {
+			leaf := s2.CellIDFromLatLng(s2.LatLngFromDegrees(cntr.Latitude, cntr.Longitude))
+			res := make([]s2.CellID, len(levels))
+			for i, l := range levels {
+				res[i] = leaf.Parent(l)
+			}
+			return res
+		}
+
+		It("picks the finest index containing the coarsest cell", func() {
+			index, ranges := NewS2Index().Ranges(cells(13, 14))
+			Expect(index).To(Equal(FineGeoIndex))
+			Expect(ranges).To(HaveLen(2))
+			index, _ = NewS2Index().Ranges(cells(11, 13))
+			Expect(index).To(Equal(DefaultGeoIndex))
+			index, _ = NewS2Index().Ranges(cells(7, 9))
+			Expect(index).To(Equal(CoarseGeoIndex))
+		})
+
+		It("splits cells coarser than the coarsest index into their children", func() {
+			index, ranges := NewS2Index().Ranges(cells(5, 10))
+			Expect(index).To(Equal(CoarseGeoIndex))
+			Expect(ranges).To(HaveLen(5))
+			for _, r := range ranges {
+				Expect(s2.CellID(r.Partition).Level()).To(Equal(CPoIItemCoarseCellLevel))
+			}
+		})
+
+		It("keys the point like the item", func() {
+			key, err := NewS2Index().Key(cntr.Latitude, cntr.Longitude)
+			Expect(err).To(Not(HaveOccurred()))
+			item, err := NewItemFromDomain(&poi.PoILocation{Location: cntr})
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(key.Sort).To(Equal(item.GeoIndexSk))
+			Expect(key.Partitions[DefaultGeoIndex]).To(Equal(item.GeoIndexPk))
+			Expect(key.Partitions[CoarseGeoIndex]).To(Equal(item.CoarseGeoIndexPk))
+			Expect(key.Partitions[FineGeoIndex]).To(Equal(item.FineGeoIndexPk))
+		})
+	})
+
+	When("geohash index keys a point", func() {
+		It("is the base32 geohash", func() {
+			key, err := NewGeohashIndex().Key(57.64911, 10.40744)
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(geohashString(key.Sort, 11)).To(Equal("u4pruydqqvj"))
+			Expect(key.Partitions[FineGeoIndex]).To(Equal(key.Sort >> (geohashBits - geohashCharBits*geohashFineChars)))
+			Expect(key.Partitions[CoarseGeoIndex]).To(Equal(key.Sort >> (geohashBits - geohashCharBits*geohashCoarseChars)))
+		})
+
+		It("returns error for invalid coordinates", func() {
+			_, err := NewGeohashIndex().Key(91, 10.40744)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	When("geohash index plans geo query", func() {
+		key, _ := NewGeohashIndex().Key(cntr.Latitude, cntr.Longitude) // the center is valid
+
+		It("picks the index by the size of the covering", func() {
+			small, err := geo.CoverProximity(cntr, 1_000, logger)
+			Expect(err).To(Not(HaveOccurred()))
+			index, ranges := NewGeohashIndex().Ranges(small.Cells)
+			Expect(index).To(Equal(FineGeoIndex))
+			Expect(containsKey(index, ranges, key)).To(BeTrue())
+
+			large, err := geo.CoverProximity(cntr, 100_000, logger)
+			Expect(err).To(Not(HaveOccurred()))
+			index, ranges = NewGeohashIndex().Ranges(large.Cells)
+			Expect(index).To(Equal(CoarseGeoIndex))
+			Expect(containsKey(index, ranges, key)).To(BeTrue())
+		})
+
+		It("merges adjacent ranges within a partition", func() {
+			covering, err := geo.CoverProximity(cntr, 5_000, logger)
+			Expect(err).To(Not(HaveOccurred()))
+			_, ranges := NewGeohashIndex().Ranges(covering.Cells)
+			for i := 1; i < len(ranges); i++ {
+				Expect(ranges[i].Min).To(BeNumerically(">", ranges[i-1].Max))
+				if ranges[i].Partition == ranges[i-1].Partition {
+					Expect(ranges[i].Min).To(BeNumerically(">", ranges[i-1].Max+1))
+				}
+			}
+		})
+
+		It("covers rectangles across the antimeridian", func() {
+			rect := s2.RectFromLatLng(s2.LatLngFromDegrees(-17, 179.9)).AddPoint(s2.LatLngFromDegrees(-17.1, -179.9))
+			Expect(rect.Lng.IsInverted()).To(BeTrue())
+			geohashes := geohashesInRect(rect, 3)
+			Expect(geohashes).To(HaveLen(2))
+			Expect(geohashString(geohashes[0]<<(geohashBits-3*geohashCharBits), 3)).To(Equal("ruz"))
+			Expect(geohashString(geohashes[1]<<(geohashBits-3*geohashCharBits), 3)).To(Equal("2hb"))
+		})
+	})
+})
@@ -0,0 +1,181 @@
+package geo
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/golang/geo/r3"
+	"github.com/golang/geo/s2"
+	"go.uber.org/zap"
+
+	"github.com/grntlrduck-cloud/go-grpc-geohasing-service-sample/internal/domain/poi"
+)
+
+const maxClusterFeatures = 3 // the number of dominant features of a cluster
+
+// MaxClusterLocations is the max number of locations clustered for zoom levels finer than the aggregate level,
+// clusters of bounding boxes with more locations are rejected with poi.ErrTooLargeSearchArea
+const MaxClusterLocations = 20_000
+
+// ClusterLevel returns the level of the cells clustering the locations of a map with the zoom level. A map tile of
+// zoom z spans 360/2^z degrees of longitude and a cell of level l about 90/2^l degrees, hence the cells of the zoom
+// level are about a quarter of the width of a map tile.
+func ClusterLevel(zoom int) int {
+	return min(max(zoom, 0), s2.MaxLevel)
+}
+
+// ClustersFromAggregates reports whether the clusters of the zoom level are summed up from the density aggregates
+// rather than the locations, which is the case if the cells of the zoom level are not finer than the aggregates
+func ClustersFromAggregates(zoom int) bool {
+	return ClusterLevel(zoom) <= DensityAggregateLevel
+}
+
+// CoverClusterAggregates creates the covering of the bbox for clusters summed up from the density aggregates. Unlike
+// the covering of a bbox search, it is never truncated and any bbox is accepted, since the aggregates are read per
+// cell of the covering rather than per location.
+func CoverClusterAggregates(sw, ne poi.Coordinates, logger *zap.Logger) (*Covering, error) {
+	if err := checkBbox(sw, ne, logger); err != nil {
+		return nil, err
+	}
+	rect := newRectFromBbox(ne, sw)
+	coverer := &s2.RegionCoverer{MaxLevel: DensityAggregateLevel, MaxCells: bboxCellsLimit}
+	return &Covering{Cells: coverer.Covering(rect), Area: rect, Fraction: 1}, nil
+}
+
+// NewClusterResultFromAggregates sums up the density aggregates whose cell center is within the search area of the
+// covering into the cells of the zoom level, which must not be finer than the aggregate level. The centroid of a
+// cluster is the mean of the cell centers weighted by their count, the clusters have no features.
+func NewClusterResultFromAggregates(
+	aggregates DensityAggregates,
+	covering *Covering,
+	zoom int,
+	logger *zap.Logger,
+) *poi.ClusterResult {
+	union := s2.CellUnion(covering.Cells)
+	level := ClusterLevel(zoom)
+	// the aggregates are summed up in the order of their cells, so that the centroids are deterministic
+	cells := make([]s2.CellID, 0, len(aggregates))
+	for cell, count := range aggregates {
+		// the charging capacity of removed locations might not cancel out exactly
+		if count.Count > 0 {
+			cells = append(cells, cell)
+		}
+	}
+	slices.Sort(cells)
+	byCell := make(map[s2.CellID]*clusterAggregate)
+	for _, cell := range cells {
+		p := cell.Point()
+		if covering.Area != nil && !covering.Area.ContainsPoint(p) {
+			continue
+		}
+		if covering.Area == nil && !union.ContainsCellID(cell) {
+			continue
+		}
+		parent := cell.Parent(level)
+		agg, ok := byCell[parent]
+		if !ok {
+			agg = &clusterAggregate{features: make(map[string]int)}
+			byCell[parent] = agg
+		}
+		agg.addCount(p, aggregates[cell].Count)
+	}
+	res := newClusterResult(byCell, covering)
+	logger.Debug("summed up density aggregates into clusters",
+		zap.Int("level", level),
+		zap.Int("num_aggregates", len(aggregates)),
+		zap.Int("num_clusters", len(res.Clusters)),
+	)
+	return res
+}
+
+// NewClusterResult aggregates the locations within the search area of the covering into the cells of the zoom level.
+// The clusters are ordered by their cells, so that the order is stable when a map is panned.
+func NewClusterResult(
+	locations []*poi.PoILocation,
+	covering *Covering,
+	zoom int,
+	logger *zap.Logger,
+) *poi.ClusterResult {
+	if covering.Area != nil {
+		locations, _ = filterContained(locations, covering.Area)
+	}
+	level := ClusterLevel(zoom)
+	byCell := make(map[s2.CellID]*clusterAggregate)
+	for _, l := range locations {
+		p := PointFromCoordinates(l.Location)
+		cell := s2.CellFromPoint(p).ID().Parent(level)
+		agg, ok := byCell[cell]
+		if !ok {
+			agg = &clusterAggregate{features: make(map[string]int)}
+			byCell[cell] = agg
+		}
+		agg.add(p, l.Features)
+	}
+	res := newClusterResult(byCell, covering)
+	logger.Debug("clustered locations",
+		zap.Int("level", level),
+		zap.Int("num_locations", len(locations)),
+		zap.Int("num_clusters", len(res.Clusters)),
+	)
+	return res
+}
+
+// newClusterResult returns the clusters ordered by their cells
+func newClusterResult(byCell map[s2.CellID]*clusterAggregate, covering *Covering) *poi.ClusterResult {
+	cells := make([]s2.CellID, 0, len(byCell))
+	for c := range byCell {
+		cells = append(cells, c)
+	}
+	slices.Sort(cells)
+	res := &poi.ClusterResult{Clusters: make([]*poi.Cluster, len(cells))}
+	for i, c := range cells {
+		res.Clusters[i] = byCell[c].cluster()
+	}
+	if covering.Truncated() {
+		res.Truncated = true
+		res.CoveredFraction = covering.Fraction
+	}
+	return res
+}
+
+// a clusterAggregate sums the positions and counts the features of the locations of a cluster
+type clusterAggregate struct {
+	sum      r3.Vector
+	count    int
+	features map[string]int
+}
+
+func (a *clusterAggregate) add(p s2.Point, features []string) {
+	a.sum = a.sum.Add(p.Vector)
+	a.count++
+	for _, f := range features {
+		a.features[f]++
+	}
+}
+
+// addCount adds the count of locations at the position, e.g. the center of a density aggregate
+func (a *clusterAggregate) addCount(p s2.Point, count int) {
+	a.sum = a.sum.Add(p.Mul(float64(count)))
+	a.count += count
+}
+
+// cluster returns the cluster with the centroid of the positions on the sphere, which is also correct for clusters
+// spanning the antimeridian, and the most frequent features ordered by their frequency and name
+func (a *clusterAggregate) cluster() *poi.Cluster {
+	centroid := s2.LatLngFromPoint(s2.Point{Vector: a.sum.Normalize()})
+	features := make([]string, 0, len(a.features))
+	for f := range a.features {
+		features = append(features, f)
+	}
+	slices.SortFunc(features, func(x, y string) int {
+		if c := cmp.Compare(a.features[y], a.features[x]); c != 0 {
+			return c
+		}
+		return cmp.Compare(x, y)
+	})
+	return &poi.Cluster{
+		Centroid: poi.Coordinates{Latitude: centroid.Lat.Degrees(), Longitude: centroid.Lng.Degrees()},
+		Count:    a.count,
+		Features: features[:min(len(features), maxClusterFeatures)],
+	}
+}
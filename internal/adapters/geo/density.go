@@ -0,0 +1,140 @@
+package geo
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/golang/geo/s2"
+	"go.uber.org/zap"
+
+	"github.com/grntlrduck-cloud/go-grpc-geohasing-service-sample/internal/domain/poi"
+)
+
+// DensityAggregateLevel is the level of the cells the repositories aggregate the density for on writes, edge length
+// of min 7 km and max 10 km. The density of coarser levels is summed up from the aggregates, so that country-wide
+// heatmaps do not read the locations, the density of finer levels is counted from the locations.
+const DensityAggregateLevel = 10
+
+// CoverDensityArea creates the covering of the bounding box or the polygon of the area
+func CoverDensityArea(area *poi.DensityArea, logger *zap.Logger) (*Covering, error) {
+	if len(area.Rings) > 0 {
+		return CoverPolygon(area.Rings, logger)
+	}
+	return CoverBbox(area.SW, area.NE, logger)
+}
+
+// DensityCells returns the cells of the covering coarsened to the level, so that each cell of the level intersecting
+// the covering is either one of the cells or contained by one of them
+func DensityCells(covering *Covering, level int) []s2.CellID {
+	cells := make(s2.CellUnion, len(covering.Cells))
+	for i, c := range covering.Cells {
+		cells[i] = c.Parent(min(c.Level(), level))
+	}
+	cells.Normalize()
+	return cells
+}
+
+// DensityAggregateCell returns the cell of the aggregate level containing the coordinates
+func DensityAggregateCell(c poi.Coordinates) s2.CellID {
+	return s2.CellIDFromLatLng(s2.LatLngFromDegrees(c.Latitude, c.Longitude)).Parent(DensityAggregateLevel)
+}
+
+// The DensityAggregates map the cells of the aggregate level to the count of their locations
+type DensityAggregates map[s2.CellID]poi.DensityCount
+
+// Add adds the count to the cell, cells whose count cancels out are removed
+func (a DensityAggregates) Add(cell s2.CellID, count poi.DensityCount) {
+	sum := a[cell].Add(count)
+	if sum == (poi.DensityCount{}) {
+		delete(a, cell)
+		return
+	}
+	a[cell] = sum
+}
+
+// Replace applies the change of replacing the stored location with the location, the stored location is nil for
+// new locations and the location is nil for deleted locations
+func (a DensityAggregates) Replace(stored, l *poi.PoILocation) {
+	if stored != nil {
+		a.Add(DensityAggregateCell(stored.Location), poi.DensityCount{}.Sub(poi.NewDensityCount(stored)))
+	}
+	if l != nil {
+		a.Add(DensityAggregateCell(l.Location), poi.NewDensityCount(l))
+	}
+}
+
+// NewDensityResult counts the locations within the cells created by DensityCells per cell of the level
+func NewDensityResult(
+	locations []*poi.PoILocation,
+	cells []s2.CellID,
+	covering *Covering,
+	level int,
+	logger *zap.Logger,
+) *poi.DensityResult {
+	union := s2.CellUnion(cells)
+	counts := make(map[s2.CellID]poi.DensityCount)
+	for _, l := range locations {
+		cell := s2.CellIDFromLatLng(s2.LatLngFromDegrees(l.Location.Latitude, l.Location.Longitude))
+		if union.ContainsCellID(cell) {
+			counts[cell.Parent(level)] = counts[cell.Parent(level)].Add(poi.NewDensityCount(l))
+		}
+	}
+	logger.Debug("counted density of locations",
+		zap.Int("level", level),
+		zap.Int("num_locations", len(locations)),
+	)
+	return newDensityResult(counts, covering, level, false)
+}
+
+// NewDensityResultFromAggregates sums up the aggregates within the cells created by DensityCells per cell of the
+// level, the level must not be finer than the aggregate level
+func NewDensityResultFromAggregates(
+	aggregates DensityAggregates,
+	cells []s2.CellID,
+	covering *Covering,
+	level int,
+	logger *zap.Logger,
+) *poi.DensityResult {
+	union := s2.CellUnion(cells)
+	counts := make(map[s2.CellID]poi.DensityCount)
+	for cell, count := range aggregates {
+		if union.ContainsCellID(cell) {
+			counts[cell.Parent(level)] = counts[cell.Parent(level)].Add(count)
+		}
+	}
+	logger.Debug("summed up density aggregates",
+		zap.Int("level", level),
+		zap.Int("num_aggregates", len(aggregates)),
+	)
+	return newDensityResult(counts, covering, level, true)
+}
+
+// newDensityResult returns the cells with any location ordered by their cell id
+func newDensityResult(
+	counts map[s2.CellID]poi.DensityCount,
+	covering *Covering,
+	level int,
+	precomputed bool,
+) *poi.DensityResult {
+	res := &poi.DensityResult{Level: level, Cells: make([]*poi.DensityCell, 0, len(counts)), Precomputed: precomputed}
+	for cell, count := range counts {
+		// the charging capacity of removed locations might not cancel out exactly
+		if count.Count <= 0 {
+			continue
+		}
+		center := cell.LatLng()
+		res.Cells = append(res.Cells, &poi.DensityCell{
+			Cell:         cell,
+			Center:       poi.Coordinates{Latitude: center.Lat.Degrees(), Longitude: center.Lng.Degrees()},
+			DensityCount: count,
+		})
+	}
+	slices.SortFunc(res.Cells, func(a, b *poi.DensityCell) int {
+		return cmp.Compare(a.Cell, b.Cell)
+	})
+	if covering.Truncated() {
+		res.Truncated = true
+		res.CoveredFraction = covering.Fraction
+	}
+	return res
+}
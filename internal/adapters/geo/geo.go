@@ -0,0 +1,542 @@
+package geo
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash/fnv"
+	"math"
+	"slices"
+	"sort"
+
+	"github.com/golang/geo/r1"
+	"github.com/golang/geo/s1"
+	"github.com/golang/geo/s2"
+	"go.uber.org/zap"
+
+	"github.com/grntlrduck-cloud/go-grpc-geohasing-service-sample/internal/domain/poi"
+)
+
+const (
+	earthRadiusMeter = 6371000.0
+	maxLatitude      = 90.0
+	minLatitude      = -90.0
+	maxLongitude     = 180.0
+	minLongitude     = -180.0
+	// the min level of coverings of large search areas, which is the level of the coarse DynamoDB geo index
+	coarseMinLevel = 6
+	// the min share of the search area a truncated covering must cover, larger search areas are rejected
+	minCoveredFraction = 0.5
+)
+
+// in the case of a radius search we want to return more results than in the radius intentiaionally
+// so that if a user zooms there are still enough PoI centered
+// http://s2geometry.io/resources/s2cell_statistics.html
+// BBox and Radius search default
+var defaultAreaCoverer = s2.RegionCoverer{
+	MinLevel: 9, // more coarse
+	MaxLevel: 13,
+	MaxCells: 15,
+	LevelMod: 1,
+}
+
+// default for Route search
+var defaultPolylineCoverer = s2.RegionCoverer{
+	MinLevel: 9,
+	MaxLevel: 15,  // fine grainer
+	MaxCells: 100, // needs to cover longer area
+	LevelMod: 1,
+}
+
+// default for Polygon search, finer than the area coverer since polygons like city boundaries are irregular
+var defaultPolygonCoverer = s2.RegionCoverer{
+	MinLevel: 9,
+	MaxLevel: 14,
+	MaxCells: 50,
+	LevelMod: 1,
+}
+
+// adaptCovering returns the covering if it is within the max cells of the coverer and the limit. Since no cell is
+// coarser than the min level of the coverer, large search areas are covered by more cells than the coverer aims for.
+// Those are covered again with the coarse min level, so that the cells can be queried from the coarse DynamoDB
+// geo index. If the covering exceeds the limit, the search area is covered again with the max level of the coverer
+// lowered level by level, so that fewer but larger cells cover the area. If the covering still exceeds the limit,
+// it is truncated to the cells closest to the reference point and the share of the covering kept is returned,
+// otherwise 1. If the truncated covering keeps less than minCoveredFraction, the search fails with
+// poi.ErrTooLargeSearchArea instead of returning a mostly empty result.
+func adaptCovering(
+	cells []s2.CellID,
+	cover func(coverer *s2.RegionCoverer) []s2.CellID,
+	coverer s2.RegionCoverer,
+	limit int,
+	ref s2.Point,
+	logger *zap.Logger,
+) ([]s2.CellID, float64, error) {
+	if len(cells) > coverer.MaxCells && coverer.MinLevel > coarseMinLevel {
+		coverer.MinLevel = coarseMinLevel
+		cells = cover(&coverer)
+		logger.Debug("covered large search area with coarse cells",
+			zap.Int("num_cells", len(cells)),
+		)
+	}
+	// once all cells are at the min level, coarser coverers return the same covering
+	for coarser := coverer; len(cells) > limit && coarser.MaxLevel > coarser.MinLevel && !atLevel(cells, coarser.MinLevel); {
+		coarser.MaxLevel--
+		cells = cover(&coarser)
+		logger.Info("coarsened covering",
+			zap.Int("max_level", coarser.MaxLevel),
+			zap.Int("num_cells", len(cells)),
+		)
+	}
+	if len(cells) <= limit {
+		return cells, 1, nil
+	}
+	truncated, fraction := truncateCovering(cells, limit, ref)
+	if fraction < minCoveredFraction {
+		logger.Warn("rejected search area exceeding the limit",
+			zap.Int("num_cells", len(cells)),
+			zap.Int("limit", limit),
+			zap.Float64("covered_fraction", fraction),
+		)
+		return nil, 0, poi.ErrTooLargeSearchArea
+	}
+	logger.Warn("truncated covering exceeding the limit",
+		zap.Int("num_cells", len(cells)),
+		zap.Int("limit", limit),
+		zap.Float64("covered_fraction", fraction),
+	)
+	return truncated, fraction, nil
+}
+
+// checkBbox validates the corners of a bounding box
+func checkBbox(sw, ne poi.Coordinates, logger *zap.Logger) error {
+	if !ValidLatLon(ne.Latitude, ne.Longitude) || !ValidLatLon(sw.Latitude, sw.Longitude) {
+		logger.Warn("invalid coordinates for bounding box",
+			zap.Float64("ne_lat", ne.Latitude),
+			zap.Float64("ne_lon", ne.Longitude),
+			zap.Float64("sw_lat", sw.Latitude),
+			zap.Float64("sw_lon", sw.Longitude),
+		)
+		return poi.ErrInvalidSearchCoordinates
+	}
+	return nil
+}
+
+// checkSearchArea rejects search areas, given as solid angle, which are too large to cover minCoveredFraction of
+// them with the limit cells of the coarse min level. Hence, those areas are rejected before they are covered, which
+// is expensive for continent-sized areas.
+func checkSearchArea(area float64, limit int, logger *zap.Logger) error {
+	maxArea := float64(limit) * s2.AvgAreaMetric.Value(coarseMinLevel) / minCoveredFraction
+	if area > maxArea {
+		logger.Warn("rejected search area exceeding the max area",
+			zap.Float64("area_km2", area*earthRadiusMeter*earthRadiusMeter/1e6),
+			zap.Float64("max_area_km2", maxArea*earthRadiusMeter*earthRadiusMeter/1e6),
+		)
+		return poi.ErrTooLargeSearchArea
+	}
+	return nil
+}
+
+func atLevel(cells []s2.CellID, level int) bool {
+	for _, c := range cells {
+		if c.Level() != level {
+			return false
+		}
+	}
+	return true
+}
+
+// truncateCovering keeps the limit cells closest to the reference point in the order of the covering and returns
+// them with the share of the area of the covering they cover
+func truncateCovering(cells []s2.CellID, limit int, ref s2.Point) ([]s2.CellID, float64) {
+	type candidate struct {
+		cell     s2.CellID
+		distance s1.ChordAngle
+		area     float64
+	}
+	candidates := make([]candidate, len(cells))
+	total := 0.0
+	for i, c := range cells {
+		cell := s2.CellFromCellID(c)
+		candidates[i] = candidate{cell: c, distance: cell.Distance(ref), area: cell.ApproxArea()}
+		total += candidates[i].area
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].distance < candidates[j].distance
+	})
+	kept := make([]s2.CellID, limit)
+	covered := 0.0
+	for i, c := range candidates[:limit] {
+		kept[i] = c.cell
+		covered += c.area
+	}
+	slices.Sort(kept)
+	return kept, covered / total
+}
+
+func newCellsFromRadiusCenter(
+	c poi.Coordinates,
+	radius float64,
+	coverer *s2.RegionCoverer,
+) ([]s2.CellID, error) {
+	if !ValidLatLon(c.Latitude, c.Longitude) {
+		return nil, fmt.Errorf("invalid search center: lat=%f, lon=%f", c.Latitude, c.Longitude)
+	}
+	region := newCapFromRadiusCenter(c, radius)
+	if coverer == nil {
+		coverer = &defaultAreaCoverer
+	}
+	return coverer.Covering(region), nil
+}
+
+func newCellsFromBbox(ne, sw poi.Coordinates, coverer *s2.RegionCoverer) ([]s2.CellID, error) {
+	if !ValidLatLon(ne.Latitude, ne.Longitude) || !ValidLatLon(sw.Latitude, sw.Longitude) {
+		return nil, fmt.Errorf(
+			"invalid bounding box: ne.lat=%f, ne.lon=%f, sw.lat=%f, sw.lon=%f",
+			ne.Latitude,
+			ne.Longitude,
+			sw.Latitude,
+			sw.Longitude,
+		)
+	}
+	if coverer == nil {
+		coverer = &defaultAreaCoverer
+	}
+	return coverer.Covering(newRectFromBbox(ne, sw)), nil
+}
+
+func newCellsFromRoute(path []poi.Coordinates, coverer *s2.RegionCoverer) ([]s2.CellID, error) {
+	polyline, err := newPolylineFromRoute(path)
+	if err != nil {
+		return nil, err
+	}
+	if coverer == nil {
+		coverer = &defaultPolylineCoverer
+	}
+	return coverer.Covering(polyline), nil
+}
+
+func newCellsFromCorridor(corridor *routeCorridor, coverer *s2.RegionCoverer) []s2.CellID {
+	if coverer == nil {
+		coverer = &defaultPolylineCoverer
+	}
+	return coverer.Covering(corridor)
+}
+
+func newPolylineFromRoute(path []poi.Coordinates) (*s2.Polyline, error) {
+	if len(path) < 2 {
+		return nil, fmt.Errorf("invalid path: length=%d", len(path))
+	}
+
+	// Validate coordinates before processing
+	for _, p := range path {
+		if !ValidLatLon(p.Latitude, p.Longitude) {
+			return nil, fmt.Errorf(
+				"invalid coordinates for route: lat=%f, lon:=%f",
+				p.Latitude,
+				p.Longitude,
+			)
+		}
+	}
+
+	// Pre-allocate slice with exact capacity
+	latLngs := make([]s2.LatLng, len(path))
+	for i, p := range path {
+		latLngs[i] = s2.LatLngFromDegrees(p.Latitude, p.Longitude)
+	}
+	return s2.PolylineFromLatLngs(latLngs), nil
+}
+
+// The routeCorridor is the region of all points within the given width to a route, i.e. the route buffered
+// by the width. In contrast to the bare polyline, the corridor is the exact search area of a route search.
+type routeCorridor struct {
+	line  *s2.Polyline
+	width s1.ChordAngle
+	bound s2.Cap
+}
+
+func newCorridorFromRoute(path []poi.Coordinates, width float64) (*routeCorridor, error) {
+	if width <= 0 {
+		return nil, fmt.Errorf("invalid corridor width: width=%f", width)
+	}
+	line, err := newPolylineFromRoute(path)
+	if err != nil {
+		return nil, err
+	}
+	angle := s1.Angle(width / earthRadiusMeter)
+	return &routeCorridor{
+		line:  line,
+		width: s1.ChordAngleFromAngle(angle),
+		bound: line.CapBound().Expanded(angle),
+	}, nil
+}
+
+// area approximates the solid angle of the corridor by the length of the route times the width on both sides
+func (c *routeCorridor) area() float64 {
+	length := s1.Angle(0)
+	for i := 0; i < c.line.NumEdges(); i++ {
+		e := c.line.Edge(i)
+		length += e.V0.Distance(e.V1)
+	}
+	return float64(length) * 2 * c.width.Angle().Radians()
+}
+
+func (c *routeCorridor) CapBound() s2.Cap {
+	return c.bound
+}
+
+func (c *routeCorridor) RectBound() s2.Rect {
+	return c.bound.RectBound()
+}
+
+// ContainsCell reports whether the cell is within the width of a single route segment, which is sufficient
+// but not necessary for containment. False negatives only cause the coverer to subdivide the cell.
+func (c *routeCorridor) ContainsCell(cell s2.Cell) bool {
+	for i := 0; i < c.line.NumEdges(); i++ {
+		e := c.line.Edge(i)
+		if cell.MaxDistanceToEdge(e.V0, e.V1) <= c.width {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *routeCorridor) IntersectsCell(cell s2.Cell) bool {
+	for i := 0; i < c.line.NumEdges(); i++ {
+		e := c.line.Edge(i)
+		if cell.DistanceToEdge(e.V0, e.V1) <= c.width {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *routeCorridor) ContainsPoint(p s2.Point) bool {
+	projected, _ := c.line.Project(p)
+	return s2.ChordAngleBetweenPoints(projected, p) <= c.width
+}
+
+func (c *routeCorridor) CellUnionBound() []s2.CellID {
+	return c.bound.CellUnionBound()
+}
+
+func newCellsFromPolygon(polygon *s2.Polygon, coverer *s2.RegionCoverer) []s2.CellID {
+	if coverer == nil {
+		coverer = &defaultPolygonCoverer
+	}
+	return coverer.Covering(polygon)
+}
+
+// newPolygonFromRings creates the exact search area of a polygon search. The first ring is the outer boundary,
+// all further rings are holes which must be inside of the outer boundary and must not overlap each other.
+func newPolygonFromRings(rings [][]poi.Coordinates) (*s2.Polygon, error) {
+	if len(rings) == 0 {
+		return nil, fmt.Errorf("invalid polygon: no rings")
+	}
+	loops := make([]*s2.Loop, len(rings))
+	for i, r := range rings {
+		loop, err := newLoopFromRing(r)
+		if err != nil {
+			return nil, fmt.Errorf("invalid ring %d: %w", i, err)
+		}
+		if i > 0 && !loops[0].Contains(loop) {
+			return nil, fmt.Errorf("invalid ring %d: hole is not inside of the outer ring", i)
+		}
+		for j := 1; j < i; j++ {
+			if loops[j].Intersects(loop) {
+				return nil, fmt.Errorf("invalid ring %d: hole overlaps hole %d", i, j)
+			}
+		}
+		loops[i] = loop
+	}
+	polygon := s2.PolygonFromLoops(loops)
+	if err := polygon.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid polygon: %w", err)
+	}
+	return polygon, nil
+}
+
+// newLoopFromRing creates a loop enclosing at most half of the sphere, hence the orientation of the ring does not
+// matter. The ring may be closed by repeating the first coordinate.
+func newLoopFromRing(ring []poi.Coordinates) (*s2.Loop, error) {
+	if len(ring) > 1 && ring[0] == ring[len(ring)-1] {
+		ring = ring[:len(ring)-1]
+	}
+	if len(ring) < 3 {
+		return nil, fmt.Errorf("invalid ring: length=%d", len(ring))
+	}
+	points := make([]s2.Point, len(ring))
+	for i, c := range ring {
+		if !ValidLatLon(c.Latitude, c.Longitude) {
+			return nil, fmt.Errorf(
+				"invalid coordinates for ring: lat=%f, lon=%f",
+				c.Latitude,
+				c.Longitude,
+			)
+		}
+		points[i] = PointFromCoordinates(c)
+	}
+	loop := s2.LoopFromPoints(points)
+	if err := loop.Validate(); err != nil {
+		return nil, err
+	}
+	loop.Normalize()
+	return loop, nil
+}
+
+// newCellsFromRing covers the cap around the center with the given radius and returns only the cells
+// which are not yet part of the already covered cell union, hence only the "ring" that was added
+// by growing the radius needs to be queried. The returned cell union is the new covered area.
+func newCellsFromRing(
+	c poi.Coordinates,
+	radius float64,
+	covered s2.CellUnion,
+	coverer *s2.RegionCoverer,
+) ([]s2.CellID, s2.CellUnion, error) {
+	if !ValidLatLon(c.Latitude, c.Longitude) {
+		return nil, nil, fmt.Errorf(
+			"invalid search center: lat=%f, lon=%f",
+			c.Latitude,
+			c.Longitude,
+		)
+	}
+	region := newCapFromRadiusCenter(c, radius)
+	if coverer == nil {
+		coverer = &defaultAreaCoverer
+	}
+	covering := coverer.Covering(region)
+	ring := s2.CellUnionFromDifference(covering, covered)
+	return ring, s2.CellUnionFromUnion(covered, covering), nil
+}
+
+// nearestWithinRadius returns at most k locations within the radius around the center sorted ascending
+// by their great-circle distance to the center
+func nearestWithinRadius(
+	locations []*poi.PoILocation,
+	c poi.Coordinates,
+	radius float64,
+	k int,
+) []*poi.PoILocation {
+	type candidate struct {
+		location *poi.PoILocation
+		distance float64
+	}
+	candidates := make([]candidate, 0, len(locations))
+	for _, l := range locations {
+		d := distanceMeters(c, l.Location)
+		if d <= radius {
+			candidates = append(candidates, candidate{location: l, distance: d})
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].distance < candidates[j].distance
+	})
+	nearest := make([]*poi.PoILocation, 0, min(k, len(candidates)))
+	for i := 0; i < len(candidates) && i < k; i++ {
+		nearest = append(nearest, candidates[i].location)
+	}
+	return nearest
+}
+
+// countWithinRadius counts the locations with a great-circle distance to the center less or equal to the radius
+func countWithinRadius(locations []*poi.PoILocation, c poi.Coordinates, radius float64) int {
+	count := 0
+	for _, l := range locations {
+		if distanceMeters(c, l.Location) <= radius {
+			count++
+		}
+	}
+	return count
+}
+
+// distanceMeters calculates the great-circle distance between two coordinates
+func distanceMeters(a, b poi.Coordinates) float64 {
+	la := s2.LatLngFromDegrees(a.Latitude, a.Longitude)
+	lb := s2.LatLngFromDegrees(b.Latitude, b.Longitude)
+	return la.Distance(lb).Radians() * earthRadiusMeter
+}
+
+// newCapFromRadiusCenter creates the exact search area of a proximity search
+func newCapFromRadiusCenter(c poi.Coordinates, radius float64) s2.Cap {
+	angle := s1.Angle(radius / earthRadiusMeter)
+	return s2.CapFromCenterAngle(PointFromCoordinates(c), angle)
+}
+
+// newRectFromBbox creates the exact search area of a bbox search. The longitudes span the shorter way between the
+// corners, which also crosses the antimeridian. Bboxes spanning at least 180 degrees from the west to the east
+// corner, e.g. the map tiles of zoom 0 and 1, span from the west to the east corner and bboxes spanning 360 degrees
+// span all longitudes, the shorter way would collapse them to a line or select the opposite side of the world.
+func newRectFromBbox(ne, sw poi.Coordinates) s2.Rect {
+	west := s2.LatLngFromDegrees(sw.Latitude, sw.Longitude)
+	east := s2.LatLngFromDegrees(ne.Latitude, ne.Longitude)
+	lat := r1.IntervalFromPoint(west.Lat.Radians()).AddPoint(east.Lat.Radians())
+	var lng s1.Interval
+	switch span := ne.Longitude - sw.Longitude; {
+	case span >= 360:
+		lng = s1.FullInterval()
+	case span >= 180:
+		lng = s1.IntervalFromEndpoints(west.Lng.Radians(), east.Lng.Radians())
+	default:
+		lng = s1.IntervalFromPointPair(west.Lng.Radians(), east.Lng.Radians())
+	}
+	return s2.Rect{Lat: lat, Lng: lng}
+}
+
+// filterContained removes all locations which are not contained by the region and returns the number of removed locations
+func filterContained(locations []*poi.PoILocation, region s2.Region) ([]*poi.PoILocation, int) {
+	contained := make([]*poi.PoILocation, 0, len(locations))
+	for _, l := range locations {
+		if region.ContainsPoint(PointFromCoordinates(l.Location)) {
+			contained = append(contained, l)
+		}
+	}
+	return contained, len(locations) - len(contained)
+}
+
+// Fingerprint identifies the cells queried for a search and the options applied to the locations found,
+// except for the page, so that a page token can only continue the search it has been issued for
+func Fingerprint(cells []s2.CellID, options *poi.SearchOptions) uint64 {
+	h := fnv.New64a()
+	buf := make([]byte, 8)
+	writeUint := func(v uint64) {
+		binary.BigEndian.PutUint64(buf, v)
+		_, _ = h.Write(buf)
+	}
+	writeStrings := func(values []string) {
+		writeUint(uint64(len(values)))
+		for _, v := range values {
+			writeUint(uint64(len(v)))
+			_, _ = h.Write([]byte(v))
+		}
+	}
+	writeUint(uint64(len(cells)))
+	for _, v := range cells {
+		writeUint(uint64(v))
+	}
+	if options.Strict {
+		writeUint(1)
+	} else {
+		writeUint(0)
+	}
+	writeUint(uint64(options.SortBy)) //nolint:gosec // sort orders are not negative
+	writeUint(math.Float64bits(options.CorridorMeters))
+	if f := options.Filter; !f.Empty() {
+		writeStrings(f.Features)
+		writeUint(math.Float64bits(f.MinPowerKW))
+		writeUint(uint64(len(f.Currents)))
+		for _, c := range f.Currents {
+			writeUint(uint64(c)) //nolint:gosec // currents are not negative
+		}
+		writeStrings(f.PlugTypes)
+		writeStrings(f.CountryCodes)
+	}
+	return h.Sum64()
+}
+
+func PointFromCoordinates(c poi.Coordinates) s2.Point {
+	return s2.PointFromLatLng(s2.LatLngFromDegrees(c.Latitude, c.Longitude))
+}
+
+func ValidLatLon(lat, lon float64) bool {
+	return lat >= minLatitude && lat <= maxLatitude &&
+		lon >= minLongitude && lon <= maxLongitude
+}
@@ -0,0 +1,544 @@
+package geo
+
+import (
+	"math"
+	"slices"
+
+	"github.com/golang/geo/s2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/zap"
+
+	"github.com/grntlrduck-cloud/go-grpc-geohasing-service-sample/internal/domain/poi"
+)
+
+var _ = Describe("given coordinates", func() {
+	When("ring is covered around center", func() {
+		cntr := poi.Coordinates{Latitude: 49.333418, Longitude: 9.147263}
+
+		It("returns all cells of the initial covering", func() {
+			hashes, covered, err := newCellsFromRing(cntr, 2_000.0, nil, nil)
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(hashes).To(Not(BeEmpty()))
+			Expect(len(hashes)).To(Equal(len(covered)))
+		})
+
+		It("returns only cells not covered by the previous ring", func() {
+			_, covered, err := newCellsFromRing(cntr, 2_000.0, nil, nil)
+			Expect(err).To(Not(HaveOccurred()))
+			hashes, _, err := newCellsFromRing(cntr, 64_000.0, covered, nil)
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(hashes).To(Not(BeEmpty()))
+			for _, h := range hashes {
+				Expect(covered.IntersectsCellID(h)).To(BeFalse())
+			}
+		})
+
+		It("returns no cells when radius did not grow", func() {
+			_, covered, err := newCellsFromRing(cntr, 8_000.0, nil, nil)
+			Expect(err).To(Not(HaveOccurred()))
+			hashes, _, err := newCellsFromRing(cntr, 8_000.0, covered, nil)
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(hashes).To(BeEmpty())
+		})
+
+		It("returns error for invalid center", func() {
+			_, _, err := newCellsFromRing(poi.Coordinates{Latitude: 900.0}, 2_000.0, s2.CellUnion{}, nil)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	When("polygon is created from rings", func() {
+		shell := []poi.Coordinates{
+			{Latitude: 49.0, Longitude: 9.0},
+			{Latitude: 49.0, Longitude: 10.0},
+			{Latitude: 50.0, Longitude: 10.0},
+			{Latitude: 50.0, Longitude: 9.0},
+		}
+		hole := []poi.Coordinates{
+			{Latitude: 49.4, Longitude: 9.4},
+			{Latitude: 49.4, Longitude: 9.6},
+			{Latitude: 49.6, Longitude: 9.6},
+			{Latitude: 49.6, Longitude: 9.4},
+		}
+
+		It("contains points inside of the outer ring but not inside of holes", func() {
+			polygon, err := newPolygonFromRings([][]poi.Coordinates{shell, hole})
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(polygon.ContainsPoint(PointFromCoordinates(poi.Coordinates{Latitude: 49.2, Longitude: 9.2}))).To(BeTrue())
+			Expect(polygon.ContainsPoint(PointFromCoordinates(poi.Coordinates{Latitude: 49.5, Longitude: 9.5}))).To(BeFalse())
+			Expect(polygon.ContainsPoint(PointFromCoordinates(poi.Coordinates{Latitude: 50.5, Longitude: 9.5}))).To(BeFalse())
+		})
+
+		It("ignores orientation and closing coordinate of rings", func() {
+			reversed := []poi.Coordinates{shell[0], shell[3], shell[2], shell[1], shell[0]}
+			polygon, err := newPolygonFromRings([][]poi.Coordinates{reversed})
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(polygon.ContainsPoint(PointFromCoordinates(poi.Coordinates{Latitude: 49.5, Longitude: 9.5}))).To(BeTrue())
+			Expect(newCellsFromPolygon(polygon, nil)).To(Not(BeEmpty()))
+		})
+
+		It("returns error for hole outside of outer ring", func() {
+			outside := []poi.Coordinates{
+				{Latitude: 51.0, Longitude: 9.0},
+				{Latitude: 51.0, Longitude: 9.2},
+				{Latitude: 51.2, Longitude: 9.2},
+			}
+			_, err := newPolygonFromRings([][]poi.Coordinates{shell, outside})
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("returns error for ring with less than three coordinates", func() {
+			_, err := newPolygonFromRings([][]poi.Coordinates{shell[:2]})
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("returns error for invalid coordinates", func() {
+			invalid := []poi.Coordinates{shell[0], shell[1], {Latitude: 900.0, Longitude: 9.0}}
+			_, err := newPolygonFromRings([][]poi.Coordinates{invalid})
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	When("corridor is created from route", func() {
+		route := []poi.Coordinates{
+			{Latitude: 49.0, Longitude: 9.0},
+			{Latitude: 49.0, Longitude: 10.0},
+		}
+
+		It("contains points within the width but not further away", func() {
+			corridor, err := newCorridorFromRoute(route, 2000.0)
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(corridor.ContainsPoint(PointFromCoordinates(poi.Coordinates{Latitude: 49.01, Longitude: 9.5}))).To(BeTrue())
+			Expect(corridor.ContainsPoint(PointFromCoordinates(poi.Coordinates{Latitude: 49.05, Longitude: 9.5}))).To(BeFalse())
+			Expect(corridor.ContainsPoint(PointFromCoordinates(poi.Coordinates{Latitude: 49.0, Longitude: 10.05}))).To(BeFalse())
+		})
+
+		It("is covered by cells including the cells of points within the width", func() {
+			corridor, err := newCorridorFromRoute(route, 2000.0)
+			Expect(err).To(Not(HaveOccurred()))
+			hashes := newCellsFromCorridor(corridor, nil)
+			Expect(hashes).To(Not(BeEmpty()))
+			cell := s2.CellIDFromLatLng(s2.LatLngFromDegrees(49.015, 9.5))
+			covered := false
+			for _, h := range hashes {
+				if h.Contains(cell) {
+					covered = true
+				}
+			}
+			Expect(covered).To(BeTrue())
+		})
+
+		It("returns error for non positive width", func() {
+			_, err := newCorridorFromRoute(route, 0)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("returns error for invalid coordinates", func() {
+			_, err := newCorridorFromRoute([]poi.Coordinates{route[0], {Latitude: 900.0, Longitude: 9.0}}, 2000.0)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	When("covering exceeds the limit", func() {
+		route := []poi.Coordinates{
+			{Latitude: 54.32, Longitude: 10.13},
+			{Latitude: 53.55, Longitude: 9.99},
+			{Latitude: 50.11, Longitude: 8.68},
+			{Latitude: 48.14, Longitude: 11.58},
+		}
+		start := PointFromCoordinates(route[0])
+		cover := func(coverer *s2.RegionCoverer) []s2.CellID {
+			cells, err := newCellsFromRoute(route, coverer)
+			Expect(err).To(Not(HaveOccurred()))
+			return cells
+		}
+
+		It("is coarsened until it is within the limit", func() {
+			cells := cover(nil)
+			Expect(len(cells)).To(BeNumerically(">", 60))
+			adapted, fraction, err := adaptCovering(cells, cover, defaultPolylineCoverer, 60, start, zap.NewNop())
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(len(adapted)).To(BeNumerically("<=", 60))
+			Expect(fraction).To(Equal(1.0))
+			for _, c := range adapted {
+				Expect(c.Level()).To(BeNumerically(">=", defaultPolylineCoverer.MinLevel))
+			}
+		})
+
+		It("is truncated to the cells closest to the reference if coarsening is not sufficient", func() {
+			cells := cover(nil)
+			adapted, fraction, err := adaptCovering(cells, cover, defaultPolylineCoverer, 30, start, zap.NewNop())
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(adapted).To(HaveLen(30))
+			Expect(slices.IsSorted(adapted)).To(BeTrue())
+			Expect(fraction).To(And(BeNumerically(">=", minCoveredFraction), BeNumerically("<", 1)))
+			union := s2.CellUnion(adapted)
+			Expect(union.ContainsPoint(start)).To(BeTrue())
+			Expect(union.ContainsPoint(PointFromCoordinates(route[3]))).To(BeFalse())
+		})
+
+		It("is rejected if the truncated covering misses most of the search area", func() {
+			cells := cover(nil)
+			_, _, err := adaptCovering(cells, cover, defaultPolylineCoverer, 10, start, zap.NewNop())
+			Expect(err).To(MatchError(poi.ErrTooLargeSearchArea))
+		})
+
+		It("is kept if it is within the limit", func() {
+			cells := cover(nil)
+			adapted, fraction, err := adaptCovering(cells, cover, defaultPolylineCoverer, len(cells), start, zap.NewNop())
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(adapted).To(Equal(cells))
+			Expect(fraction).To(Equal(1.0))
+		})
+	})
+
+	When("covering of search area exceeds the max cells of the coverer", func() {
+		cntr := poi.Coordinates{Latitude: 49.333418, Longitude: 9.147263}
+		cover := func(radius float64) func(coverer *s2.RegionCoverer) []s2.CellID {
+			return func(coverer *s2.RegionCoverer) []s2.CellID {
+				cells, err := newCellsFromRadiusCenter(cntr, radius, coverer)
+				Expect(err).To(Not(HaveOccurred()))
+				return cells
+			}
+		}
+
+		It("is covered with cells coarser than the min level of the coverer", func() {
+			cells := cover(100_000.0)(nil)
+			Expect(len(cells)).To(BeNumerically(">", defaultAreaCoverer.MaxCells))
+			adapted, fraction, err := adaptCovering(
+				cells, cover(100_000.0), defaultAreaCoverer, proxCellsLimit, PointFromCoordinates(cntr), zap.NewNop(),
+			)
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(len(adapted)).To(BeNumerically("<=", defaultAreaCoverer.MaxCells))
+			Expect(fraction).To(Equal(1.0))
+			Expect(atLevel(adapted, defaultAreaCoverer.MinLevel)).To(BeFalse())
+			for _, c := range adapted {
+				Expect(c.Level()).To(BeNumerically(">=", coarseMinLevel))
+			}
+		})
+
+		It("is kept for small search area", func() {
+			cells := cover(1_000.0)(nil)
+			adapted, fraction, err := adaptCovering(
+				cells, cover(1_000.0), defaultAreaCoverer, proxCellsLimit, PointFromCoordinates(cntr), zap.NewNop(),
+			)
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(adapted).To(Equal(cells))
+			Expect(fraction).To(Equal(1.0))
+		})
+	})
+
+	When("search area is continent-sized", func() {
+		It("is rejected before it is covered", func() {
+			sw := poi.Coordinates{Latitude: 35.0, Longitude: -10.0}
+			ne := poi.Coordinates{Latitude: 70.0, Longitude: 40.0}
+			_, err := CoverBbox(sw, ne, zap.NewNop())
+			Expect(err).To(MatchError(poi.ErrTooLargeSearchArea))
+			_, err = CoverProximity(poi.Coordinates{Latitude: 50.0, Longitude: 10.0}, 2_000_000.0, zap.NewNop())
+			Expect(err).To(MatchError(poi.ErrTooLargeSearchArea))
+		})
+
+		It("covers country-sized search areas completely", func() {
+			sw := poi.Coordinates{Latitude: 47.27, Longitude: 5.87}
+			ne := poi.Coordinates{Latitude: 55.06, Longitude: 15.04}
+			covering, err := CoverBbox(sw, ne, zap.NewNop())
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(covering.Truncated()).To(BeFalse())
+		})
+	})
+
+	When("nearest locations are selected", func() {
+		cntr := poi.Coordinates{Latitude: 49.0, Longitude: 9.0}
+		far := &poi.PoILocation{Location: poi.Coordinates{Latitude: 49.2, Longitude: 9.0}}
+		near := &poi.PoILocation{Location: poi.Coordinates{Latitude: 49.01, Longitude: 9.0}}
+		mid := &poi.PoILocation{Location: poi.Coordinates{Latitude: 49.05, Longitude: 9.0}}
+		locations := []*poi.PoILocation{far, near, mid}
+
+		It("sorts by distance and limits to k", func() {
+			actual := nearestWithinRadius(locations, cntr, 50_000.0, 2)
+			Expect(actual).To(Equal([]*poi.PoILocation{near, mid}))
+		})
+
+		It("excludes locations outside the radius", func() {
+			actual := nearestWithinRadius(locations, cntr, 10_000.0, 5)
+			Expect(actual).To(Equal([]*poi.PoILocation{near, mid}))
+			Expect(countWithinRadius(locations, cntr, 10_000.0)).To(Equal(2))
+		})
+	})
+
+	When("locations are filtered by exact search area", func() {
+		inside := &poi.PoILocation{Location: poi.Coordinates{Latitude: 49.5, Longitude: 9.5}}
+		outside := &poi.PoILocation{Location: poi.Coordinates{Latitude: 50.5, Longitude: 9.5}}
+		locations := []*poi.PoILocation{inside, outside}
+
+		It("discards locations outside of bbox", func() {
+			sw := poi.Coordinates{Latitude: 49.0, Longitude: 9.0}
+			ne := poi.Coordinates{Latitude: 50.0, Longitude: 10.0}
+			actual, discarded := filterContained(locations, newRectFromBbox(ne, sw))
+			Expect(actual).To(Equal([]*poi.PoILocation{inside}))
+			Expect(discarded).To(Equal(1))
+		})
+
+		It("keeps locations of all longitudes within bbox spanning the world", func() {
+			sw := poi.Coordinates{Latitude: -85.0, Longitude: -180.0}
+			ne := poi.Coordinates{Latitude: 85.0, Longitude: 180.0}
+			west := &poi.PoILocation{Location: poi.Coordinates{Latitude: 40.7, Longitude: -74.0}}
+			east := &poi.PoILocation{Location: poi.Coordinates{Latitude: 35.7, Longitude: 139.7}}
+			actual, discarded := filterContained([]*poi.PoILocation{inside, west, east}, newRectFromBbox(ne, sw))
+			Expect(actual).To(HaveLen(3))
+			Expect(discarded).To(BeZero())
+		})
+
+		It("spans from the west to the east corner for bbox spanning half of the world", func() {
+			sw := poi.Coordinates{Latitude: 0.0, Longitude: 0.0}
+			ne := poi.Coordinates{Latitude: 85.0, Longitude: 180.0}
+			west := &poi.PoILocation{Location: poi.Coordinates{Latitude: 40.7, Longitude: -74.0}}
+			actual, discarded := filterContained([]*poi.PoILocation{inside, west}, newRectFromBbox(ne, sw))
+			Expect(actual).To(Equal([]*poi.PoILocation{inside}))
+			Expect(discarded).To(Equal(1))
+		})
+
+		It("spans the shorter way for bbox crossing the antimeridian", func() {
+			sw := poi.Coordinates{Latitude: -20.0, Longitude: 170.0}
+			ne := poi.Coordinates{Latitude: -10.0, Longitude: -170.0}
+			fiji := &poi.PoILocation{Location: poi.Coordinates{Latitude: -17.0, Longitude: 179.9}}
+			rect := newRectFromBbox(ne, sw)
+			Expect(rect.ContainsPoint(PointFromCoordinates(fiji.Location))).To(BeTrue())
+			Expect(rect.Lng.Length()).To(BeNumerically("~", 20*math.Pi/180, 1e-9))
+		})
+
+		It("discards locations outside of radius", func() {
+			cntr := poi.Coordinates{Latitude: 49.45, Longitude: 9.5}
+			actual, discarded := filterContained(locations, newCapFromRadiusCenter(cntr, 10_000.0))
+			Expect(actual).To(Equal([]*poi.PoILocation{inside}))
+			Expect(discarded).To(Equal(1))
+		})
+	})
+
+	When("pages of locations are filtered by exact search area", func() {
+		inside := &poi.PoILocation{Location: poi.Coordinates{Latitude: 49.5, Longitude: 9.5}}
+		outside := &poi.PoILocation{Location: poi.Coordinates{Latitude: 50.5, Longitude: 9.5}}
+		sw := poi.Coordinates{Latitude: 49.0, Longitude: 9.0}
+		ne := poi.Coordinates{Latitude: 50.0, Longitude: 10.0}
+		covering := &Covering{Area: newRectFromBbox(ne, sw), Fraction: 1}
+		pages := map[string]struct {
+			locations []*poi.PoILocation
+			next      string
+		}{
+			"":  {[]*poi.PoILocation{outside}, "1"},
+			"1": {[]*poi.PoILocation{outside}, "2"},
+			"2": {[]*poi.PoILocation{inside, outside}, "3"},
+			"3": {[]*poi.PoILocation{outside}, ""},
+		}
+		var queried []string
+		query := func(token string) ([]*poi.PoILocation, string, error) {
+			queried = append(queried, token)
+			return pages[token].locations, pages[token].next, nil
+		}
+
+		BeforeEach(func() {
+			queried = nil
+		})
+
+		It("skips pages without locations in the search area", func() {
+			options := poi.NewSearchOptions(poi.WithStrict(true), poi.WithPage(2, ""))
+			res, err := NewPagedSearchResult(query, covering, options, zap.NewNop())
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(res.Locations).To(Equal([]*poi.PoILocation{inside}))
+			Expect(res.Discarded).To(Equal(3))
+			Expect(res.NextPageToken).To(Equal("3"))
+			Expect(queried).To(Equal([]string{"", "1", "2"}))
+		})
+
+		It("returns empty last page", func() {
+			options := poi.NewSearchOptions(poi.WithStrict(true), poi.WithPage(2, "3"))
+			res, err := NewPagedSearchResult(query, covering, options, zap.NewNop())
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(res.Locations).To(BeEmpty())
+			Expect(res.NextPageToken).To(BeEmpty())
+		})
+
+		It("returns each page without strict filtering", func() {
+			options := poi.NewSearchOptions(poi.WithPage(2, ""))
+			res, err := NewPagedSearchResult(query, covering, options, zap.NewNop())
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(res.Locations).To(Equal([]*poi.PoILocation{outside}))
+			Expect(res.NextPageToken).To(Equal("1"))
+			Expect(queried).To(Equal([]string{""}))
+		})
+	})
+
+	When("locations are clustered", func() {
+		sw := poi.Coordinates{Latitude: 49.0, Longitude: 9.0}
+		ne := poi.Coordinates{Latitude: 50.0, Longitude: 10.0}
+		covering := &Covering{Area: newRectFromBbox(ne, sw), Fraction: 1}
+		locations := []*poi.PoILocation{
+			{Location: poi.Coordinates{Latitude: 49.50, Longitude: 9.50}, Features: []string{"AC", "DC"}},
+			{Location: poi.Coordinates{Latitude: 49.51, Longitude: 9.51}, Features: []string{"DC", "WC"}},
+			{Location: poi.Coordinates{Latitude: 49.52, Longitude: 9.52}, Features: []string{"DC", "WC"}},
+			{Location: poi.Coordinates{Latitude: 49.10, Longitude: 9.10}, Features: []string{"AC"}},
+			{Location: poi.Coordinates{Latitude: 50.50, Longitude: 9.50}, Features: []string{"AC"}},
+		}
+
+		It("aggregates the locations within the search area per cell of the zoom level", func() {
+			res := NewClusterResult(locations, covering, 10, zap.NewNop())
+			Expect(res.Truncated).To(BeFalse())
+			Expect(res.Clusters).To(HaveLen(2))
+			counts := []int{res.Clusters[0].Count, res.Clusters[1].Count}
+			Expect(counts).To(ConsistOf(3, 1))
+			for _, c := range res.Clusters {
+				if c.Count == 3 {
+					Expect(c.Centroid.Latitude).To(BeNumerically("~", 49.51, 0.001))
+					Expect(c.Centroid.Longitude).To(BeNumerically("~", 9.51, 0.001))
+					Expect(c.Features).To(Equal([]string{"DC", "WC", "AC"}))
+				}
+			}
+		})
+
+		It("aggregates all locations into one cluster at low zoom", func() {
+			res := NewClusterResult(locations, covering, 2, zap.NewNop())
+			Expect(res.Clusters).To(HaveLen(1))
+			Expect(res.Clusters[0].Count).To(Equal(4))
+			Expect(res.Clusters[0].Features).To(Equal([]string{"DC", "AC", "WC"}))
+		})
+
+		It("computes the centroid across the antimeridian", func() {
+			res := NewClusterResult([]*poi.PoILocation{
+				{Location: poi.Coordinates{Latitude: -17, Longitude: 179.9}},
+				{Location: poi.Coordinates{Latitude: -17, Longitude: -179.9}},
+			}, &Covering{Fraction: 1}, 0, zap.NewNop())
+			Expect(res.Clusters).To(HaveLen(1))
+			Expect(math.Abs(res.Clusters[0].Centroid.Longitude)).To(BeNumerically("~", 180, 0.001))
+			Expect(res.Clusters[0].Features).To(BeEmpty())
+		})
+
+		It("reports truncated covering", func() {
+			res := NewClusterResult(locations, &Covering{Area: covering.Area, Fraction: 0.5}, 10, zap.NewNop())
+			Expect(res.Truncated).To(BeTrue())
+			Expect(res.CoveredFraction).To(Equal(0.5))
+		})
+	})
+
+	When("clusters are summed up from the density aggregates", func() {
+		sw := poi.Coordinates{Latitude: 49.0, Longitude: 9.0}
+		ne := poi.Coordinates{Latitude: 50.0, Longitude: 10.0}
+		aggregates := make(DensityAggregates)
+		for _, l := range []*poi.PoILocation{
+			{Location: poi.Coordinates{Latitude: 49.50, Longitude: 9.50}},
+			{Location: poi.Coordinates{Latitude: 49.50, Longitude: 9.50}},
+			{Location: poi.Coordinates{Latitude: 49.10, Longitude: 9.10}},
+			{Location: poi.Coordinates{Latitude: 50.50, Longitude: 9.50}},
+		} {
+			aggregates.Replace(nil, l)
+		}
+
+		It("sums up the aggregates within the bbox per cell of the zoom level", func() {
+			covering, err := CoverClusterAggregates(sw, ne, zap.NewNop())
+			Expect(err).To(Not(HaveOccurred()))
+			res := NewClusterResultFromAggregates(aggregates, covering, 2, zap.NewNop())
+			Expect(res.Truncated).To(BeFalse())
+			Expect(res.Clusters).To(HaveLen(1))
+			Expect(res.Clusters[0].Count).To(Equal(3))
+			Expect(res.Clusters[0].Features).To(BeEmpty())
+			// the centroid is weighted by the counts of the aggregates
+			Expect(res.Clusters[0].Centroid.Latitude).To(BeNumerically("~", 49.37, 0.05))
+			Expect(res.Clusters[0].Centroid.Longitude).To(BeNumerically("~", 9.37, 0.05))
+		})
+
+		It("accepts bboxes too large to be searched", func() {
+			sw := poi.Coordinates{Latitude: 35.0, Longitude: -10.0}
+			ne := poi.Coordinates{Latitude: 70.0, Longitude: 40.0}
+			_, err := CoverBbox(sw, ne, zap.NewNop())
+			Expect(err).To(MatchError(poi.ErrTooLargeSearchArea))
+			covering, err := CoverClusterAggregates(sw, ne, zap.NewNop())
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(covering.Truncated()).To(BeFalse())
+		})
+
+		It("returns error for invalid coordinates", func() {
+			_, err := CoverClusterAggregates(poi.Coordinates{Latitude: -91.0}, ne, zap.NewNop())
+			Expect(err).To(MatchError(poi.ErrInvalidSearchCoordinates))
+		})
+
+		It("is used for zoom levels up to the aggregate level", func() {
+			Expect(ClustersFromAggregates(DensityAggregateLevel)).To(BeTrue())
+			Expect(ClustersFromAggregates(DensityAggregateLevel + 1)).To(BeFalse())
+		})
+	})
+
+	When("density of locations is computed", func() {
+		sw := poi.Coordinates{Latitude: 49.0, Longitude: 9.0}
+		ne := poi.Coordinates{Latitude: 50.0, Longitude: 10.0}
+		locations := []*poi.PoILocation{
+			{
+				Location: poi.Coordinates{Latitude: 49.50, Longitude: 9.50},
+				Charging: &poi.ChargingInfo{ChargePoints: 2, MaxPowerKW: 150},
+			},
+			{
+				Location: poi.Coordinates{Latitude: 49.501, Longitude: 9.501},
+				Charging: &poi.ChargingInfo{ChargePoints: 4, MaxPowerKW: 50},
+			},
+			{Location: poi.Coordinates{Latitude: 49.10, Longitude: 9.10}, Features: []string{"AC"}},
+			{Location: poi.Coordinates{Latitude: 55.0, Longitude: 9.50}, Features: []string{"AC"}},
+		}
+		var covering *Covering
+
+		BeforeEach(func() {
+			var err error
+			covering, err = CoverDensityArea(&poi.DensityArea{SW: sw, NE: ne}, zap.NewNop())
+			Expect(err).To(Not(HaveOccurred()))
+		})
+
+		It("counts the locations within the area per cell of the level", func() {
+			res := NewDensityResult(locations, DensityCells(covering, 10), covering, 10, zap.NewNop())
+			Expect(res.Precomputed).To(BeFalse())
+			Expect(res.Truncated).To(BeFalse())
+			Expect(res.Cells).To(HaveLen(2))
+			count := 0
+			for _, c := range res.Cells {
+				Expect(c.Cell.Level()).To(Equal(10))
+				count += c.Count
+				if c.Count == 2 {
+					Expect(c.ChargePoints).To(Equal(6))
+					Expect(c.PowerKW).To(Equal(200.0))
+				}
+			}
+			Expect(count).To(Equal(3))
+			Expect(res.Cells[0].Cell).To(BeNumerically("<", res.Cells[1].Cell))
+		})
+
+		It("sums up the aggregates to the same density as counting the locations", func() {
+			aggregates := make(DensityAggregates)
+			for _, l := range locations {
+				aggregates.Replace(nil, l)
+			}
+			for _, level := range []int{2, 6, DensityAggregateLevel} {
+				cells := DensityCells(covering, level)
+				counted := NewDensityResult(locations, cells, covering, level, zap.NewNop())
+				summed := NewDensityResultFromAggregates(aggregates, cells, covering, level, zap.NewNop())
+				Expect(summed.Precomputed).To(BeTrue())
+				Expect(summed.Cells).To(Equal(counted.Cells))
+			}
+		})
+
+		It("removes the aggregates of replaced and deleted locations", func() {
+			aggregates := make(DensityAggregates)
+			aggregates.Replace(nil, locations[0])
+			aggregates.Replace(nil, locations[1])
+			moved := &poi.PoILocation{Location: locations[2].Location, Charging: locations[0].Charging}
+			aggregates.Replace(locations[0], moved)
+			aggregates.Replace(locations[1], nil)
+			Expect(aggregates).To(Equal(DensityAggregates{
+				DensityAggregateCell(moved.Location): poi.NewDensityCount(moved),
+			}))
+		})
+
+		It("reports truncated covering", func() {
+			truncated := &Covering{Cells: covering.Cells, Area: covering.Area, Fraction: 0.5}
+			res := NewDensityResult(locations, DensityCells(truncated, 10), truncated, 10, zap.NewNop())
+			Expect(res.Truncated).To(BeTrue())
+			Expect(res.CoveredFraction).To(Equal(0.5))
+		})
+	})
+})
@@ -0,0 +1,274 @@
+package geo
+
+import (
+	"context"
+
+	"github.com/golang/geo/s2"
+	"go.uber.org/zap"
+
+	"github.com/grntlrduck-cloud/go-grpc-geohasing-service-sample/internal/domain/poi"
+)
+
+const (
+	routeCellsLimit      = 200
+	bboxCellsLimit       = 150
+	proxCellsLimit       = 150
+	polygonCellsLimit    = 150
+	nearestInitialRadius = 2_000.0 // 2 km, grows by nearestRadiusGrowth until k locations are found
+	nearestRadiusGrowth  = 2.0
+)
+
+// A Covering holds the cells queried for a search and the exact search area, Area is nil if the cells are the
+// search area. If the search area can not be covered within the limit of the search, the covering is truncated to
+// the cells closest to the search reference and Fraction is the share of the covering which is searched,
+// otherwise Fraction is 1. Search areas of which less than minCoveredFraction can be searched are rejected with
+// poi.ErrTooLargeSearchArea.
+type Covering struct {
+	Cells    []s2.CellID
+	Area     s2.Region
+	Fraction float64
+}
+
+// Truncated reports whether only a part of the search area is searched
+func (c *Covering) Truncated() bool {
+	return c.Fraction < 1
+}
+
+// CoverProximity creates the covering for a proximity search and validates the search area. If the covering exceeds
+// the cells we can query without major performance cuts, it is coarsened or truncated around the center.
+func CoverProximity(cntr poi.Coordinates, radius float64, logger *zap.Logger) (*Covering, error) {
+	if !ValidLatLon(cntr.Latitude, cntr.Longitude) {
+		return nil, poi.ErrInvalidSearchCoordinates
+	}
+	area := newCapFromRadiusCenter(cntr, radius)
+	if err := checkSearchArea(area.Area(), proxCellsLimit, logger); err != nil {
+		return nil, err
+	}
+	cells, _ := newCellsFromRadiusCenter(cntr, radius, nil) // the center is valid
+	cells, fraction, err := adaptCovering(cells, func(coverer *s2.RegionCoverer) []s2.CellID {
+		cells, _ := newCellsFromRadiusCenter(cntr, radius, coverer)
+		return cells
+	}, defaultAreaCoverer, proxCellsLimit, PointFromCoordinates(cntr), logger)
+	if err != nil {
+		return nil, err
+	}
+	return &Covering{Cells: cells, Area: area, Fraction: fraction}, nil
+}
+
+// CoverBbox creates the covering for a bbox search and validates the search area. If the covering exceeds
+// the cells we can query without major performance cuts, it is coarsened or truncated around the center of the bbox.
+func CoverBbox(sw, ne poi.Coordinates, logger *zap.Logger) (*Covering, error) {
+	if err := checkBbox(sw, ne, logger); err != nil {
+		return nil, err
+	}
+	rect := newRectFromBbox(ne, sw)
+	if err := checkSearchArea(rect.Area(), bboxCellsLimit, logger); err != nil {
+		return nil, err
+	}
+	cells, _ := newCellsFromBbox(ne, sw, nil) // the corners are valid
+	cells, fraction, err := adaptCovering(cells, func(coverer *s2.RegionCoverer) []s2.CellID {
+		cells, _ := newCellsFromBbox(ne, sw, coverer)
+		return cells
+	}, defaultAreaCoverer, bboxCellsLimit, s2.PointFromLatLng(rect.Center()), logger)
+	if err != nil {
+		return nil, err
+	}
+	return &Covering{Cells: cells, Area: rect, Fraction: fraction}, nil
+}
+
+// CoverRoute creates the covering for a route search and validates the path.
+// If a corridor width is given the route is buffered by the width and the corridor is the exact search area,
+// otherwise the cells touched by the bare route are covered and the search area is nil.
+// If the covering exceeds the cells we can query without major performance cuts, it is coarsened or truncated
+// to the beginning of the route.
+func CoverRoute(path []poi.Coordinates, width float64, logger *zap.Logger) (*Covering, error) {
+	var (
+		cells []s2.CellID
+		area  s2.Region
+		cover func(coverer *s2.RegionCoverer) []s2.CellID
+		err   error
+	)
+	if width > 0 {
+		var corridor *routeCorridor
+		corridor, err = newCorridorFromRoute(path, width)
+		if err == nil {
+			if err := checkSearchArea(corridor.area(), routeCellsLimit, logger); err != nil {
+				return nil, err
+			}
+			cells = newCellsFromCorridor(corridor, nil)
+			area = corridor
+			cover = func(coverer *s2.RegionCoverer) []s2.CellID {
+				return newCellsFromCorridor(corridor, coverer)
+			}
+		}
+	} else {
+		cells, err = newCellsFromRoute(path, nil)
+		cover = func(coverer *s2.RegionCoverer) []s2.CellID {
+			cells, _ := newCellsFromRoute(path, coverer) // the path is valid
+			return cells
+		}
+	}
+	if err != nil {
+		logger.Warn("invalid coordinates in provided coordinate path",
+			zap.Error(err),
+		)
+		return nil, poi.ErrInvalidSearchCoordinates
+	}
+	cells, fraction, err := adaptCovering(cells, cover, defaultPolylineCoverer, routeCellsLimit, PointFromCoordinates(path[0]), logger)
+	if err != nil {
+		return nil, err
+	}
+	return &Covering{Cells: cells, Area: area, Fraction: fraction}, nil
+}
+
+// CoverPolygon creates the covering for a polygon search with the polygon as exact search area and validates
+// the rings. If the covering exceeds the cells we can query without major performance cuts, it is coarsened or
+// truncated around the center of the polygon.
+func CoverPolygon(rings [][]poi.Coordinates, logger *zap.Logger) (*Covering, error) {
+	polygon, err := newPolygonFromRings(rings)
+	if err != nil {
+		logger.Warn("invalid rings for polygon",
+			zap.Error(err),
+		)
+		return nil, poi.ErrInvalidSearchCoordinates
+	}
+	if err := checkSearchArea(polygon.Area(), polygonCellsLimit, logger); err != nil {
+		return nil, err
+	}
+	cells, fraction, err := adaptCovering(newCellsFromPolygon(polygon, nil), func(coverer *s2.RegionCoverer) []s2.CellID {
+		return newCellsFromPolygon(polygon, coverer)
+	}, defaultPolygonCoverer, polygonCellsLimit, polygon.CapBound().Center(), logger)
+	if err != nil {
+		return nil, err
+	}
+	return &Covering{Cells: cells, Area: polygon, Fraction: fraction}, nil
+}
+
+// NewSearchResult applies the search options to the locations queried for the covering of the search area
+func NewSearchResult(
+	locations []*poi.PoILocation,
+	covering *Covering,
+	options *poi.SearchOptions,
+	logger *zap.Logger,
+) *poi.SearchResult {
+	res := &poi.SearchResult{Locations: locations}
+	if covering.Truncated() {
+		res.Truncated = true
+		res.CoveredFraction = covering.Fraction
+	}
+	if !options.Strict || covering.Area == nil {
+		return res
+	}
+	contained, discarded := filterContained(locations, covering.Area)
+	logger.Debug("filtered locations outside of search area",
+		zap.Int("num_locations", len(contained)),
+		zap.Int("num_discarded", discarded),
+	)
+	res.Locations = contained
+	res.Discarded = discarded
+	return res
+}
+
+// A PageQuery returns the locations of the page continuing at the page token, which is empty for the first page,
+// and the token of the next page, which is empty if there are no more locations
+type PageQuery func(token string) ([]*poi.PoILocation, string, error)
+
+// NewPagedSearchResult applies the search options to the locations of the page requested by the options.
+// Strict filtering may discard all locations of a page, in that case the following pages are queried until
+// a page has locations or there are no more pages, so that only the last page of a search can be empty.
+func NewPagedSearchResult(
+	query PageQuery,
+	covering *Covering,
+	options *poi.SearchOptions,
+	logger *zap.Logger,
+) (*poi.SearchResult, error) {
+	token := options.PageToken
+	discarded := 0
+	for {
+		locations, next, err := query(token)
+		if err != nil {
+			return nil, err
+		}
+		res := NewSearchResult(locations, covering, options, logger)
+		discarded += res.Discarded
+		if len(res.Locations) > 0 || next == "" {
+			res.Discarded = discarded
+			res.NextPageToken = next
+			return res, nil
+		}
+		logger.Debug("skipping page without locations in search area",
+			zap.Int("num_discarded", res.Discarded),
+		)
+		token = next
+	}
+}
+
+// A CellRepository returns all locations within the cells of a covering created by Cover*, regardless of the search
+// the covering has been created for. The cells of a covering do not overlap, hence no location is returned twice.
+type CellRepository interface {
+	GetByCells(ctx context.Context, cells []s2.CellID, logger *zap.Logger) ([]*poi.PoILocation, error)
+}
+
+// A CellQuery returns the locations within the cells which possibly match the filter
+type CellQuery func(cells []s2.CellID) ([]*poi.PoILocation, error)
+
+// Nearest returns at most k locations matching the filter within the max radius around the center sorted ascending
+// by their distance. The searched area is expanded ring by ring and only cells which have not been queried yet
+// are queried, until k locations are found within the current radius or the max radius is reached.
+func Nearest(
+	cntr poi.Coordinates,
+	k int,
+	maxRadius float64,
+	filter *poi.SearchFilter,
+	query CellQuery,
+	logger *zap.Logger,
+) ([]*poi.PoILocation, error) {
+	var covered s2.CellUnion
+	candidates := make([]*poi.PoILocation, 0, k)
+	radius := min(nearestInitialRadius, maxRadius)
+	for {
+		cells, cov, err := newCellsFromRing(cntr, radius, covered, nil)
+		if err != nil {
+			logger.Warn("invalid coordinates for nearest search",
+				zap.Error(err),
+			)
+			return nil, poi.ErrInvalidSearchCoordinates
+		}
+		// google s2 does not guarantee that the set MaxCells can be fulfilled
+		// an arbitrary large list of cells might be returned
+		if len(cells) > proxCellsLimit {
+			logger.Warn("too many hashes calculated for nearest ring",
+				zap.Int("num_hashes", len(cells)),
+				zap.Float64("radius_meters", radius),
+			)
+			return nil, poi.ErrTooLargeSearchArea
+		}
+		covered = cov
+		if len(cells) > 0 {
+			res, errQ := query(cells)
+			if errQ != nil {
+				logger.Error("failed to query nearest ring",
+					zap.Error(errQ),
+				)
+				return nil, poi.ErrDBQuery
+			}
+			// only matching locations count towards k, not all of the filter might be evaluated by the query
+			for _, l := range res {
+				if filter.Matches(l) {
+					candidates = append(candidates, l)
+				}
+			}
+		}
+		// only locations within the radius are guaranteed to be complete, locations outside
+		// might be further away than locations in cells not yet queried
+		if countWithinRadius(candidates, cntr, radius) >= k || radius >= maxRadius {
+			break
+		}
+		radius = min(radius*nearestRadiusGrowth, maxRadius)
+	}
+	logger.Debug("finished nearest search",
+		zap.Float64("radius_meters", radius),
+		zap.Int("num_candidates", len(candidates)),
+	)
+	return nearestWithinRadius(candidates, cntr, radius, k), nil
+}
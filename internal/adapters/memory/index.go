@@ -0,0 +1,72 @@
+package memory
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/golang/geo/s2"
+	"github.com/segmentio/ksuid"
+
+	"github.com/grntlrduck-cloud/go-grpc-geohasing-service-sample/internal/domain/poi"
+)
+
+// An indexEntry is the position of a location in the cell index, i.e. the leaf cell of the location and its id
+type indexEntry struct {
+	cell s2.CellID
+	id   ksuid.KSUID
+}
+
+func newIndexEntry(l *poi.PoILocation) indexEntry {
+	return indexEntry{
+		cell: s2.CellIDFromLatLng(s2.LatLngFromDegrees(l.Location.Latitude, l.Location.Longitude)),
+		id:   l.ID,
+	}
+}
+
+func (e indexEntry) compare(o indexEntry) int {
+	if c := cmp.Compare(e.cell, o.cell); c != 0 {
+		return c
+	}
+	return ksuid.Compare(e.id, o.id)
+}
+
+// The cellIndex holds the entries sorted by their leaf cell and id. Since the leaf cells of a cell at any level
+// form a contiguous range of cell ids, all locations within a cell of a covering are found by a single range scan,
+// the in-process equivalent of the range key condition on the geo index of the DynamoDB table.
+type cellIndex struct {
+	entries []indexEntry
+}
+
+func (x *cellIndex) insert(e indexEntry) {
+	i, found := slices.BinarySearchFunc(x.entries, e, indexEntry.compare)
+	if found {
+		return
+	}
+	x.entries = slices.Insert(x.entries, i, e)
+}
+
+func (x *cellIndex) remove(e indexEntry) {
+	i, found := slices.BinarySearchFunc(x.entries, e, indexEntry.compare)
+	if !found {
+		return
+	}
+	x.entries = slices.Delete(x.entries, i, i+1)
+}
+
+// scan visits the entries within the cell in order, starting after the given entry if any, until visit returns false
+func (x *cellIndex) scan(cell s2.CellID, after *indexEntry, visit func(e indexEntry) bool) {
+	i, _ := slices.BinarySearchFunc(x.entries, indexEntry{cell: cell.RangeMin()}, indexEntry.compare)
+	if after != nil {
+		j, found := slices.BinarySearchFunc(x.entries, *after, indexEntry.compare)
+		if found {
+			j++
+		}
+		i = max(i, j)
+	}
+	last := cell.RangeMax()
+	for ; i < len(x.entries) && x.entries[i].cell <= last; i++ {
+		if !visit(x.entries[i]) {
+			return
+		}
+	}
+}
@@ -0,0 +1,43 @@
+package memory
+
+import (
+	"fmt"
+
+	"github.com/golang/geo/s2"
+	"github.com/segmentio/ksuid"
+)
+
+// The pageToken is the position at which a paged search continues.
+// Cell is the index of the covering cell to scan next and LastID and LastCell are the index entry
+// the previous page ended with within that cell, if any.
+// Covering is a fingerprint of the covering and the search options the token has been issued for,
+// so that a token can not be used to resume a different search.
+// The tokens are signed with a random secret like the tokens of the DynamoDB repository, they are only valid
+// for the lifetime of the process like the index they point into.
+type pageToken struct {
+	Covering uint64 `json:"h"`
+	Cell     int    `json:"c"`
+	LastCell uint64 `json:"l,omitempty"`
+	LastID   string `json:"i,omitempty"`
+}
+
+func newPageToken(covering uint64, cell int, last *indexEntry) *pageToken {
+	t := &pageToken{Covering: covering, Cell: cell}
+	if last != nil {
+		t.LastCell = uint64(last.cell)
+		t.LastID = last.id.String()
+	}
+	return t
+}
+
+// after returns the index entry to continue after, nil if the cell is scanned from its start
+func (t *pageToken) after() (*indexEntry, error) {
+	if t.LastID == "" {
+		return nil, nil
+	}
+	id, err := ksuid.Parse(t.LastID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid last id: %w", err)
+	}
+	return &indexEntry{cell: s2.CellID(t.LastCell), id: id}, nil
+}
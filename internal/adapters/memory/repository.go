@@ -0,0 +1,630 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/golang/geo/s2"
+	"github.com/segmentio/ksuid"
+	"go.uber.org/zap"
+
+	"github.com/grntlrduck-cloud/go-grpc-geohasing-service-sample/internal/adapters/csv"
+	"github.com/grntlrduck-cloud/go-grpc-geohasing-service-sample/internal/adapters/geo"
+	"github.com/grntlrduck-cloud/go-grpc-geohasing-service-sample/internal/adapters/pagetoken"
+	"github.com/grntlrduck-cloud/go-grpc-geohasing-service-sample/internal/domain/poi"
+)
+
+// The PoIRepository keeps the locations in process and indexes them by their S2 leaf cell, so that searches
+// cover the search area with the same cells as the DynamoDB repository and return the same locations.
+// It is meant for local runs and tests which should not depend on DynamoDB.
+type PoIRepository struct {
+	mu           sync.RWMutex
+	locations    map[ksuid.KSUID]*poi.PoILocation
+	index        cellIndex
+	density      geo.DensityAggregates
+	pageTokens   *pagetoken.Codec
+	loadInitData bool
+	initDataPath string
+}
+
+type PoIRepositoryOptions func(p *PoIRepository)
+
+func WithLoadInitData(loadInitData bool) PoIRepositoryOptions {
+	return func(p *PoIRepository) {
+		p.loadInitData = loadInitData
+	}
+}
+
+func WithInitDataOverride(initDataPath string) PoIRepositoryOptions {
+	return func(p *PoIRepository) {
+		p.initDataPath = initDataPath
+	}
+}
+
+func NewPoIRepository(
+	logger *zap.Logger,
+	opts ...PoIRepositoryOptions,
+) (poi.Repository, error) {
+	repo := &PoIRepository{
+		locations:    make(map[ksuid.KSUID]*poi.PoILocation),
+		density:      make(geo.DensityAggregates),
+		initDataPath: csv.TestInitDataPath,
+	}
+	for _, opt := range opts {
+		opt(repo)
+	}
+	codec, err := pagetoken.NewCodec("")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create page token codec: %w", err)
+	}
+	repo.pageTokens = codec
+	if repo.loadInitData {
+		locations, err := csv.LocationsFromFile(repo.initDataPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load test data from csv: %w", err)
+		}
+		if _, err := repo.UpsertBatch(context.Background(), locations, logger); err != nil {
+			return nil, fmt.Errorf("failed to perform batch upsert: %w", err)
+		}
+	}
+	return repo, nil
+}
+
+func (r *PoIRepository) UpsertBatch(
+	ctx context.Context,
+	pois []*poi.PoILocation,
+	logger *zap.Logger,
+) (*poi.BatchWriteReport, error) {
+	// handle context cancelation
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+	report := &poi.BatchWriteReport{Results: make([]poi.BatchWriteResult, len(pois))}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i, v := range pois {
+		report.Results[i].ID = v.ID
+		if err := validCoordinates(v); err != nil {
+			report.Results[i].Err = fmt.Errorf("unable to map location: %w", err)
+			continue
+		}
+		r.put(v)
+	}
+	failed := report.Failed()
+	if len(failed) > 0 {
+		logger.Error("batch upsert incomplete",
+			zap.Error(failed[0].Err),
+			zap.Int("num_failed_items", len(failed)),
+			zap.Int("num_items", len(pois)),
+		)
+		return report, poi.ErrDBBatchUpsert
+	}
+	logger.Info("batch upsert complete",
+		zap.Int("num_items", len(pois)),
+	)
+	return report, nil
+}
+
+func (r *PoIRepository) Upsert(
+	ctx context.Context,
+	domain *poi.PoILocation,
+	_ *zap.Logger,
+) error {
+	// handle context cancelation
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	if err := validCoordinates(domain); err != nil {
+		return fmt.Errorf("unable to upsert location: %w", err)
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.put(domain)
+	return nil
+}
+
+func (r *PoIRepository) Create(
+	ctx context.Context,
+	domain *poi.PoILocation,
+	_ *zap.Logger,
+) error {
+	// handle context cancelation
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	if err := validCoordinates(domain); err != nil {
+		return fmt.Errorf("unable to upsert location: %w", err)
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.locations[domain.ID]; ok {
+		return poi.ErrLocationAlreadyExists
+	}
+	created := *domain
+	created.Version = 1
+	r.put(&created)
+	domain.Version = created.Version
+	return nil
+}
+
+func (r *PoIRepository) Update(
+	ctx context.Context,
+	domain *poi.PoILocation,
+	_ *zap.Logger,
+) error {
+	// handle context cancelation
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	if err := validCoordinates(domain); err != nil {
+		return fmt.Errorf("unable to upsert location: %w", err)
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if err := r.checkVersion(domain.ID, domain.Version); err != nil {
+		return err
+	}
+	updated := *domain
+	updated.Version = domain.Version + 1
+	r.put(&updated)
+	domain.Version = updated.Version
+	return nil
+}
+
+func (r *PoIRepository) Delete(
+	ctx context.Context,
+	id ksuid.KSUID,
+	version int64,
+	_ *zap.Logger,
+) error {
+	// handle context cancelation
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if err := r.checkVersion(id, version); err != nil {
+		return err
+	}
+	r.index.remove(newIndexEntry(r.locations[id]))
+	r.density.Replace(r.locations[id], nil)
+	delete(r.locations, id)
+	return nil
+}
+
+func (r *PoIRepository) GetByID(
+	ctx context.Context,
+	id ksuid.KSUID,
+	_ *zap.Logger,
+) (*poi.PoILocation, error) {
+	// handle context cancelation
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	l, ok := r.locations[id]
+	if !ok {
+		return nil, poi.ErrLocationNotFound
+	}
+	return l.Clone(), nil
+}
+
+func (r *PoIRepository) GetByIDs(
+	ctx context.Context,
+	ids []ksuid.KSUID,
+	_ *zap.Logger,
+) ([]*poi.PoILocation, error) {
+	// handle context cancelation
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	locations := make([]*poi.PoILocation, 0, len(ids))
+	seen := make(map[ksuid.KSUID]bool, len(ids))
+	for _, id := range ids {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		if l, ok := r.locations[id]; ok {
+			locations = append(locations, l.Clone())
+		}
+	}
+	return locations, nil
+}
+
+func (r *PoIRepository) GetByProximity(
+	ctx context.Context,
+	cntr poi.Coordinates,
+	radius float64,
+	logger *zap.Logger,
+	opts ...poi.SearchOption,
+) (*poi.SearchResult, error) {
+	// handle context cancelation
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+	covering, err := geo.CoverProximity(cntr, radius, logger)
+	if err != nil {
+		return nil, err
+	}
+	return r.search(ctx, logger, covering, opts)
+}
+
+func (r *PoIRepository) GetByBbox(
+	ctx context.Context,
+	sw, ne poi.Coordinates,
+	logger *zap.Logger,
+	opts ...poi.SearchOption,
+) (*poi.SearchResult, error) {
+	// handle context cancelation
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+	covering, err := geo.CoverBbox(sw, ne, logger)
+	if err != nil {
+		return nil, err
+	}
+	return r.search(ctx, logger, covering, opts)
+}
+
+func (r *PoIRepository) GetByRoute(
+	ctx context.Context,
+	path []poi.Coordinates,
+	logger *zap.Logger,
+	opts ...poi.SearchOption,
+) (*poi.SearchResult, error) {
+	// handle context cancelation
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+	covering, err := geo.CoverRoute(path, poi.NewSearchOptions(opts...).CorridorMeters, logger)
+	if err != nil {
+		return nil, err
+	}
+	// a corridor describes the exact search area and the locations are always filtered strictly
+	if covering.Area != nil {
+		opts = append(opts, poi.WithStrict(true))
+	}
+	return r.search(ctx, logger, covering, opts)
+}
+
+func (r *PoIRepository) GetByPolygon(
+	ctx context.Context,
+	rings [][]poi.Coordinates,
+	logger *zap.Logger,
+	opts ...poi.SearchOption,
+) (*poi.SearchResult, error) {
+	// handle context cancelation
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+	covering, err := geo.CoverPolygon(rings, logger)
+	if err != nil {
+		return nil, err
+	}
+	// a polygon describes the exact search area, hence the locations are always filtered strictly
+	opts = append(opts, poi.WithStrict(true))
+	return r.search(ctx, logger, covering, opts)
+}
+
+func (r *PoIRepository) StreamByProximity(
+	ctx context.Context,
+	cntr poi.Coordinates,
+	radius float64,
+	logger *zap.Logger,
+	handle poi.SearchResultHandler,
+	opts ...poi.SearchOption,
+) error {
+	// handle context cancelation
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	covering, err := geo.CoverProximity(cntr, radius, logger)
+	if err != nil {
+		return err
+	}
+	return r.stream(ctx, logger, covering, handle, opts)
+}
+
+func (r *PoIRepository) StreamByBbox(
+	ctx context.Context,
+	sw, ne poi.Coordinates,
+	logger *zap.Logger,
+	handle poi.SearchResultHandler,
+	opts ...poi.SearchOption,
+) error {
+	// handle context cancelation
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	covering, err := geo.CoverBbox(sw, ne, logger)
+	if err != nil {
+		return err
+	}
+	return r.stream(ctx, logger, covering, handle, opts)
+}
+
+func (r *PoIRepository) StreamByRoute(
+	ctx context.Context,
+	path []poi.Coordinates,
+	logger *zap.Logger,
+	handle poi.SearchResultHandler,
+	opts ...poi.SearchOption,
+) error {
+	// handle context cancelation
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	covering, err := geo.CoverRoute(path, poi.NewSearchOptions(opts...).CorridorMeters, logger)
+	if err != nil {
+		return err
+	}
+	if covering.Area != nil {
+		opts = append(opts, poi.WithStrict(true))
+	}
+	return r.stream(ctx, logger, covering, handle, opts)
+}
+
+func (r *PoIRepository) GetNearest(
+	ctx context.Context,
+	cntr poi.Coordinates,
+	k int,
+	maxRadius float64,
+	logger *zap.Logger,
+	opts ...poi.SearchOption,
+) ([]*poi.PoILocation, error) {
+	// handle context cancelation
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+	if k <= 0 {
+		return []*poi.PoILocation{}, nil
+	}
+	filter := poi.NewSearchOptions(opts...).Filter
+	return geo.Nearest(cntr, k, maxRadius, filter, func(cells []s2.CellID) ([]*poi.PoILocation, error) {
+		locations := make([]*poi.PoILocation, 0)
+		for _, c := range cells {
+			locations = append(locations, r.scanCell(c, filter)...)
+		}
+		return locations, nil
+	}, logger)
+}
+
+// GetClusters sums up the density aggregates for zoom levels up to the aggregate level, finer zoom levels are
+// clustered from the locations of the cells
+func (r *PoIRepository) GetClusters(
+	ctx context.Context,
+	sw, ne poi.Coordinates,
+	zoom int,
+	logger *zap.Logger,
+) (*poi.ClusterResult, error) {
+	// handle context cancelation
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+	if geo.ClustersFromAggregates(zoom) {
+		covering, err := geo.CoverClusterAggregates(sw, ne, logger)
+		if err != nil {
+			return nil, err
+		}
+		r.mu.RLock()
+		defer r.mu.RUnlock()
+		return geo.NewClusterResultFromAggregates(r.density, covering, zoom, logger), nil
+	}
+	covering, err := geo.CoverBbox(sw, ne, logger)
+	if err != nil {
+		return nil, err
+	}
+	locations := make([]*poi.PoILocation, 0)
+	for _, c := range covering.Cells {
+		locations = append(locations, r.scanCell(c, nil)...)
+		if len(locations) > geo.MaxClusterLocations {
+			return nil, fmt.Errorf("%w: more than %d locations to cluster", poi.ErrTooLargeSearchArea, geo.MaxClusterLocations)
+		}
+	}
+	return geo.NewClusterResult(locations, covering, zoom, logger), nil
+}
+
+// GetDensity sums up the density aggregates for levels up to the aggregate level, finer levels are counted from the
+// locations of the cells
+func (r *PoIRepository) GetDensity(
+	ctx context.Context,
+	area *poi.DensityArea,
+	level int,
+	logger *zap.Logger,
+) (*poi.DensityResult, error) {
+	// handle context cancelation
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+	covering, err := geo.CoverDensityArea(area, logger)
+	if err != nil {
+		return nil, err
+	}
+	cells := geo.DensityCells(covering, level)
+	if level <= geo.DensityAggregateLevel {
+		r.mu.RLock()
+		defer r.mu.RUnlock()
+		return geo.NewDensityResultFromAggregates(r.density, cells, covering, level, logger), nil
+	}
+	locations := make([]*poi.PoILocation, 0)
+	for _, c := range cells {
+		locations = append(locations, r.scanCell(c, nil)...)
+	}
+	return geo.NewDensityResult(locations, cells, covering, level, logger), nil
+}
+
+func (r *PoIRepository) GetByCells(
+	ctx context.Context,
+	cells []s2.CellID,
+	_ *zap.Logger,
+) ([]*poi.PoILocation, error) {
+	// handle context cancelation
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+	locations := make([]*poi.PoILocation, 0)
+	for _, c := range cells {
+		locations = append(locations, r.scanCell(c, nil)...)
+	}
+	return locations, nil
+}
+
+// search scans the cells of the covering either all at once or page by page and applies the search options
+// to the locations found. If area is nil the locations are not filtered strictly.
+func (r *PoIRepository) search(
+	ctx context.Context,
+	logger *zap.Logger,
+	covering *geo.Covering,
+	opts []poi.SearchOption,
+) (*poi.SearchResult, error) {
+	options := poi.NewSearchOptions(opts...)
+	if options.Paged() {
+		return geo.NewPagedSearchResult(func(token string) ([]*poi.PoILocation, string, error) {
+			return r.pagedScan(ctx, logger, covering.Cells, options, token)
+		}, covering, options, logger)
+	}
+	locations := make([]*poi.PoILocation, 0)
+	for _, c := range covering.Cells {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		locations = append(locations, r.scanCell(c, options.Filter)...)
+	}
+	return geo.NewSearchResult(locations, covering, options, logger), nil
+}
+
+// pagedScan scans the cells of the covering in order until the page is full, starting at the position
+// of the page token. It returns the token for the next page, which is empty if all cells have been scanned.
+func (r *PoIRepository) pagedScan(
+	ctx context.Context,
+	logger *zap.Logger,
+	cells []s2.CellID,
+	options *poi.SearchOptions,
+	token string,
+) ([]*poi.PoILocation, string, error) {
+	covering := geo.Fingerprint(cells, options)
+	start := &pageToken{Covering: covering}
+	if token != "" {
+		t := new(pageToken)
+		if err := r.pageTokens.Decode(token, t); err != nil {
+			logger.Warn("failed to decode page token", zap.Error(err))
+			return nil, "", poi.ErrInvalidPageToken
+		}
+		if t.Covering != covering || t.Cell < 0 || t.Cell >= len(cells) {
+			logger.Warn("page token does not match the search",
+				zap.Int("cell", t.Cell),
+				zap.Int("num_cells", len(cells)),
+			)
+			return nil, "", poi.ErrInvalidPageToken
+		}
+		start = t
+	}
+	after, err := start.after()
+	if err != nil {
+		logger.Warn("invalid position in page token", zap.Error(err))
+		return nil, "", poi.ErrInvalidPageToken
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	locations := make([]*poi.PoILocation, 0, options.PageSize)
+	for i := start.Cell; i < len(cells); i++ {
+		if ctx.Err() != nil {
+			return nil, "", ctx.Err()
+		}
+		var last *indexEntry
+		r.index.scan(cells[i], after, func(e indexEntry) bool {
+			if l := r.locations[e.id]; options.Filter.Matches(l) {
+				locations = append(locations, l.Clone())
+			}
+			if len(locations) < options.PageSize {
+				return true
+			}
+			last = &e
+			return false
+		})
+		after = nil
+		if last != nil {
+			// the page is full within the cell, continue in the same cell after the last scanned entry
+			next, err := r.pageTokens.Encode(newPageToken(covering, i, last))
+			return locations, next, err
+		}
+	}
+	return locations, "", nil
+}
+
+// stream scans the cells of the covering and hands the search result of each cell to the handler
+func (r *PoIRepository) stream(
+	ctx context.Context,
+	logger *zap.Logger,
+	covering *geo.Covering,
+	handle poi.SearchResultHandler,
+	opts []poi.SearchOption,
+) error {
+	options := poi.NewSearchOptions(opts...)
+	for _, c := range covering.Cells {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		locations := r.scanCell(c, options.Filter)
+		if len(locations) == 0 {
+			continue
+		}
+		if err := handle(geo.NewSearchResult(locations, covering, options, logger)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// scanCell returns copies of the locations within the cell matching the filter
+func (r *PoIRepository) scanCell(cell s2.CellID, filter *poi.SearchFilter) []*poi.PoILocation {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	locations := make([]*poi.PoILocation, 0)
+	r.index.scan(cell, nil, func(e indexEntry) bool {
+		if l := r.locations[e.id]; filter.Matches(l) {
+			locations = append(locations, l.Clone())
+		}
+		return true
+	})
+	return locations
+}
+
+// put stores a copy of the location, moves its index entry if the location has moved, and updates the density
+// aggregates, requires the write lock
+func (r *PoIRepository) put(domain *poi.PoILocation) {
+	stored, ok := r.locations[domain.ID]
+	if ok {
+		r.index.remove(newIndexEntry(stored))
+	}
+	l := domain.Clone()
+	r.locations[l.ID] = l
+	r.index.insert(newIndexEntry(l))
+	r.density.Replace(stored, l)
+}
+
+// checkVersion verifies that the location exists with the version, any version is accepted for poi.AnyVersion.
+// Requires the lock to be held.
+func (r *PoIRepository) checkVersion(id ksuid.KSUID, version int64) error {
+	stored, ok := r.locations[id]
+	if !ok {
+		return poi.ErrLocationNotFound
+	}
+	if version != poi.AnyVersion && stored.Version != version {
+		return poi.ErrVersionMismatch
+	}
+	return nil
+}
+
+func validCoordinates(l *poi.PoILocation) error {
+	if !geo.ValidLatLon(l.Location.Latitude, l.Location.Longitude) {
+		return fmt.Errorf("invalid coordinates: lat=%f, lon=%f", l.Location.Latitude, l.Location.Longitude)
+	}
+	return nil
+}
@@ -0,0 +1,353 @@
+package memory_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"errors"
+	"strings"
+
+	"github.com/golang/geo/s2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/segmentio/ksuid"
+	"go.uber.org/zap"
+
+	"github.com/grntlrduck-cloud/go-grpc-geohasing-service-sample/internal/adapters/memory"
+	"github.com/grntlrduck-cloud/go-grpc-geohasing-service-sample/internal/domain/poi"
+)
+
+const testDatapath = "../../../config/db/local/cpoi_dynamo_items_int_test.csv"
+
+var _ = Describe("given in-memory repository with test data", Ordered, func() {
+	ctx := context.Background()
+	logger := zap.NewNop()
+	sw := poi.Coordinates{Longitude: 8.494772, Latitude: 49.425026}
+	ne := poi.Coordinates{Longitude: 10.040508, Latitude: 50.089540}
+	cntr := poi.Coordinates{Longitude: 9.147263, Latitude: 49.333418}
+	var repository poi.Repository
+
+	BeforeAll(func() {
+		var err error
+		repository, err = memory.NewPoIRepository(
+			logger,
+			memory.WithLoadInitData(true),
+			memory.WithInitDataOverride(testDatapath),
+		)
+		Expect(err).To(Not(HaveOccurred()))
+	})
+
+	When("location query received", func() {
+		It("get poi by id returns poi location as expected", func() {
+			kID, err := ksuid.Parse("2ofD9igSisfEtgC743gf3BnzO7L")
+			Expect(err).To(Not(HaveOccurred()))
+			location, err := repository.GetByID(ctx, kID, logger)
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(location.ID).To(Equal(kID))
+		})
+
+		It("get pois by ids returns existing locations only", func() {
+			kID, err := ksuid.Parse("2ofD9igSisfEtgC743gf3BnzO7L")
+			Expect(err).To(Not(HaveOccurred()))
+			locations, err := repository.GetByIDs(ctx, []ksuid.KSUID{kID, ksuid.New(), kID}, logger)
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(locations).To(HaveLen(1))
+			Expect(locations[0].ID).To(Equal(kID))
+		})
+
+		It("get location by proximity search in strict mode returns only locations in radius", func() {
+			radiusMeters := 30_000.0 // 30 km
+			all, err := repository.GetByProximity(ctx, cntr, radiusMeters, logger)
+			Expect(err).To(Not(HaveOccurred()))
+			res, err := repository.GetByProximity(ctx, cntr, radiusMeters, logger, poi.WithStrict(true))
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(res.Discarded).To(BeNumerically(">", 0))
+			Expect(len(res.Locations) + res.Discarded).To(Equal(len(all.Locations)))
+			for _, p := range res.Locations {
+				Expect(distanceTo(cntr, p.Location)).To(BeNumerically("<=", radiusMeters))
+			}
+		})
+
+		It("get location by bbox search with filter returns only locations with features", func() {
+			filter := &poi.SearchFilter{Features: []string{"DC_CHARGING"}}
+			all, err := repository.GetByBbox(ctx, sw, ne, logger)
+			Expect(err).To(Not(HaveOccurred()))
+			res, err := repository.GetByBbox(ctx, sw, ne, logger, poi.WithFilter(filter))
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(len(res.Locations)).To(BeNumerically(">", 0))
+			Expect(len(res.Locations)).To(BeNumerically("<", len(all.Locations)))
+			for _, p := range res.Locations {
+				Expect(p.Features).To(ContainElement("DC_CHARGING"))
+			}
+		})
+
+		It("get location by bbox search with page size returns all locations page by page", func() {
+			all, err := repository.GetByBbox(ctx, sw, ne, logger)
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(len(all.Locations)).To(BeNumerically(">", 70))
+			pageSize := 7
+			ids := make(map[string]bool)
+			token := ""
+			for {
+				res, errP := repository.GetByBbox(ctx, sw, ne, logger, poi.WithPage(pageSize, token))
+				Expect(errP).To(Not(HaveOccurred()))
+				Expect(len(res.Locations)).To(BeNumerically("<=", pageSize))
+				for _, p := range res.Locations {
+					Expect(ids).To(Not(HaveKey(p.ID.String())))
+					ids[p.ID.String()] = true
+				}
+				if res.NextPageToken == "" {
+					break
+				}
+				token = res.NextPageToken
+			}
+			Expect(ids).To(HaveLen(len(all.Locations)))
+		})
+
+		It("get location by proximity search with page token of other search returns error", func() {
+			res, err := repository.GetByBbox(ctx, sw, ne, logger, poi.WithPage(5, ""))
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(res.NextPageToken).To(Not(BeEmpty()))
+			_, err = repository.GetByProximity(ctx, cntr, 30_000.0, logger, poi.WithPage(5, res.NextPageToken))
+			Expect(err).To(Equal(poi.ErrInvalidPageToken))
+			_, err = repository.GetByBbox(ctx, sw, ne, logger, poi.WithPage(5, "x"+res.NextPageToken))
+			Expect(err).To(Equal(poi.ErrInvalidPageToken))
+		})
+
+		It("get location by bbox search with page token of search with other options returns error", func() {
+			filter := &poi.SearchFilter{Features: []string{"AC_CHARGING"}}
+			res, err := repository.GetByBbox(ctx, sw, ne, logger, poi.WithPage(5, ""), poi.WithFilter(filter))
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(res.NextPageToken).To(Not(BeEmpty()))
+			_, err = repository.GetByBbox(ctx, sw, ne, logger, poi.WithPage(5, res.NextPageToken))
+			Expect(err).To(Equal(poi.ErrInvalidPageToken))
+			_, err = repository.GetByBbox(ctx, sw, ne, logger, poi.WithPage(5, res.NextPageToken), poi.WithFilter(filter),
+				poi.WithStrict(true))
+			Expect(err).To(Equal(poi.ErrInvalidPageToken))
+			_, err = repository.GetByBbox(ctx, sw, ne, logger, poi.WithPage(5, res.NextPageToken), poi.WithFilter(filter))
+			Expect(err).To(Not(HaveOccurred()))
+		})
+
+		It("get location by proximity search with strict filtering returns no empty pages but the last", func() {
+			all, err := repository.GetByProximity(ctx, cntr, 30_000.0, logger, poi.WithStrict(true))
+			Expect(err).To(Not(HaveOccurred()))
+			ids := make(map[ksuid.KSUID]bool)
+			token := ""
+			for {
+				res, err := repository.GetByProximity(ctx, cntr, 30_000.0, logger, poi.WithStrict(true), poi.WithPage(1, token))
+				Expect(err).To(Not(HaveOccurred()))
+				for _, l := range res.Locations {
+					ids[l.ID] = true
+				}
+				if res.NextPageToken == "" {
+					break
+				}
+				Expect(res.Locations).To(HaveLen(1))
+				token = res.NextPageToken
+			}
+			Expect(ids).To(HaveLen(len(all.Locations)))
+		})
+
+		It("get location by bbox search with forged page token returns error", func() {
+			res, err := repository.GetByBbox(ctx, sw, ne, logger, poi.WithPage(5, ""))
+			Expect(err).To(Not(HaveOccurred()))
+			payload, sig, found := strings.Cut(res.NextPageToken, ".")
+			Expect(found).To(BeTrue())
+			decoded, err := base64.RawURLEncoding.DecodeString(payload)
+			Expect(err).To(Not(HaveOccurred()))
+			forged := base64.RawURLEncoding.EncodeToString(bytes.Replace(decoded, []byte(`"c":`), []byte(`"c":1`), 1))
+			_, err = repository.GetByBbox(ctx, sw, ne, logger, poi.WithPage(5, forged+"."+sig))
+			Expect(err).To(Equal(poi.ErrInvalidPageToken))
+			_, err = repository.GetByBbox(ctx, sw, ne, logger, poi.WithPage(5, payload))
+			Expect(err).To(Equal(poi.ErrInvalidPageToken))
+		})
+
+		It("get location by route search with corridor returns only locations within corridor", func() {
+			route := []poi.Coordinates{
+				{Longitude: 9.181946, Latitude: 48.796183},
+				{Longitude: 8.611994, Latitude: 49.75371},
+				{Longitude: 8.180723, Latitude: 49.558617},
+			}
+			wide, err := repository.GetByRoute(ctx, route, logger, poi.WithCorridor(10_000.0))
+			Expect(err).To(Not(HaveOccurred()))
+			res, err := repository.GetByRoute(ctx, route, logger, poi.WithCorridor(2000.0))
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(len(res.Locations)).To(BeNumerically(">", 0))
+			Expect(len(res.Locations)).To(BeNumerically("<", len(wide.Locations)))
+			for _, p := range res.Locations {
+				Expect(distanceToRoute(route, p.Location)).To(BeNumerically("<=", 2000.0))
+			}
+		})
+
+		It("stream location by bbox search hands over all locations cell by cell", func() {
+			all, err := repository.GetByBbox(ctx, sw, ne, logger)
+			Expect(err).To(Not(HaveOccurred()))
+			numResults, numLocations := 0, 0
+			err = repository.StreamByBbox(ctx, sw, ne, logger, func(res *poi.SearchResult) error {
+				numResults++
+				numLocations += len(res.Locations)
+				return nil
+			})
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(numResults).To(BeNumerically(">", 1))
+			Expect(numLocations).To(Equal(len(all.Locations)))
+		})
+
+		It("stream location by bbox search aborts with the error of the handler", func() {
+			handlerErr := errors.New("client gone")
+			err := repository.StreamByBbox(ctx, sw, ne, logger, func(_ *poi.SearchResult) error {
+				return handlerErr
+			})
+			Expect(err).To(Equal(handlerErr))
+		})
+
+		It("get nearest locations returns k locations sorted by distance", func() {
+			k := 5
+			pois, err := repository.GetNearest(ctx, cntr, k, 100_000.0, logger)
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(pois).To(HaveLen(k))
+			for i := 1; i < len(pois); i++ {
+				Expect(distanceTo(cntr, pois[i].Location)).To(
+					BeNumerically(">=", distanceTo(cntr, pois[i-1].Location)),
+				)
+			}
+		})
+
+		It("get nearest locations with invalid coordinates returns error", func() {
+			invalid := poi.Coordinates{Longitude: 9000.147263, Latitude: 49000.333418}
+			_, err := repository.GetNearest(ctx, invalid, 5, 50_000.0, logger)
+			Expect(err).To(Equal(poi.ErrInvalidSearchCoordinates))
+		})
+	})
+
+	When("location is written", func() {
+		newLocation := func() poi.PoILocation {
+			return poi.PoILocation{
+				ID:               ksuid.New(),
+				Location:         poi.Coordinates{Latitude: 64.135482, Longitude: -21.895410},
+				LocationEntrance: poi.Coordinates{Latitude: 64.135482, Longitude: -21.895410},
+				Address: poi.Address{
+					Street:      "Laugavegur",
+					City:        "Reykjavik",
+					ZipCode:     "101",
+					CountryCode: "ISL",
+				},
+				Features: []string{"AC_CHARGING"},
+			}
+		}
+
+		It("create, update and delete poi with conditions on versions as expected", func() {
+			location := newLocation()
+			err := repository.Update(ctx, &location, logger)
+			Expect(err).To(Equal(poi.ErrLocationNotFound))
+			err = repository.Create(ctx, &location, logger)
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(location.Version).To(Equal(int64(1)))
+			err = repository.Create(ctx, &location, logger)
+			Expect(err).To(Equal(poi.ErrLocationAlreadyExists))
+
+			location.Address.StreetNumber = "2b"
+			stale := location
+			err = repository.Update(ctx, &location, logger)
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(location.Version).To(Equal(int64(2)))
+			actual, err := repository.GetByID(ctx, location.ID, logger)
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(actual.Address.StreetNumber).To(Equal("2b"))
+			Expect(actual.Version).To(Equal(int64(2)))
+
+			err = repository.Update(ctx, &stale, logger)
+			Expect(err).To(Equal(poi.ErrVersionMismatch))
+			err = repository.Delete(ctx, location.ID, stale.Version, logger)
+			Expect(err).To(Equal(poi.ErrVersionMismatch))
+
+			err = repository.Delete(ctx, location.ID, location.Version, logger)
+			Expect(err).To(Not(HaveOccurred()))
+			_, err = repository.GetByID(ctx, location.ID, logger)
+			Expect(err).To(Equal(poi.ErrLocationNotFound))
+			err = repository.Delete(ctx, location.ID, poi.AnyVersion, logger)
+			Expect(err).To(Equal(poi.ErrLocationNotFound))
+		})
+
+		It("returned locations do not share state with the stored location", func() {
+			location := newLocation()
+			Expect(repository.Upsert(ctx, &location, logger)).To(Succeed())
+			location.Features[0] = "modified"
+			actual, err := repository.GetByID(ctx, location.ID, logger)
+			Expect(err).To(Not(HaveOccurred()))
+			actual.Features[0] = "modified"
+			actual, err = repository.GetByID(ctx, location.ID, logger)
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(actual.Features).To(Equal([]string{"AC_CHARGING"}))
+		})
+
+		It("moved location is only found at its new position", func() {
+			location := newLocation()
+			Expect(repository.Upsert(ctx, &location, logger)).To(Succeed())
+			oldPosition := location.Location
+			location.Location = poi.Coordinates{Latitude: 65.683424, Longitude: -18.110554}
+			Expect(repository.Upsert(ctx, &location, logger)).To(Succeed())
+
+			res, err := repository.GetByProximity(ctx, oldPosition, 1_000.0, logger, poi.WithStrict(true))
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(res.Locations).To(Not(ContainElement(HaveField("ID", location.ID))))
+			res, err = repository.GetByProximity(ctx, location.Location, 1_000.0, logger, poi.WithStrict(true))
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(res.Locations).To(ContainElement(HaveField("ID", location.ID)))
+		})
+
+		It("density aggregates follow created, moved and deleted locations", func() {
+			density := func(c poi.Coordinates, level int) int {
+				res, err := repository.GetDensity(ctx, &poi.DensityArea{
+					SW: poi.Coordinates{Latitude: c.Latitude - 0.001, Longitude: c.Longitude - 0.001},
+					NE: poi.Coordinates{Latitude: c.Latitude + 0.001, Longitude: c.Longitude + 0.001},
+				}, level, logger)
+				Expect(err).To(Not(HaveOccurred()))
+				count := 0
+				for _, cell := range res.Cells {
+					count += cell.Count
+				}
+				return count
+			}
+			location := newLocation()
+			oldPosition := location.Location
+			newPosition := poi.Coordinates{Latitude: 65.683424, Longitude: -18.110554}
+			before, beforeMoved := density(oldPosition, 8), density(newPosition, 8)
+			Expect(density(oldPosition, 16)).To(BeNumerically("<=", before))
+
+			Expect(repository.Create(ctx, &location, logger)).To(Succeed())
+			Expect(density(oldPosition, 8)).To(Equal(before + 1))
+			location.Location = newPosition
+			Expect(repository.Update(ctx, &location, logger)).To(Succeed())
+			Expect(density(oldPosition, 8)).To(Equal(before))
+			Expect(density(newPosition, 8)).To(Equal(beforeMoved + 1))
+			Expect(repository.Delete(ctx, location.ID, location.Version, logger)).To(Succeed())
+			Expect(density(newPosition, 8)).To(Equal(beforeMoved))
+		})
+
+		It("upsert poi with invalid coordinates returns error", func() {
+			location := newLocation()
+			location.Location.Latitude = 91
+			Expect(repository.Upsert(ctx, &location, logger)).To(Not(Succeed()))
+			report, err := repository.UpsertBatch(ctx, []*poi.PoILocation{&location}, logger)
+			Expect(err).To(Equal(poi.ErrDBBatchUpsert))
+			Expect(report.Failed()).To(HaveLen(1))
+		})
+	})
+})
+
+func distanceTo(a, b poi.Coordinates) float64 {
+	la := s2.LatLngFromDegrees(a.Latitude, a.Longitude)
+	lb := s2.LatLngFromDegrees(b.Latitude, b.Longitude)
+	return la.Distance(lb).Radians() * 6371000.0
+}
+
+func distanceToRoute(route []poi.Coordinates, c poi.Coordinates) float64 {
+	line := make(s2.Polyline, len(route))
+	for i, r := range route {
+		line[i] = s2.PointFromLatLng(s2.LatLngFromDegrees(r.Latitude, r.Longitude))
+	}
+	p := s2.PointFromLatLng(s2.LatLngFromDegrees(c.Latitude, c.Longitude))
+	projected, _ := line.Project(p)
+	return p.Distance(projected).Radians() * 6371000.0
+}
@@ -0,0 +1,78 @@
+package pagetoken
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+const secretSize = 32
+
+var ErrSignature = errors.New("page token signature mismatch")
+
+// The Codec encodes the page tokens of the repositories as json signed with a HMAC, so that clients can not tamper
+// with the position at which a paged search continues
+type Codec struct {
+	secret []byte
+}
+
+// NewCodec creates a codec for the secret. If no secret is given a random one is generated,
+// tokens are then only valid for the lifetime of the process
+func NewCodec(secret string) (*Codec, error) {
+	if secret != "" {
+		return &Codec{secret: []byte(secret)}, nil
+	}
+	random := make([]byte, secretSize)
+	if _, err := rand.Read(random); err != nil {
+		return nil, fmt.Errorf("failed to generate page token secret: %w", err)
+	}
+	return &Codec{secret: random}, nil
+}
+
+// Encode marshals the token and signs it
+func (c *Codec) Encode(t any) (string, error) {
+	payload, err := json.Marshal(t)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal page token: %w", err)
+	}
+	return fmt.Sprintf(
+		"%s.%s",
+		base64.RawURLEncoding.EncodeToString(payload),
+		base64.RawURLEncoding.EncodeToString(c.sign(payload)),
+	), nil
+}
+
+// Decode verifies the signature of the token and unmarshals it into t, it fails with ErrSignature if the token has
+// not been signed by the codec
+func (c *Codec) Decode(token string, t any) error {
+	encPayload, encSig, found := strings.Cut(token, ".")
+	if !found {
+		return fmt.Errorf("page token is malformed")
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(encPayload)
+	if err != nil {
+		return fmt.Errorf("failed to decode page token payload: %w", err)
+	}
+	sig, err := base64.RawURLEncoding.DecodeString(encSig)
+	if err != nil {
+		return fmt.Errorf("failed to decode page token signature: %w", err)
+	}
+	if !hmac.Equal(sig, c.sign(payload)) {
+		return ErrSignature
+	}
+	if err := json.Unmarshal(payload, t); err != nil {
+		return fmt.Errorf("failed to unmarshal page token: %w", err)
+	}
+	return nil
+}
+
+func (c *Codec) sign(payload []byte) []byte {
+	mac := hmac.New(sha256.New, c.secret)
+	mac.Write(payload)
+	return mac.Sum(nil)
+}
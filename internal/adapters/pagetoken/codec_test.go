@@ -0,0 +1,66 @@
+package pagetoken_test
+
+import (
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/grntlrduck-cloud/go-grpc-geohasing-service-sample/internal/adapters/pagetoken"
+)
+
+type position struct {
+	Covering uint64 `json:"h"`
+	Cell     int    `json:"c"`
+}
+
+var _ = Describe("given a page token", func() {
+	When("encoded and decoded with the same secret", func() {
+		It("restores the token", func() {
+			codec, err := pagetoken.NewCodec("secret")
+			Expect(err).To(Not(HaveOccurred()))
+			encoded, err := codec.Encode(&position{Covering: 42, Cell: 3})
+			Expect(err).To(Not(HaveOccurred()))
+
+			var decoded position
+			Expect(codec.Decode(encoded, &decoded)).To(Succeed())
+			Expect(decoded).To(Equal(position{Covering: 42, Cell: 3}))
+		})
+	})
+
+	When("the token is tampered with", func() {
+		It("fails to decode", func() {
+			codec, err := pagetoken.NewCodec("secret")
+			Expect(err).To(Not(HaveOccurred()))
+			encoded, err := codec.Encode(&position{Covering: 42, Cell: 3})
+			Expect(err).To(Not(HaveOccurred()))
+			forged, err := codec.Encode(&position{Covering: 42, Cell: 4})
+			Expect(err).To(Not(HaveOccurred()))
+			payload, _, _ := strings.Cut(forged, ".")
+			_, sig, _ := strings.Cut(encoded, ".")
+
+			Expect(codec.Decode(payload+"."+sig, &position{})).To(MatchError(pagetoken.ErrSignature))
+		})
+	})
+
+	When("the token is signed with another secret", func() {
+		It("fails to decode", func() {
+			codec, err := pagetoken.NewCodec("secret")
+			Expect(err).To(Not(HaveOccurred()))
+			other, err := pagetoken.NewCodec("")
+			Expect(err).To(Not(HaveOccurred()))
+			encoded, err := other.Encode(&position{Covering: 42, Cell: 3})
+			Expect(err).To(Not(HaveOccurred()))
+
+			Expect(codec.Decode(encoded, &position{})).To(MatchError(pagetoken.ErrSignature))
+		})
+	})
+
+	When("the token is malformed", func() {
+		It("fails to decode", func() {
+			codec, err := pagetoken.NewCodec("secret")
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(codec.Decode("not-a-token", &position{})).To(HaveOccurred())
+		})
+	})
+})
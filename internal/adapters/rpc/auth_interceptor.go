@@ -5,8 +5,10 @@ import (
 	"crypto/sha256"
 	"encoding/hex"
 	"errors"
+	"net/http"
 	"strings"
 
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
@@ -32,28 +34,61 @@ func NewKeyAuthInterceptor(secret string) (*KeyAuthInterceptor, error) {
 
 func (k *KeyAuthInterceptor) UnaryKeyAuthorizer() grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
-		if strings.Contains(info.FullMethod, healthServiceMethodName) {
-			return handler(ctx, req)
-		}
-		requestKey, err := getAPIKeyFromContext(ctx)
-		if err != nil {
-			return nil, status.Error(codes.Unauthenticated, "unauthenticated, key missing")
+		if err := k.authorize(ctx, info.FullMethod); err != nil {
+			return nil, err
 		}
+		return handler(ctx, req)
+	}
+}
 
-		// now we hash the key so we have a constant length to compare to prevent timing attacks for length determination
-		hashFunc := sha256.New()
-		_, err = hashFunc.Write([]byte(requestKey))
-		if err != nil {
-			return nil, status.Error(codes.Internal, "failed to verify auth")
+func (k *KeyAuthInterceptor) StreamKeyAuthorizer() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := k.authorize(ss.Context(), info.FullMethod); err != nil {
+			return err
 		}
+		return handler(srv, ss)
+	}
+}
 
-		requestKeyHash := hashFunc.Sum(nil)
-		hexEnc := hex.EncodeToString(requestKeyHash)
-		if hexEnc == k.secretValue {
-			return handler(ctx, req)
+// HTTPKeyAuthorizer authorizes requests of handlers registered on the HTTP gateway directly, which do not pass the
+// interceptors of the gRPC server
+func (k *KeyAuthInterceptor) HTTPKeyAuthorizer(mux *runtime.ServeMux, next runtime.HandlerFunc) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		md := metadata.MD{}
+		if key := r.Header.Get(apiKeyHeader); key != "" {
+			md.Set(apiKeyHeader, key)
 		}
-		return nil, status.Error(codes.PermissionDenied, "invalid key")
+		if err := k.authorize(metadata.NewIncomingContext(r.Context(), md), r.URL.Path); err != nil {
+			_, outbound := runtime.MarshalerForRequest(mux, r)
+			runtime.HTTPError(r.Context(), mux, outbound, w, r, err)
+			return
+		}
+		next(w, r, pathParams)
+	}
+}
+
+func (k *KeyAuthInterceptor) authorize(ctx context.Context, fullMethod string) error {
+	if strings.Contains(fullMethod, healthServiceMethodName) {
+		return nil
+	}
+	requestKey, err := getAPIKeyFromContext(ctx)
+	if err != nil {
+		return status.Error(codes.Unauthenticated, "unauthenticated, key missing")
+	}
+
+	// now we hash the key so we have a constant length to compare to prevent timing attacks for length determination
+	hashFunc := sha256.New()
+	_, err = hashFunc.Write([]byte(requestKey))
+	if err != nil {
+		return status.Error(codes.Internal, "failed to verify auth")
+	}
+
+	requestKeyHash := hashFunc.Sum(nil)
+	hexEnc := hex.EncodeToString(requestKeyHash)
+	if hexEnc == k.secretValue {
+		return nil
 	}
+	return status.Error(codes.PermissionDenied, "invalid key")
 }
 
 func getAPIKeyFromContext(ctx context.Context) (string, error) {
@@ -5,4 +5,6 @@ const (
 	gAPIKeyMetadata   = "Grpc-Metadata-X-Api-Key" //nolint:gosec
 	correlationHeader = "X-Correlation-Id"
 	gCorrelationMD    = "Grpc-Metadata-X-Correlation-Id"
+	ndjsonContentType = "application/x-ndjson"
+	mvtContentType    = "application/vnd.mapbox-vector-tile"
 )
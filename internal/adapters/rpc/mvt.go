@@ -0,0 +1,143 @@
+package rpc
+
+import (
+	"fmt"
+	"math"
+
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// field numbers and constants of the Mapbox Vector Tile specification 2.1,
+// see https://github.com/mapbox/vector-tile-spec/blob/master/2.1/vector_tile.proto
+const (
+	mvtTileLayers         protowire.Number = 3
+	mvtLayerVersion       protowire.Number = 15
+	mvtLayerName          protowire.Number = 1
+	mvtLayerFeatures      protowire.Number = 2
+	mvtLayerKeys          protowire.Number = 3
+	mvtLayerValues        protowire.Number = 4
+	mvtLayerExtent        protowire.Number = 5
+	mvtFeatureTags        protowire.Number = 2
+	mvtFeatureType        protowire.Number = 3
+	mvtFeatureGeometry    protowire.Number = 4
+	mvtValueString        protowire.Number = 1
+	mvtValueDouble        protowire.Number = 3
+	mvtValueInt           protowire.Number = 4
+	mvtValueBool          protowire.Number = 7
+	mvtVersion                             = 2
+	mvtGeomTypePoint                       = 1
+	mvtCommandMoveToPoint                  = 1 | 1<<3 // MoveTo with a count of one
+)
+
+// An mvtProperty is a key and a value of the feature properties, the value is a string, float64, int64, or bool
+type mvtProperty struct {
+	key   string
+	value any
+}
+
+// The mvtLayer encodes point features of a single layer of a vector tile, the keys and values of the properties are
+// shared by the features of the layer
+type mvtLayer struct {
+	name     string
+	extent   uint32
+	keys     map[string]uint64
+	values   map[any]uint64
+	encoded  []byte // the keys and values in the order of their indices
+	features [][]byte
+}
+
+func newMVTLayer(name string, extent uint32) *mvtLayer {
+	return &mvtLayer{
+		name:   name,
+		extent: extent,
+		keys:   make(map[string]uint64),
+		values: make(map[any]uint64),
+	}
+}
+
+// addPoint adds a point feature at the position in tile coordinates, within [0, extent] for points within the tile.
+// It returns an error without adding the feature if a property value has an unsupported type.
+func (l *mvtLayer) addPoint(x, y int32, properties []mvtProperty) error {
+	var tags []byte
+	for _, p := range properties {
+		value, err := l.valueIndex(p.value)
+		if err != nil {
+			return fmt.Errorf("invalid property %s: %w", p.key, err)
+		}
+		tags = protowire.AppendVarint(tags, l.keyIndex(p.key))
+		tags = protowire.AppendVarint(tags, value)
+	}
+	var geometry []byte
+	geometry = protowire.AppendVarint(geometry, mvtCommandMoveToPoint)
+	geometry = protowire.AppendVarint(geometry, uint64(protowire.EncodeZigZag(int64(x))))
+	geometry = protowire.AppendVarint(geometry, uint64(protowire.EncodeZigZag(int64(y))))
+
+	var feature []byte
+	feature = protowire.AppendTag(feature, mvtFeatureTags, protowire.BytesType)
+	feature = protowire.AppendBytes(feature, tags)
+	feature = protowire.AppendTag(feature, mvtFeatureType, protowire.VarintType)
+	feature = protowire.AppendVarint(feature, mvtGeomTypePoint)
+	feature = protowire.AppendTag(feature, mvtFeatureGeometry, protowire.BytesType)
+	feature = protowire.AppendBytes(feature, geometry)
+	l.features = append(l.features, feature)
+	return nil
+}
+
+func (l *mvtLayer) keyIndex(key string) uint64 {
+	if i, ok := l.keys[key]; ok {
+		return i
+	}
+	i := uint64(len(l.keys))
+	l.keys[key] = i
+	l.encoded = protowire.AppendTag(l.encoded, mvtLayerKeys, protowire.BytesType)
+	l.encoded = protowire.AppendString(l.encoded, key)
+	return i
+}
+
+func (l *mvtLayer) valueIndex(value any) (uint64, error) {
+	if i, ok := l.values[value]; ok {
+		return i, nil
+	}
+	var v []byte
+	switch value := value.(type) {
+	case string:
+		v = protowire.AppendTag(v, mvtValueString, protowire.BytesType)
+		v = protowire.AppendString(v, value)
+	case float64:
+		v = protowire.AppendTag(v, mvtValueDouble, protowire.Fixed64Type)
+		v = protowire.AppendFixed64(v, math.Float64bits(value))
+	case int64:
+		v = protowire.AppendTag(v, mvtValueInt, protowire.VarintType)
+		v = protowire.AppendVarint(v, uint64(value)) //nolint:gosec // int64 values are encoded as two's complement
+	case bool:
+		v = protowire.AppendTag(v, mvtValueBool, protowire.VarintType)
+		v = protowire.AppendVarint(v, protowire.EncodeBool(value))
+	default:
+		return 0, fmt.Errorf("unsupported mvt property value of type %T", value)
+	}
+	i := uint64(len(l.values))
+	l.values[value] = i
+	l.encoded = protowire.AppendTag(l.encoded, mvtLayerValues, protowire.BytesType)
+	l.encoded = protowire.AppendBytes(l.encoded, v)
+	return i, nil
+}
+
+// tile encodes the tile holding the layer
+func (l *mvtLayer) tile() []byte {
+	var layer []byte
+	layer = protowire.AppendTag(layer, mvtLayerVersion, protowire.VarintType)
+	layer = protowire.AppendVarint(layer, mvtVersion)
+	layer = protowire.AppendTag(layer, mvtLayerName, protowire.BytesType)
+	layer = protowire.AppendString(layer, l.name)
+	for _, f := range l.features {
+		layer = protowire.AppendTag(layer, mvtLayerFeatures, protowire.BytesType)
+		layer = protowire.AppendBytes(layer, f)
+	}
+	layer = append(layer, l.encoded...)
+	layer = protowire.AppendTag(layer, mvtLayerExtent, protowire.VarintType)
+	layer = protowire.AppendVarint(layer, uint64(l.extent))
+
+	var tile []byte
+	tile = protowire.AppendTag(tile, mvtTileLayers, protowire.BytesType)
+	return protowire.AppendBytes(tile, layer)
+}
@@ -0,0 +1,18 @@
+package rpc
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("given a vector tile layer", func() {
+	When("point with unsupported property value is added", func() {
+		It("returns error without adding the feature", func() {
+			layer := newMVTLayer(tileLayerName, tileExtent)
+			Expect(layer.addPoint(1, 2, []mvtProperty{{key: "count", value: 3}})).To(HaveOccurred())
+			Expect(layer.features).To(BeEmpty())
+			Expect(layer.addPoint(1, 2, []mvtProperty{{key: "count", value: int64(3)}})).To(Succeed())
+			Expect(layer.features).To(HaveLen(1))
+		})
+	})
+})
@@ -0,0 +1,31 @@
+package rpc
+
+import (
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// The ndjsonMarshaler is the default marshaler of the gateway, but declares server-streamed
+// responses as newline-delimited JSON instead of plain JSON
+type ndjsonMarshaler struct {
+	*runtime.HTTPBodyMarshaler
+}
+
+func newNDJSONMarshaler() *ndjsonMarshaler {
+	return &ndjsonMarshaler{
+		HTTPBodyMarshaler: &runtime.HTTPBodyMarshaler{
+			Marshaler: &runtime.JSONPb{
+				MarshalOptions: protojson.MarshalOptions{
+					EmitUnpopulated: true,
+				},
+				UnmarshalOptions: protojson.UnmarshalOptions{
+					DiscardUnknown: true,
+				},
+			},
+		},
+	}
+}
+
+func (m *ndjsonMarshaler) StreamContentType(_ any) string {
+	return ndjsonContentType
+}
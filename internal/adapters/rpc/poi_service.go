@@ -3,6 +3,7 @@ package rpc
 import (
 	"context"
 	"errors"
+	"strconv"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/segmentio/ksuid"
@@ -11,6 +12,7 @@ import (
 	codes "google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	status "google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 
 	poi_v1 "github.com/grntlrduck-cloud/go-grpc-geohasing-service-sample/api/gen/v1/poi"
 	"github.com/grntlrduck-cloud/go-grpc-geohasing-service-sample/internal/domain/poi"
@@ -25,12 +27,34 @@ var (
 
 	invalidGeoParamsMessage = "invalid geo search arguments, ensure correct coordinates and the number of parameters required"
 
+	tooLargeSearchAreaMessage = "search area too large, reduce max_radius_meters"
+	tooLargeAreaMessage       = "search area too large, reduce its extent"
+
 	severErrMessage = "server error, failed to process request"
 )
 
 const (
 	minSearchRadiusMeters float64 = 1000.0    // 1 km
 	maxSearchRadiusMeters float64 = 100_000.0 // 100 km
+	minNearestK           int32   = 1
+	maxNearestK           int32   = 100
+	minCorridorMeters     float64 = 100.0       // 100 m
+	maxCorridorMeters     float64 = 50_000.0    // 50 km
+	defaultCorridorMeters float64 = 5000.0      // used for charging stop planning if no corridor is given
+	minRangeMeters        float64 = 10_000.0    // 10 km
+	maxRangeMeters        float64 = 2_000_000.0 // 2000 km
+	maxReservePercent     float64 = 50.0
+	defaultTargetPercent  float64 = 80.0
+	maxPolygonRings               = 10
+	minRingCoordinates            = 3
+	maxRingCoordinates            = 1000
+	maxPageSize           int32   = 500
+	maxBatchGetIDs                = 250
+	defaultPageSize       int32   = 100 // used if a page token is given without a page size
+	minZoom               int32   = 0
+	maxZoom               int32   = 22
+	minDensityLevel       int32   = 0
+	maxDensityLevel       int32   = 30 // the level of the S2 leaf cells
 )
 
 type PoIRPCService struct {
@@ -97,7 +121,8 @@ func (p *PoIRPCService) PoI(
 		return nil, status.Errorf(codes.Internal, "%s: %v", severErrMessage, err)
 	}
 	response := poi_v1.PoIResponse{
-		Poi: poiToProto(location),
+		Poi:  poiToProto(location),
+		Etag: etagFromVersion(location.Version),
 	}
 	p.logger.Info(
 		"returning response for PoI rpc",
@@ -105,29 +130,273 @@ func (p *PoIRPCService) PoI(
 	return &response, nil
 }
 
-func (p *PoIRPCService) Proximity(
+func (p *PoIRPCService) BatchGetPoIs(
 	ctx context.Context,
-	request *poi_v1.ProximityRequest,
-) (*poi_v1.PoISearchResponse, error) {
+	request *poi_v1.BatchGetPoIsRequest,
+) (*poi_v1.BatchGetPoIsResponse, error) {
 	// handle context cancellation
 	if ctx.Err() != nil {
 		return nil, requestCenceledStatus
 	}
-	// validate request
-	if request == nil || request.Center == nil {
+	ids, err := idsFromProto(request.GetIds())
+	if err != nil {
+		return nil, err
+	}
+	correlationID, err := getCorrelationID(ctx)
+	if err != nil {
+		return nil, missingOrInvalidCorrelationIDStatus
+	}
+
+	// setting response header for client side tracing
+	_ = grpc.SendHeader(ctx, metadata.Pairs(correlationHeader, correlationID.String()))
+
+	logger := p.logger.With(
+		zap.String("correlation_id", correlationID.String()),
+		zap.String("rpc_method", "BatchGetPoIs"),
+		zap.Int("num_ids", len(ids)),
+	)
+	logger.Info(
+		"processing BatchGetPoIs rpc",
+	)
+
+	// process request
+	result, err := p.locationService.BatchInfo(ctx, ids, logger)
+	if err != nil {
+		logger.Error("failed to get pois by ids", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "%s: %v", severErrMessage, err)
+	}
+	response := &poi_v1.BatchGetPoIsResponse{
+		Items:      make([]*poi_v1.PoI, len(result.Locations)),
+		MissingIds: make([]string, len(result.Missing)),
+		Etags:      make([]string, len(result.Locations)),
+	}
+	for i, l := range result.Locations {
+		response.Items[i] = poiToProto(l)
+		response.Etags[i] = etagFromVersion(l.Version)
+	}
+	for i, id := range result.Missing {
+		response.MissingIds[i] = id.String()
+	}
+	logger.Info(
+		"returning response for BatchGetPoIs rpc",
+		zap.Int("num_found", len(response.Items)),
+		zap.Int("num_missing", len(response.MissingIds)),
+	)
+	return response, nil
+}
+
+func (p *PoIRPCService) CreatePoI(
+	ctx context.Context,
+	request *poi_v1.CreatePoIRequest,
+) (*poi_v1.PoIResponse, error) {
+	// handle context cancellation
+	if ctx.Err() != nil {
+		return nil, requestCenceledStatus
+	}
+	if request == nil || request.Poi == nil || request.Poi.Coordinate == nil ||
+		request.Poi.Address == nil {
+		return nil, status.Error(codes.InvalidArgument, "missing argument: poi with coordinate and address")
+	}
+	location, err := locationFromProto(request.Poi)
+	if err != nil {
+		return nil, err
+	}
+	correlationID, err := getCorrelationID(ctx)
+	if err != nil {
+		return nil, missingOrInvalidCorrelationIDStatus
+	}
+
+	// setting response header for client side tracing
+	_ = grpc.SendHeader(ctx, metadata.Pairs(correlationHeader, correlationID.String()))
+
+	logger := p.logger.With(
+		zap.String("correlation_id", correlationID.String()),
+		zap.String("rpc_method", "CreatePoI"),
+	)
+	logger.Info(
+		"processing CreatePoI rpc",
+	)
+
+	// process request
+	location, err = p.locationService.Create(ctx, location, logger)
+
+	// handle errors accordingly
+	if errors.Is(err, poi.ErrInvalidLocation) {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if errors.Is(err, poi.ErrLocationAlreadyExists) {
+		return nil, status.Errorf(
+			codes.AlreadyExists,
+			"location already exists: id=%s",
+			request.Poi.Id,
+		)
+	}
+	if err != nil {
+		logger.Error("failed to create poi", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "%s: %v", severErrMessage, err)
+	}
+	logger.Info(
+		"returning response for CreatePoI rpc",
+		zap.String("location_id", location.ID.String()),
+	)
+	return &poi_v1.PoIResponse{Poi: poiToProto(location), Etag: etagFromVersion(location.Version)}, nil
+}
+
+func (p *PoIRPCService) UpdatePoI(
+	ctx context.Context,
+	request *poi_v1.UpdatePoIRequest,
+) (*poi_v1.PoIResponse, error) {
+	// handle context cancellation
+	if ctx.Err() != nil {
+		return nil, requestCenceledStatus
+	}
+	if request == nil || request.Poi == nil || request.Poi.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing argument: poi.id")
+	}
+	kID, err := ksuid.Parse(request.Poi.Id)
+	if err != nil {
 		return nil, status.Errorf(
 			codes.InvalidArgument,
-			"center must be given to perform proximity search",
+			"invalid location id format: given id=%s",
+			request.Poi.Id,
 		)
 	}
-	if request.RadiusMeters < minSearchRadiusMeters ||
-		request.RadiusMeters > maxSearchRadiusMeters {
+	update, err := updateFromProto(request.Poi, request.UpdateMask)
+	if err != nil {
+		return nil, err
+	}
+	version, err := versionFromEtag(request.Etag)
+	if err != nil {
+		return nil, err
+	}
+	correlationID, err := getCorrelationID(ctx)
+	if err != nil {
+		return nil, missingOrInvalidCorrelationIDStatus
+	}
+
+	// setting response header for client side tracing
+	_ = grpc.SendHeader(ctx, metadata.Pairs(correlationHeader, correlationID.String()))
+
+	logger := p.logger.With(
+		zap.String("correlation_id", correlationID.String()),
+		zap.String("rpc_method", "UpdatePoI"),
+		zap.String("location_id", kID.String()),
+		zap.Strings("update_mask", request.UpdateMask.GetPaths()),
+	)
+	logger.Info(
+		"processing UpdatePoI rpc",
+	)
+
+	// process request
+	location, err := p.locationService.Update(ctx, kID, version, update, logger)
+
+	// handle errors accordingly
+	if errors.Is(err, poi.ErrLocationNotFound) {
+		return nil, status.Errorf(codes.NotFound, "location not found: id=%s", request.Poi.Id)
+	}
+	if errors.Is(err, poi.ErrInvalidLocation) {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if errors.Is(err, poi.ErrConcurrentModification) {
+		return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+	}
+	if errors.Is(err, poi.ErrVersionMismatch) {
+		return nil, status.Errorf(codes.Aborted, "%v: id=%s", poi.ErrVersionMismatch, request.Poi.Id)
+	}
+	if err != nil {
+		logger.Error("failed to update poi", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "%s: %v", severErrMessage, err)
+	}
+	logger.Info(
+		"returning response for UpdatePoI rpc",
+	)
+	return &poi_v1.PoIResponse{Poi: poiToProto(location), Etag: etagFromVersion(location.Version)}, nil
+}
+
+func (p *PoIRPCService) DeletePoI(
+	ctx context.Context,
+	request *poi_v1.DeletePoIRequest,
+) (*poi_v1.DeletePoIResponse, error) {
+	// handle context cancellation
+	if ctx.Err() != nil {
+		return nil, requestCenceledStatus
+	}
+	if request == nil || request.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing argument: id")
+	}
+	kID, err := ksuid.Parse(request.Id)
+	if err != nil {
 		return nil, status.Errorf(
 			codes.InvalidArgument,
-			"invalid radius: radius=%f must be between 1000 m (1 km) and 100000 m (100 km)",
-			request.RadiusMeters,
+			"invalid location id format: given id=%s",
+			request.Id,
 		)
 	}
+	version, err := versionFromEtag(request.Etag)
+	if err != nil {
+		return nil, err
+	}
+	correlationID, err := getCorrelationID(ctx)
+	if err != nil {
+		return nil, missingOrInvalidCorrelationIDStatus
+	}
+
+	// setting response header for client side tracing
+	_ = grpc.SendHeader(ctx, metadata.Pairs(correlationHeader, correlationID.String()))
+
+	logger := p.logger.With(
+		zap.String("correlation_id", correlationID.String()),
+		zap.String("rpc_method", "DeletePoI"),
+		zap.String("location_id", kID.String()),
+	)
+	logger.Info(
+		"processing DeletePoI rpc",
+	)
+
+	// process request
+	err = p.locationService.Delete(ctx, kID, version, logger)
+
+	// handle errors accordingly
+	if errors.Is(err, poi.ErrLocationNotFound) {
+		return nil, status.Errorf(codes.NotFound, "location not found: id=%s", request.Id)
+	}
+	if errors.Is(err, poi.ErrVersionMismatch) {
+		return nil, status.Errorf(codes.Aborted, "%v: id=%s", poi.ErrVersionMismatch, request.Id)
+	}
+	if err != nil {
+		logger.Error("failed to delete poi", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "%s: %v", severErrMessage, err)
+	}
+	logger.Info(
+		"returning response for DeletePoI rpc",
+	)
+	return &poi_v1.DeletePoIResponse{}, nil
+}
+
+func (p *PoIRPCService) Proximity(
+	ctx context.Context,
+	request *poi_v1.ProximityRequest,
+) (*poi_v1.PoISearchResponse, error) {
+	// handle context cancellation
+	if ctx.Err() != nil {
+		return nil, requestCenceledStatus
+	}
+	// validate request
+	if err := validateProximityRequest(request); err != nil {
+		return nil, err
+	}
+	sortBy, err := sortOrderFromProto(request.SortBy, false)
+	if err != nil {
+		return nil, err
+	}
+	filter, err := filterFromProto(request.Filter)
+	if err != nil {
+		return nil, err
+	}
+	page, err := pageFromProto(request.PageSize, request.PageToken)
+	if err != nil {
+		return nil, err
+	}
 	correlationID, err := getCorrelationID(ctx)
 	if err != nil {
 		return nil, missingOrInvalidCorrelationIDStatus
@@ -152,13 +421,28 @@ func (p *PoIRPCService) Proximity(
 		Latitude:  request.Center.Lat,
 		Longitude: request.Center.Lon,
 	}
-	locations, err := p.locationService.Proximity(ctx, cntr, request.RadiusMeters, logger)
+	result, err := p.locationService.Proximity(
+		ctx,
+		cntr,
+		request.RadiusMeters,
+		logger,
+		poi.WithStrict(request.Strict),
+		poi.WithSortBy(sortBy),
+		filter,
+		page,
+	)
 
 	// handle errors accordingly
 	if errors.Is(err, poi.ErrInvalidSearchCoordinates) ||
 		errors.Is(errors.Unwrap(err), poi.ErrInvalidSearchCoordinates) {
 		return nil, status.Errorf(codes.InvalidArgument, "%s: %v", invalidGeoParamsMessage, err)
 	}
+	if errors.Is(err, poi.ErrTooLargeSearchArea) {
+		return nil, status.Errorf(codes.OutOfRange, "%s: %v", tooLargeAreaMessage, err)
+	}
+	if errors.Is(err, poi.ErrInvalidPageToken) {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
 	if err != nil {
 		logger.Error("unable to handle request", zap.Error(err))
 		return nil, status.Errorf(codes.Internal, "%s: %v", severErrMessage, err)
@@ -167,9 +451,12 @@ func (p *PoIRPCService) Proximity(
 	// log and return
 	logger.Info(
 		"returning response for Proximity RPC",
-		zap.Int("num_locations", len(locations)),
+		zap.Int("num_locations", len(result.Locations)),
+		zap.Int("num_discarded", result.Discarded),
+		zap.Bool("has_next_page", result.NextPageToken != ""),
+		zap.Bool("truncated", result.Truncated),
 	)
-	resp := buildPoISearchResponse(locations)
+	resp := buildPoISearchResultResponse(result)
 	return resp, nil
 }
 
@@ -182,8 +469,20 @@ func (p *PoIRPCService) BBox(
 		return nil, requestCenceledStatus
 	}
 	// validate the bbox request
-	if request == nil || request.Bbox == nil || request.Bbox.Sw == nil || request.Bbox.Ne == nil {
-		return nil, status.Errorf(codes.InvalidArgument, "bounding box coordinates must be defined")
+	if err := validateBBoxRequest(request); err != nil {
+		return nil, err
+	}
+	sortBy, err := sortOrderFromProto(request.SortBy, false)
+	if err != nil {
+		return nil, err
+	}
+	filter, err := filterFromProto(request.Filter)
+	if err != nil {
+		return nil, err
+	}
+	page, err := pageFromProto(request.PageSize, request.PageToken)
+	if err != nil {
+		return nil, err
 	}
 	correlationID, err := getCorrelationID(ctx)
 	if err != nil {
@@ -215,13 +514,28 @@ func (p *PoIRPCService) BBox(
 		Latitude:  request.Bbox.Ne.Lat,
 		Longitude: request.Bbox.Ne.Lon,
 	}
-	locations, err := p.locationService.Bbox(ctx, sw, ne, logger)
+	result, err := p.locationService.Bbox(
+		ctx,
+		sw,
+		ne,
+		logger,
+		poi.WithStrict(request.Strict),
+		poi.WithSortBy(sortBy),
+		filter,
+		page,
+	)
 
 	// handle errors accordingly
 	if errors.Is(err, poi.ErrInvalidSearchCoordinates) ||
 		errors.Is(errors.Unwrap(err), poi.ErrInvalidSearchCoordinates) {
 		return nil, status.Errorf(codes.InvalidArgument, "%s: %v", invalidGeoParamsMessage, err)
 	}
+	if errors.Is(err, poi.ErrTooLargeSearchArea) {
+		return nil, status.Errorf(codes.OutOfRange, "%s: %v", tooLargeAreaMessage, err)
+	}
+	if errors.Is(err, poi.ErrInvalidPageToken) {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
 	if err != nil {
 		logger.Error("unable to handle request", zap.Error(err))
 		return nil, status.Errorf(codes.Internal, "%s: %v", severErrMessage, err)
@@ -230,9 +544,12 @@ func (p *PoIRPCService) BBox(
 	// log and return
 	logger.Info(
 		"returning response for BBox RPC",
-		zap.Int("num_locations", len(locations)),
+		zap.Int("num_locations", len(result.Locations)),
+		zap.Int("num_discarded", result.Discarded),
+		zap.Bool("has_next_page", result.NextPageToken != ""),
+		zap.Bool("truncated", result.Truncated),
 	)
-	resp := buildPoISearchResponse(locations)
+	resp := buildPoISearchResultResponse(result)
 	return resp, nil
 }
 
@@ -245,12 +562,20 @@ func (p *PoIRPCService) Route(
 		return nil, requestCenceledStatus
 	}
 	// validate request
-	if request == nil || request.Route == nil || len(request.Route) < 2 ||
-		len(request.Route) > 100 {
-		return nil, status.Errorf(
-			codes.InvalidArgument,
-			"a route must at least have two coordinates and not more then 100",
-		)
+	if err := validateRouteRequest(request); err != nil {
+		return nil, err
+	}
+	sortBy, err := sortOrderFromProto(request.SortBy, true)
+	if err != nil {
+		return nil, err
+	}
+	filter, err := filterFromProto(request.Filter)
+	if err != nil {
+		return nil, err
+	}
+	page, err := pageFromProto(request.PageSize, request.PageToken)
+	if err != nil {
+		return nil, err
 	}
 	correlationID, err := getCorrelationID(ctx)
 	if err != nil {
@@ -265,6 +590,7 @@ func (p *PoIRPCService) Route(
 		zap.String("correlation_id", correlationID.String()),
 		zap.String("rpc_method", "Route"),
 		zap.Int("num_route_points", len(request.Route)),
+		zap.Float64("corridor_meters", request.CorridorMeters),
 	)
 	logger.Info(
 		"processing Route rpc",
@@ -272,12 +598,26 @@ func (p *PoIRPCService) Route(
 
 	// process request
 	path := coordinatesPathFromProto(request.Route)
-	locations, err := p.locationService.Route(ctx, path, logger)
+	result, err := p.locationService.Route(
+		ctx,
+		path,
+		logger,
+		poi.WithSortBy(sortBy),
+		filter,
+		poi.WithCorridor(request.CorridorMeters),
+		page,
+	)
 
 	// handle the errors accordingly
 	if errors.Is(err, poi.ErrInvalidSearchCoordinates) {
 		return nil, status.Errorf(codes.InvalidArgument, "%s: %v", invalidGeoParamsMessage, err)
 	}
+	if errors.Is(err, poi.ErrTooLargeSearchArea) {
+		return nil, status.Errorf(codes.OutOfRange, "%s: %v", tooLargeAreaMessage, err)
+	}
+	if errors.Is(err, poi.ErrInvalidPageToken) {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
 	if err != nil {
 		logger.Error("unable to handle request", zap.Error(err))
 		return nil, status.Errorf(codes.Internal, "%s: %v", severErrMessage, err)
@@ -286,62 +626,1186 @@ func (p *PoIRPCService) Route(
 	// log and return
 	logger.Info(
 		"returning response for Route RPC",
-		zap.Int("num_locations", len(locations)),
+		zap.Int("num_locations", len(result.Locations)),
+		zap.Int("num_discarded", result.Discarded),
+		zap.Bool("has_next_page", result.NextPageToken != ""),
+		zap.Bool("truncated", result.Truncated),
 	)
-	resp := buildPoISearchResponse(locations)
+	resp := buildPoISearchResultResponse(result)
 	return resp, nil
 }
 
-func (p *PoIRPCService) Register(server *grpc.Server) {
-	poi_v1.RegisterPoIServiceServer(server, p)
-}
-
-func (p *PoIRPCService) RegisterProxy(
+func (p *PoIRPCService) Nearest(
 	ctx context.Context,
-	mux *runtime.ServeMux,
-	endpoint string,
-	opts []grpc.DialOption,
-) (err error) {
-	return poi_v1.RegisterPoIServiceHandlerFromEndpoint(
-		ctx,
-		mux,
-		endpoint,
-		opts,
-	)
-}
-
-func buildPoISearchResponse(l []*poi.PoILocation) *poi_v1.PoISearchResponse {
-	proto := locationsToProto(l)
-	return &poi_v1.PoISearchResponse{
-		Items: proto,
+	request *poi_v1.NearestRequest,
+) (*poi_v1.PoISearchResponse, error) {
+	// handle context cancellation
+	if ctx.Err() != nil {
+		return nil, requestCenceledStatus
 	}
-}
-
-func locationsToProto(l []*poi.PoILocation) []*poi_v1.PoI {
-	pois := make([]*poi_v1.PoI, len(l))
-	for i, v := range l {
-		pois[i] = poiToProto(v)
+	// validate request
+	if request == nil || request.Center == nil {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"center must be given to perform nearest search",
+		)
 	}
-	return pois
-}
-
-func poiToProto(p *poi.PoILocation) *poi_v1.PoI {
-	return &poi_v1.PoI{
-		Id: p.ID.String(),
-		Coordinate: &poi_v1.Coordinate{
-			Lat: p.Location.Latitude,
-			Lon: p.Location.Longitude,
-		},
-		Address: &poi_v1.Address{
-			Street:       p.Address.Street,
-			StreetNumber: p.Address.StreetNumber,
-			ZipCode:      p.Address.ZipCode,
-			City:         p.Address.City,
-			Country:      p.Address.CountryCode,
-		},
-		Features: p.Features,
+	if request.K < minNearestK || request.K > maxNearestK {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"invalid k: k=%d must be between 1 and 100",
+			request.K,
+		)
 	}
-}
+	if request.MaxRadiusMeters < minSearchRadiusMeters ||
+		request.MaxRadiusMeters > maxSearchRadiusMeters {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"invalid max radius: max_radius=%f must be between 1000 m (1 km) and 100000 m (100 km)",
+			request.MaxRadiusMeters,
+		)
+	}
+	sortBy, err := sortOrderFromProto(request.SortBy, false)
+	if err != nil {
+		return nil, err
+	}
+	filter, err := filterFromProto(request.Filter)
+	if err != nil {
+		return nil, err
+	}
+	correlationID, err := getCorrelationID(ctx)
+	if err != nil {
+		return nil, missingOrInvalidCorrelationIDStatus
+	}
+
+	// setting response header for client side tracing
+	_ = grpc.SendHeader(ctx, metadata.Pairs(correlationHeader, correlationID.String()))
+
+	// set correlationID for logging
+	logger := p.logger.With(
+		zap.String("correlation_id", correlationID.String()),
+		zap.String("rpc_method", "Nearest"),
+		zap.Float64("lat", request.Center.Lat),
+		zap.Float64("lon", request.Center.Lon),
+		zap.Int32("k", request.K),
+	)
+	logger.Info(
+		"processing Nearest rpc",
+	)
+
+	// process request
+	cntr := poi.Coordinates{
+		Latitude:  request.Center.Lat,
+		Longitude: request.Center.Lon,
+	}
+	result, err := p.locationService.Nearest(
+		ctx,
+		cntr,
+		int(request.K),
+		request.MaxRadiusMeters,
+		logger,
+		poi.WithSortBy(sortBy),
+		filter,
+	)
+
+	// handle errors accordingly
+	if errors.Is(err, poi.ErrInvalidSearchCoordinates) {
+		return nil, status.Errorf(codes.InvalidArgument, "%s: %v", invalidGeoParamsMessage, err)
+	}
+	if errors.Is(err, poi.ErrTooLargeSearchArea) {
+		return nil, status.Errorf(codes.OutOfRange, "%s: %v", tooLargeSearchAreaMessage, err)
+	}
+	if err != nil {
+		logger.Error("unable to handle request", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "%s: %v", severErrMessage, err)
+	}
+
+	// log and return
+	logger.Info(
+		"returning response for Nearest RPC",
+		zap.Int("num_locations", len(result.Locations)),
+	)
+	resp := buildPoISearchResultResponse(result)
+	return resp, nil
+}
+
+func (p *PoIRPCService) Polygon(
+	ctx context.Context,
+	request *poi_v1.PolygonRequest,
+) (*poi_v1.PoISearchResponse, error) {
+	// handle context cancellation
+	if ctx.Err() != nil {
+		return nil, requestCenceledStatus
+	}
+	// validate request
+	if err := validatePolygonRequest(request); err != nil {
+		return nil, err
+	}
+	sortBy, err := sortOrderFromProto(request.SortBy, false)
+	if err != nil {
+		return nil, err
+	}
+	filter, err := filterFromProto(request.Filter)
+	if err != nil {
+		return nil, err
+	}
+	page, err := pageFromProto(request.PageSize, request.PageToken)
+	if err != nil {
+		return nil, err
+	}
+	correlationID, err := getCorrelationID(ctx)
+	if err != nil {
+		return nil, missingOrInvalidCorrelationIDStatus
+	}
+
+	// setting response header for client side tracing
+	_ = grpc.SendHeader(ctx, metadata.Pairs(correlationHeader, correlationID.String()))
+
+	// set correlationID for logging
+	logger := p.logger.With(
+		zap.String("correlation_id", correlationID.String()),
+		zap.String("rpc_method", "Polygon"),
+		zap.Int("num_rings", len(request.Rings)),
+	)
+	logger.Info(
+		"processing Polygon rpc",
+	)
+
+	// process request
+	rings := make([][]poi.Coordinates, len(request.Rings))
+	for i, r := range request.Rings {
+		rings[i] = coordinatesPathFromProto(r.Coordinates)
+	}
+	result, err := p.locationService.Polygon(
+		ctx,
+		rings,
+		logger,
+		poi.WithSortBy(sortBy),
+		filter,
+		page,
+	)
+
+	// handle errors accordingly
+	if errors.Is(err, poi.ErrInvalidSearchCoordinates) {
+		return nil, status.Errorf(codes.InvalidArgument, "%s: %v", invalidGeoParamsMessage, err)
+	}
+	if errors.Is(err, poi.ErrTooLargeSearchArea) {
+		return nil, status.Errorf(codes.OutOfRange, "%s: %v", tooLargeAreaMessage, err)
+	}
+	if errors.Is(err, poi.ErrInvalidPageToken) {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err != nil {
+		logger.Error("unable to handle request", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "%s: %v", severErrMessage, err)
+	}
+
+	// log and return
+	logger.Info(
+		"returning response for Polygon RPC",
+		zap.Int("num_locations", len(result.Locations)),
+		zap.Int("num_discarded", result.Discarded),
+		zap.Bool("has_next_page", result.NextPageToken != ""),
+		zap.Bool("truncated", result.Truncated),
+	)
+	resp := buildPoISearchResultResponse(result)
+	return resp, nil
+}
+
+func (p *PoIRPCService) PlanChargingStops(
+	ctx context.Context,
+	request *poi_v1.PlanChargingStopsRequest,
+) (*poi_v1.PlanChargingStopsResponse, error) {
+	// handle context cancellation
+	if ctx.Err() != nil {
+		return nil, requestCenceledStatus
+	}
+	// validate request
+	if err := validatePlanChargingStopsRequest(request); err != nil {
+		return nil, err
+	}
+	correlationID, err := getCorrelationID(ctx)
+	if err != nil {
+		return nil, missingOrInvalidCorrelationIDStatus
+	}
+
+	// setting response header for client side tracing
+	_ = grpc.SendHeader(ctx, metadata.Pairs(correlationHeader, correlationID.String()))
+
+	// set correlationID for logging
+	logger := p.logger.With(
+		zap.String("correlation_id", correlationID.String()),
+		zap.String("rpc_method", "PlanChargingStops"),
+		zap.Int("num_route_points", len(request.Route)),
+		zap.Float64("usable_range_meters", request.UsableRangeMeters),
+	)
+	logger.Info(
+		"processing PlanChargingStops rpc",
+	)
+
+	// process request
+	path := coordinatesPathFromProto(request.Route)
+	corridor := request.CorridorMeters
+	if corridor == 0 {
+		corridor = defaultCorridorMeters
+	}
+	plan, err := p.locationService.PlanChargingStops(
+		ctx,
+		path,
+		vehicleFromProto(request),
+		logger,
+		poi.WithCorridor(corridor),
+	)
+
+	// handle errors accordingly
+	if errors.Is(err, poi.ErrInvalidSearchCoordinates) {
+		return nil, status.Errorf(codes.InvalidArgument, "%s: %v", invalidGeoParamsMessage, err)
+	}
+	if errors.Is(err, poi.ErrTooLargeSearchArea) {
+		return nil, status.Errorf(codes.OutOfRange, "%s: %v", tooLargeAreaMessage, err)
+	}
+	if errors.Is(err, poi.ErrNoReachableChargingStop) {
+		return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+	}
+	if err != nil {
+		logger.Error("unable to handle request", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "%s: %v", severErrMessage, err)
+	}
+
+	// log and return
+	logger.Info(
+		"returning response for PlanChargingStops RPC",
+		zap.Int("num_stops", len(plan.Stops)),
+	)
+	resp := buildPlanChargingStopsResponse(plan)
+	return resp, nil
+}
+
+func (p *PoIRPCService) Clusters(
+	ctx context.Context,
+	request *poi_v1.ClustersRequest,
+) (*poi_v1.ClustersResponse, error) {
+	// handle context cancellation
+	if ctx.Err() != nil {
+		return nil, requestCenceledStatus
+	}
+	// validate request
+	if err := validateClustersRequest(request); err != nil {
+		return nil, err
+	}
+	correlationID, err := getCorrelationID(ctx)
+	if err != nil {
+		return nil, missingOrInvalidCorrelationIDStatus
+	}
+
+	// setting response header for client side tracing
+	_ = grpc.SendHeader(ctx, metadata.Pairs(correlationHeader, correlationID.String()))
+
+	// set correlationID for logging
+	logger := p.logger.With(
+		zap.String("correlation_id", correlationID.String()),
+		zap.String("rpc_method", "Clusters"),
+		zap.Float64("ne.lat", request.Bbox.Ne.Lat),
+		zap.Float64("ne.lon", request.Bbox.Ne.Lon),
+		zap.Float64("sw.lat", request.Bbox.Sw.Lat),
+		zap.Float64("sw.lon", request.Bbox.Sw.Lon),
+		zap.Int32("zoom", request.Zoom),
+	)
+	logger.Info(
+		"processing Clusters rpc",
+	)
+
+	// process request
+	sw := poi.Coordinates{
+		Latitude:  request.Bbox.Sw.Lat,
+		Longitude: request.Bbox.Sw.Lon,
+	}
+	ne := poi.Coordinates{
+		Latitude:  request.Bbox.Ne.Lat,
+		Longitude: request.Bbox.Ne.Lon,
+	}
+	result, err := p.locationService.Clusters(ctx, sw, ne, int(request.Zoom), logger)
+
+	// handle errors accordingly
+	if errors.Is(err, poi.ErrInvalidSearchCoordinates) {
+		return nil, status.Errorf(codes.InvalidArgument, "%s: %v", invalidGeoParamsMessage, err)
+	}
+	if errors.Is(err, poi.ErrTooLargeSearchArea) {
+		return nil, status.Errorf(codes.OutOfRange, "%s: %v", tooLargeAreaMessage, err)
+	}
+	if err != nil {
+		logger.Error("unable to handle request", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "%s: %v", severErrMessage, err)
+	}
+
+	// log and return
+	logger.Info(
+		"returning response for Clusters RPC",
+		zap.Int("num_clusters", len(result.Clusters)),
+		zap.Bool("truncated", result.Truncated),
+	)
+	resp := buildClustersResponse(result)
+	return resp, nil
+}
+
+func (p *PoIRPCService) Density(
+	ctx context.Context,
+	request *poi_v1.DensityRequest,
+) (*poi_v1.DensityResponse, error) {
+	// handle context cancellation
+	if ctx.Err() != nil {
+		return nil, requestCenceledStatus
+	}
+	// validate request
+	if err := validateDensityRequest(request); err != nil {
+		return nil, err
+	}
+	correlationID, err := getCorrelationID(ctx)
+	if err != nil {
+		return nil, missingOrInvalidCorrelationIDStatus
+	}
+
+	// setting response header for client side tracing
+	_ = grpc.SendHeader(ctx, metadata.Pairs(correlationHeader, correlationID.String()))
+
+	// set correlationID for logging
+	logger := p.logger.With(
+		zap.String("correlation_id", correlationID.String()),
+		zap.String("rpc_method", "Density"),
+		zap.Int("num_rings", len(request.Rings)),
+		zap.Int32("level", request.Level),
+	)
+	logger.Info(
+		"processing Density rpc",
+	)
+
+	// process request
+	area := &poi.DensityArea{}
+	if len(request.Rings) > 0 {
+		area.Rings = make([][]poi.Coordinates, len(request.Rings))
+		for i, r := range request.Rings {
+			area.Rings[i] = coordinatesPathFromProto(r.Coordinates)
+		}
+	} else {
+		area.SW = poi.Coordinates{Latitude: request.Bbox.Sw.Lat, Longitude: request.Bbox.Sw.Lon}
+		area.NE = poi.Coordinates{Latitude: request.Bbox.Ne.Lat, Longitude: request.Bbox.Ne.Lon}
+	}
+	result, err := p.locationService.Density(ctx, area, int(request.Level), logger)
+
+	// handle errors accordingly
+	if errors.Is(err, poi.ErrInvalidSearchCoordinates) {
+		return nil, status.Errorf(codes.InvalidArgument, "%s: %v", invalidGeoParamsMessage, err)
+	}
+	if errors.Is(err, poi.ErrTooLargeSearchArea) {
+		return nil, status.Errorf(codes.OutOfRange, "%s: %v", tooLargeAreaMessage, err)
+	}
+	if err != nil {
+		logger.Error("unable to handle request", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "%s: %v", severErrMessage, err)
+	}
+
+	// log and return
+	logger.Info(
+		"returning response for Density RPC",
+		zap.Int("num_cells", len(result.Cells)),
+		zap.Bool("precomputed", result.Precomputed),
+		zap.Bool("truncated", result.Truncated),
+	)
+	resp := buildDensityResponse(result)
+	return resp, nil
+}
+
+func (p *PoIRPCService) StreamProximity(
+	request *poi_v1.ProximityRequest,
+	stream poi_v1.PoIService_StreamProximityServer,
+) error {
+	ctx := stream.Context()
+	// handle context cancellation
+	if ctx.Err() != nil {
+		return requestCenceledStatus
+	}
+	// validate request
+	if err := validateProximityRequest(request); err != nil {
+		return err
+	}
+	if err := validateStreamPage(request.PageSize, request.PageToken); err != nil {
+		return err
+	}
+	sortBy, err := sortOrderFromProto(request.SortBy, false)
+	if err != nil {
+		return err
+	}
+	filter, err := filterFromProto(request.Filter)
+	if err != nil {
+		return err
+	}
+	correlationID, err := getCorrelationID(ctx)
+	if err != nil {
+		return missingOrInvalidCorrelationIDStatus
+	}
+
+	// setting response header for client side tracing
+	_ = stream.SendHeader(metadata.Pairs(correlationHeader, correlationID.String()))
+
+	// set correlationID for logging
+	logger := p.logger.With(
+		zap.String("correlation_id", correlationID.String()),
+		zap.String("rpc_method", "StreamProximity"),
+		zap.Float64("lat", request.Center.Lat),
+		zap.Float64("lon", request.Center.Lon),
+	)
+	logger.Info(
+		"processing StreamProximity rpc",
+	)
+
+	// process request
+	cntr := poi.Coordinates{
+		Latitude:  request.Center.Lat,
+		Longitude: request.Center.Lon,
+	}
+	sent := 0
+	err = p.locationService.StreamProximity(
+		ctx,
+		cntr,
+		request.RadiusMeters,
+		logger,
+		func(result *poi.SearchResult) error {
+			sent += len(result.Locations)
+			return stream.Send(buildPoISearchResultResponse(result))
+		},
+		poi.WithStrict(request.Strict),
+		poi.WithSortBy(sortBy),
+		filter,
+	)
+	if err != nil {
+		return streamErrorStatus(err, logger)
+	}
+
+	logger.Info(
+		"finished stream for StreamProximity RPC",
+		zap.Int("num_locations", sent),
+	)
+	return nil
+}
+
+func (p *PoIRPCService) StreamBBox(
+	request *poi_v1.BBoxRequest,
+	stream poi_v1.PoIService_StreamBBoxServer,
+) error {
+	ctx := stream.Context()
+	// handle context cancellation
+	if ctx.Err() != nil {
+		return requestCenceledStatus
+	}
+	// validate the bbox request
+	if err := validateBBoxRequest(request); err != nil {
+		return err
+	}
+	if err := validateStreamPage(request.PageSize, request.PageToken); err != nil {
+		return err
+	}
+	sortBy, err := sortOrderFromProto(request.SortBy, false)
+	if err != nil {
+		return err
+	}
+	filter, err := filterFromProto(request.Filter)
+	if err != nil {
+		return err
+	}
+	correlationID, err := getCorrelationID(ctx)
+	if err != nil {
+		return missingOrInvalidCorrelationIDStatus
+	}
+
+	// setting response header for client side tracing
+	_ = stream.SendHeader(metadata.Pairs(correlationHeader, correlationID.String()))
+
+	// set correlationID for logging
+	logger := p.logger.With(
+		zap.String("correlation_id", correlationID.String()),
+		zap.String("rpc_method", "StreamBbox"),
+		zap.Float64("ne.lat", request.Bbox.Ne.Lat),
+		zap.Float64("ne.lon", request.Bbox.Ne.Lon),
+		zap.Float64("sw.lat", request.Bbox.Sw.Lat),
+		zap.Float64("sw.lon", request.Bbox.Sw.Lon),
+	)
+	logger.Info(
+		"processing StreamBBox rpc",
+	)
+
+	// process request
+	sw := poi.Coordinates{
+		Latitude:  request.Bbox.Sw.Lat,
+		Longitude: request.Bbox.Sw.Lon,
+	}
+	ne := poi.Coordinates{
+		Latitude:  request.Bbox.Ne.Lat,
+		Longitude: request.Bbox.Ne.Lon,
+	}
+	sent := 0
+	err = p.locationService.StreamBbox(
+		ctx,
+		sw,
+		ne,
+		logger,
+		func(result *poi.SearchResult) error {
+			sent += len(result.Locations)
+			return stream.Send(buildPoISearchResultResponse(result))
+		},
+		poi.WithStrict(request.Strict),
+		poi.WithSortBy(sortBy),
+		filter,
+	)
+	if err != nil {
+		return streamErrorStatus(err, logger)
+	}
+
+	logger.Info(
+		"finished stream for StreamBBox RPC",
+		zap.Int("num_locations", sent),
+	)
+	return nil
+}
+
+func (p *PoIRPCService) StreamRoute(
+	request *poi_v1.RouteRequest,
+	stream poi_v1.PoIService_StreamRouteServer,
+) error {
+	ctx := stream.Context()
+	// handle context cancellation
+	if ctx.Err() != nil {
+		return requestCenceledStatus
+	}
+	// validate request
+	if err := validateRouteRequest(request); err != nil {
+		return err
+	}
+	if err := validateStreamPage(request.PageSize, request.PageToken); err != nil {
+		return err
+	}
+	sortBy, err := sortOrderFromProto(request.SortBy, true)
+	if err != nil {
+		return err
+	}
+	filter, err := filterFromProto(request.Filter)
+	if err != nil {
+		return err
+	}
+	correlationID, err := getCorrelationID(ctx)
+	if err != nil {
+		return missingOrInvalidCorrelationIDStatus
+	}
+
+	// setting response header for client side tracing
+	_ = stream.SendHeader(metadata.Pairs(correlationHeader, correlationID.String()))
+
+	// set correlatioId for logging
+	logger := p.logger.With(
+		zap.String("correlation_id", correlationID.String()),
+		zap.String("rpc_method", "StreamRoute"),
+		zap.Int("num_route_points", len(request.Route)),
+		zap.Float64("corridor_meters", request.CorridorMeters),
+	)
+	logger.Info(
+		"processing StreamRoute rpc",
+	)
+
+	// process request
+	path := coordinatesPathFromProto(request.Route)
+	sent := 0
+	err = p.locationService.StreamRoute(
+		ctx,
+		path,
+		logger,
+		func(result *poi.SearchResult) error {
+			sent += len(result.Locations)
+			return stream.Send(buildPoISearchResultResponse(result))
+		},
+		poi.WithSortBy(sortBy),
+		filter,
+		poi.WithCorridor(request.CorridorMeters),
+	)
+	if err != nil {
+		return streamErrorStatus(err, logger)
+	}
+
+	logger.Info(
+		"finished stream for StreamRoute RPC",
+		zap.Int("num_locations", sent),
+	)
+	return nil
+}
+
+func (p *PoIRPCService) Register(server *grpc.Server) {
+	poi_v1.RegisterPoIServiceServer(server, p)
+}
+
+func (p *PoIRPCService) RegisterProxy(
+	ctx context.Context,
+	mux *runtime.ServeMux,
+	endpoint string,
+	opts []grpc.DialOption,
+) (err error) {
+	return poi_v1.RegisterPoIServiceHandlerFromEndpoint(
+		ctx,
+		mux,
+		endpoint,
+		opts,
+	)
+}
+
+func buildPoISearchResultResponse(r *poi.SearchResult) *poi_v1.PoISearchResponse {
+	items := make([]*poi_v1.PoI, len(r.Locations))
+	for i, l := range r.Locations {
+		items[i] = poiToProto(l)
+		if m, ok := r.Measures[l.ID]; ok {
+			setMeasure(items[i], m)
+		}
+	}
+	return &poi_v1.PoISearchResponse{
+		Items:           items,
+		DiscardedCount:  int32(r.Discarded), //nolint:gosec // bound by number of queried items
+		NextPageToken:   r.NextPageToken,
+		Truncated:       r.Truncated,
+		CoveredFraction: r.CoveredFraction,
+	}
+}
+
+func buildPlanChargingStopsResponse(plan *poi.ChargingPlan) *poi_v1.PlanChargingStopsResponse {
+	stops := make([]*poi_v1.ChargingStop, len(plan.Stops))
+	for i, st := range plan.Stops {
+		alternates := make([]*poi_v1.PoI, len(st.Alternates))
+		for j, a := range st.Alternates {
+			alternates[j] = poiToProto(a.Station)
+			setMeasure(alternates[j], a.Measure)
+		}
+		station := poiToProto(st.Station)
+		setMeasure(station, st.Measure)
+		stops[i] = &poi_v1.ChargingStop{
+			Station:                       station,
+			ArrivalStateOfChargePercent:   st.ArrivalCharge * 100,
+			DepartureStateOfChargePercent: st.DepartureCharge * 100,
+			Alternates:                    alternates,
+		}
+	}
+	return &poi_v1.PlanChargingStopsResponse{
+		Stops:                       stops,
+		ArrivalStateOfChargePercent: plan.ArrivalCharge * 100,
+		RouteLengthMeters:           plan.RouteLengthMeters,
+	}
+}
+
+func buildClustersResponse(r *poi.ClusterResult) *poi_v1.ClustersResponse {
+	clusters := make([]*poi_v1.Cluster, len(r.Clusters))
+	for i, c := range r.Clusters {
+		clusters[i] = &poi_v1.Cluster{
+			Centroid: &poi_v1.Coordinate{
+				Lat: c.Centroid.Latitude,
+				Lon: c.Centroid.Longitude,
+			},
+			Count:    int32(c.Count), //nolint:gosec // bound by number of queried items
+			Features: c.Features,
+		}
+	}
+	return &poi_v1.ClustersResponse{
+		Clusters:        clusters,
+		Truncated:       r.Truncated,
+		CoveredFraction: r.CoveredFraction,
+	}
+}
+
+func buildDensityResponse(r *poi.DensityResult) *poi_v1.DensityResponse {
+	cells := make([]*poi_v1.DensityCell, len(r.Cells))
+	for i, c := range r.Cells {
+		cells[i] = &poi_v1.DensityCell{
+			Token: c.Cell.ToToken(),
+			Center: &poi_v1.Coordinate{
+				Lat: c.Center.Latitude,
+				Lon: c.Center.Longitude,
+			},
+			Count:        int32(c.Count),        //nolint:gosec // bound by number of stored items
+			ChargePoints: int32(c.ChargePoints), //nolint:gosec // bound by number of stored items
+			PowerKw:      c.PowerKW,
+		}
+	}
+	return &poi_v1.DensityResponse{
+		Level:           int32(r.Level), //nolint:gosec // bound by the max level
+		Cells:           cells,
+		Precomputed:     r.Precomputed,
+		Truncated:       r.Truncated,
+		CoveredFraction: r.CoveredFraction,
+	}
+}
+
+// setMeasure sets the position of the PoI relative to the search reference
+func setMeasure(item *poi_v1.PoI, m poi.Measure) {
+	item.DistanceMeters = &m.DistanceMeters
+	item.BearingDegrees = &m.BearingDegrees
+	if m.AlongRoute {
+		item.RouteFraction = &m.RouteFraction
+		item.DistanceFromStartMeters = &m.DistanceFromStartMeters
+	}
+}
+
+func poiToProto(p *poi.PoILocation) *poi_v1.PoI {
+	return &poi_v1.PoI{
+		Id: p.ID.String(),
+		Coordinate: &poi_v1.Coordinate{
+			Lat: p.Location.Latitude,
+			Lon: p.Location.Longitude,
+		},
+		Address: &poi_v1.Address{
+			Street:       p.Address.Street,
+			StreetNumber: p.Address.StreetNumber,
+			ZipCode:      p.Address.ZipCode,
+			City:         p.Address.City,
+			Country:      p.Address.CountryCode,
+		},
+		Features: p.Features,
+		Charging: chargingInfoToProto(p.Charging),
+		Version:  p.Version,
+		Entrance: &poi_v1.Coordinate{
+			Lat: p.LocationEntrance.Latitude,
+			Lon: p.LocationEntrance.Longitude,
+		},
+	}
+}
+
+func chargingInfoToProto(c *poi.ChargingInfo) *poi_v1.ChargingInfo {
+	if c == nil {
+		return nil
+	}
+	connectors := make([]*poi_v1.Connector, len(c.Connectors))
+	for i, cn := range c.Connectors {
+		connectors[i] = &poi_v1.Connector{
+			PlugType:    cn.PlugType,
+			CurrentType: currentToProto(cn.Current),
+		}
+	}
+	return &poi_v1.ChargingInfo{
+		MaxPowerKw:       c.MaxPowerKW,
+		ChargePointCount: int32(c.ChargePoints), //nolint:gosec // bound by the source data
+		Connectors:       connectors,
+	}
+}
+
+func validateProximityRequest(request *poi_v1.ProximityRequest) error {
+	if request == nil || request.Center == nil {
+		return status.Errorf(
+			codes.InvalidArgument,
+			"center must be given to perform proximity search",
+		)
+	}
+	if request.RadiusMeters < minSearchRadiusMeters ||
+		request.RadiusMeters > maxSearchRadiusMeters {
+		return status.Errorf(
+			codes.InvalidArgument,
+			"invalid radius: radius=%f must be between 1000 m (1 km) and 100000 m (100 km)",
+			request.RadiusMeters,
+		)
+	}
+	return nil
+}
+
+func validateBBoxRequest(request *poi_v1.BBoxRequest) error {
+	if request == nil || request.Bbox == nil || request.Bbox.Sw == nil || request.Bbox.Ne == nil {
+		return status.Errorf(codes.InvalidArgument, "bounding box coordinates must be defined")
+	}
+	return nil
+}
+
+func validateClustersRequest(request *poi_v1.ClustersRequest) error {
+	if request == nil || request.Bbox == nil || request.Bbox.Sw == nil || request.Bbox.Ne == nil {
+		return status.Errorf(codes.InvalidArgument, "bounding box coordinates must be defined")
+	}
+	if request.Zoom < minZoom || request.Zoom > maxZoom {
+		return status.Errorf(
+			codes.InvalidArgument,
+			"invalid zoom: zoom=%d must be between 0 and 22",
+			request.Zoom,
+		)
+	}
+	return nil
+}
+
+// validateDensityRequest requires either the bounding box or the rings of a polygon
+func validateDensityRequest(request *poi_v1.DensityRequest) error {
+	if request == nil {
+		return status.Errorf(codes.InvalidArgument, "bounding box coordinates must be defined")
+	}
+	if len(request.Rings) > 0 {
+		if request.Bbox != nil {
+			return status.Errorf(codes.InvalidArgument, "either a bounding box or a polygon must be defined, not both")
+		}
+		if err := validatePolygonRequest(&poi_v1.PolygonRequest{Rings: request.Rings}); err != nil {
+			return err
+		}
+	} else if request.Bbox == nil || request.Bbox.Sw == nil || request.Bbox.Ne == nil {
+		return status.Errorf(codes.InvalidArgument, "bounding box coordinates must be defined")
+	}
+	if request.Level < minDensityLevel || request.Level > maxDensityLevel {
+		return status.Errorf(
+			codes.InvalidArgument,
+			"invalid level: level=%d must be between %d and %d",
+			request.Level,
+			minDensityLevel,
+			maxDensityLevel,
+		)
+	}
+	return nil
+}
+
+func validateRouteRequest(request *poi_v1.RouteRequest) error {
+	if request == nil || request.Route == nil || len(request.Route) < 2 ||
+		len(request.Route) > 100 {
+		return status.Errorf(
+			codes.InvalidArgument,
+			"a route must at least have two coordinates and not more then 100",
+		)
+	}
+	if request.CorridorMeters != 0 && (request.CorridorMeters < minCorridorMeters ||
+		request.CorridorMeters > maxCorridorMeters) {
+		return status.Errorf(
+			codes.InvalidArgument,
+			"invalid corridor: corridor=%f must be zero or between 100 m and 50000 m (50 km)",
+			request.CorridorMeters,
+		)
+	}
+	return nil
+}
+
+func validatePlanChargingStopsRequest(request *poi_v1.PlanChargingStopsRequest) error {
+	if request == nil {
+		return status.Errorf(codes.InvalidArgument, "a route and the vehicle must be given")
+	}
+	if err := validateRouteRequest(
+		&poi_v1.RouteRequest{Route: request.Route, CorridorMeters: request.CorridorMeters},
+	); err != nil {
+		return err
+	}
+	if request.StateOfChargePercent < 0 || request.StateOfChargePercent > 100 {
+		return status.Errorf(
+			codes.InvalidArgument,
+			"invalid state of charge: state_of_charge_percent=%f must be between 0 and 100",
+			request.StateOfChargePercent,
+		)
+	}
+	if request.UsableRangeMeters < minRangeMeters || request.UsableRangeMeters > maxRangeMeters {
+		return status.Errorf(
+			codes.InvalidArgument,
+			"invalid range: usable_range_meters=%f must be between 10000 m (10 km) and 2000000 m (2000 km)",
+			request.UsableRangeMeters,
+		)
+	}
+	if request.ReservePercent < 0 || request.ReservePercent > maxReservePercent {
+		return status.Errorf(
+			codes.InvalidArgument,
+			"invalid reserve: reserve_percent=%f must be between 0 and %.0f",
+			request.ReservePercent,
+			maxReservePercent,
+		)
+	}
+	if request.TargetPercent != 0 &&
+		(request.TargetPercent <= request.ReservePercent || request.TargetPercent > 100) {
+		return status.Errorf(
+			codes.InvalidArgument,
+			"invalid target: target_percent=%f must be greater than the reserve and not more than 100",
+			request.TargetPercent,
+		)
+	}
+	if request.MinPowerKw < 0 {
+		return status.Errorf(
+			codes.InvalidArgument,
+			"invalid power: min_power_kw=%f must not be negative",
+			request.MinPowerKw,
+		)
+	}
+	if _, ok := poi_v1.ConnectorType_name[int32(request.Connector)]; !ok {
+		return status.Errorf(codes.InvalidArgument, "unknown connector type: %s", request.Connector)
+	}
+	return nil
+}
+
+func validatePolygonRequest(request *poi_v1.PolygonRequest) error {
+	if request == nil || len(request.Rings) < 1 || len(request.Rings) > maxPolygonRings {
+		return status.Errorf(
+			codes.InvalidArgument,
+			"a polygon must at least have one ring and not more than %d",
+			maxPolygonRings,
+		)
+	}
+	for i, r := range request.Rings {
+		if r == nil || len(r.Coordinates) < minRingCoordinates ||
+			len(r.Coordinates) > maxRingCoordinates {
+			return status.Errorf(
+				codes.InvalidArgument,
+				"invalid ring %d: a ring must at least have %d coordinates and not more than %d",
+				i,
+				minRingCoordinates,
+				maxRingCoordinates,
+			)
+		}
+		for _, c := range r.Coordinates {
+			if c == nil {
+				return status.Errorf(codes.InvalidArgument, "invalid ring %d: coordinates must be defined", i)
+			}
+		}
+	}
+	return nil
+}
+
+func vehicleFromProto(request *poi_v1.PlanChargingStopsRequest) *poi.Vehicle {
+	target := request.TargetPercent
+	if target == 0 {
+		target = defaultTargetPercent
+	}
+	return &poi.Vehicle{
+		StateOfCharge:     request.StateOfChargePercent / 100,
+		UsableRangeMeters: request.UsableRangeMeters,
+		ReserveCharge:     request.ReservePercent / 100,
+		TargetCharge:      target / 100,
+		MinPowerKW:        request.MinPowerKw,
+		Current:           currentFromProto(request.Connector),
+	}
+}
+
+func currentFromProto(c poi_v1.ConnectorType) poi.Current {
+	switch c {
+	case poi_v1.ConnectorType_CONNECTOR_TYPE_AC:
+		return poi.CurrentAC
+	case poi_v1.ConnectorType_CONNECTOR_TYPE_DC:
+		return poi.CurrentDC
+	case poi_v1.ConnectorType_CONNECTOR_TYPE_UNSPECIFIED:
+	}
+	return poi.CurrentAny
+}
+
+func currentToProto(c poi.Current) poi_v1.ConnectorType {
+	switch c {
+	case poi.CurrentAC:
+		return poi_v1.ConnectorType_CONNECTOR_TYPE_AC
+	case poi.CurrentDC:
+		return poi_v1.ConnectorType_CONNECTOR_TYPE_DC
+	case poi.CurrentAny:
+	}
+	return poi_v1.ConnectorType_CONNECTOR_TYPE_UNSPECIFIED
+}
+
+func sortOrderFromProto(s poi_v1.SortBy, route bool) (poi.SortOrder, error) {
+	switch s {
+	case poi_v1.SortBy_SORT_BY_UNSPECIFIED:
+		// route searches are ordered along the trip by default
+		if route {
+			return poi.SortByRoutePosition, nil
+		}
+		return poi.SortByDistance, nil
+	case poi_v1.SortBy_SORT_BY_DISTANCE:
+		return poi.SortByDistance, nil
+	case poi_v1.SortBy_SORT_BY_ID:
+		return poi.SortByID, nil
+	case poi_v1.SortBy_SORT_BY_ROUTE_POSITION:
+		if route {
+			return poi.SortByRoutePosition, nil
+		}
+		return 0, status.Errorf(
+			codes.InvalidArgument,
+			"invalid sort order: %s is only supported for route searches",
+			s,
+		)
+	default:
+		return 0, status.Errorf(codes.InvalidArgument, "unknown sort order: %s", s)
+	}
+}
+
+func filterFromProto(f *poi_v1.SearchFilter) (poi.SearchOption, error) {
+	if f == nil {
+		return poi.WithFilter(nil), nil
+	}
+	if f.MinPowerKw < 0 {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"invalid filter: min_power_kw=%f must not be negative",
+			f.MinPowerKw,
+		)
+	}
+	currents := make([]poi.Current, len(f.ConnectorTypes))
+	for i, c := range f.ConnectorTypes {
+		if c != poi_v1.ConnectorType_CONNECTOR_TYPE_AC && c != poi_v1.ConnectorType_CONNECTOR_TYPE_DC {
+			return nil, status.Errorf(
+				codes.InvalidArgument,
+				"invalid filter: connector type %s is not supported",
+				c,
+			)
+		}
+		currents[i] = currentFromProto(c)
+	}
+	return poi.WithFilter(&poi.SearchFilter{
+		Features:     f.Features,
+		MinPowerKW:   f.MinPowerKw,
+		Currents:     currents,
+		PlugTypes:    f.PlugTypes,
+		CountryCodes: f.Countries,
+	}), nil
+}
+
+// poiFieldUpdates are the fields of a PoI which can be updated by their update mask path
+var poiFieldUpdates = map[string]func(p *poi_v1.PoI, l *poi.PoILocation){
+	"coordinate": func(p *poi_v1.PoI, l *poi.PoILocation) {
+		l.Location = poi.Coordinates{Latitude: p.Coordinate.Lat, Longitude: p.Coordinate.Lon}
+	},
+	"entrance": func(p *poi_v1.PoI, l *poi.PoILocation) {
+		// a missing entrance is set to the coordinate by the validation
+		l.LocationEntrance = poi.Coordinates{Latitude: p.GetEntrance().GetLat(), Longitude: p.GetEntrance().GetLon()}
+	},
+	"address": func(p *poi_v1.PoI, l *poi.PoILocation) {
+		l.Address = addressFromProto(p.Address)
+	},
+	"address.street":        func(p *poi_v1.PoI, l *poi.PoILocation) { l.Address.Street = p.GetAddress().GetStreet() },
+	"address.street_number": func(p *poi_v1.PoI, l *poi.PoILocation) { l.Address.StreetNumber = p.GetAddress().GetStreetNumber() },
+	"address.zip_code":      func(p *poi_v1.PoI, l *poi.PoILocation) { l.Address.ZipCode = p.GetAddress().GetZipCode() },
+	"address.city":          func(p *poi_v1.PoI, l *poi.PoILocation) { l.Address.City = p.GetAddress().GetCity() },
+	"address.country":       func(p *poi_v1.PoI, l *poi.PoILocation) { l.Address.CountryCode = p.GetAddress().GetCountry() },
+	"features": func(p *poi_v1.PoI, l *poi.PoILocation) {
+		l.Features = p.Features
+	},
+	"charging": func(p *poi_v1.PoI, l *poi.PoILocation) {
+		l.Charging = chargingInfoFromProto(p.Charging)
+	},
+}
+
+// updateFromProto returns the update of a stored location with the fields of the update mask, an empty mask
+// replaces all fields
+func updateFromProto(p *poi_v1.PoI, mask *fieldmaskpb.FieldMask) (func(l *poi.PoILocation), error) {
+	paths := mask.GetPaths()
+	if len(paths) == 0 {
+		paths = []string{"coordinate", "entrance", "address", "features", "charging"}
+	}
+	updates := make([]func(p *poi_v1.PoI, l *poi.PoILocation), len(paths))
+	for i, path := range paths {
+		update, ok := poiFieldUpdates[path]
+		if !ok {
+			return nil, status.Errorf(
+				codes.InvalidArgument,
+				"invalid update mask: path=%s is unknown or can not be updated",
+				path,
+			)
+		}
+		if path == "coordinate" && p.Coordinate == nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid update mask: coordinate is not set")
+		}
+		updates[i] = update
+	}
+	return func(l *poi.PoILocation) {
+		for _, update := range updates {
+			update(p, l)
+		}
+	}, nil
+}
+
+func locationFromProto(p *poi_v1.PoI) (*poi.PoILocation, error) {
+	id := ksuid.Nil
+	if p.Id != "" {
+		var err error
+		if id, err = ksuid.Parse(p.Id); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid location id format: given id=%s", p.Id)
+		}
+	}
+	location := &poi.PoILocation{
+		ID:       id,
+		Location: poi.Coordinates{Latitude: p.Coordinate.Lat, Longitude: p.Coordinate.Lon},
+		Address:  addressFromProto(p.Address),
+		Features: p.Features,
+		Charging: chargingInfoFromProto(p.Charging),
+	}
+	if p.Entrance != nil {
+		location.LocationEntrance = poi.Coordinates{Latitude: p.Entrance.Lat, Longitude: p.Entrance.Lon}
+	}
+	return location, nil
+}
+
+func idsFromProto(ids []string) ([]ksuid.KSUID, error) {
+	if len(ids) == 0 || len(ids) > maxBatchGetIDs {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"invalid number of ids: num_ids=%d must be between 1 and %d",
+			len(ids),
+			maxBatchGetIDs,
+		)
+	}
+	kIDs := make([]ksuid.KSUID, len(ids))
+	for i, id := range ids {
+		kID, err := ksuid.Parse(id)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid location id format: given id=%s", id)
+		}
+		kIDs[i] = kID
+	}
+	return kIDs, nil
+}
+
+// etagFromVersion returns the strong entity tag of the version of a location
+func etagFromVersion(version int64) string {
+	return strconv.Quote(strconv.FormatInt(version, 10))
+}
+
+// versionFromEtag returns the version of the entity tag, any version for an empty tag
+func versionFromEtag(etag string) (int64, error) {
+	if etag == "" {
+		return poi.AnyVersion, nil
+	}
+	v, err := strconv.Unquote(etag)
+	if err != nil {
+		return 0, status.Errorf(codes.InvalidArgument, "invalid etag: etag=%s", etag)
+	}
+	version, err := strconv.ParseInt(v, 10, 64)
+	if err != nil || version < 0 {
+		return 0, status.Errorf(codes.InvalidArgument, "invalid etag: etag=%s", etag)
+	}
+	return version, nil
+}
+
+func addressFromProto(a *poi_v1.Address) poi.Address {
+	return poi.Address{
+		Street:       a.GetStreet(),
+		StreetNumber: a.GetStreetNumber(),
+		ZipCode:      a.GetZipCode(),
+		City:         a.GetCity(),
+		CountryCode:  a.GetCountry(),
+	}
+}
+
+func chargingInfoFromProto(c *poi_v1.ChargingInfo) *poi.ChargingInfo {
+	if c == nil {
+		return nil
+	}
+	connectors := make([]poi.ChargingConnector, len(c.Connectors))
+	for i, cn := range c.Connectors {
+		connectors[i] = poi.ChargingConnector{
+			PlugType: cn.PlugType,
+			Current:  currentFromProto(cn.CurrentType),
+		}
+	}
+	return &poi.ChargingInfo{
+		MaxPowerKW:   c.MaxPowerKw,
+		ChargePoints: int(c.ChargePointCount),
+		Connectors:   connectors,
+	}
+}
+
+func pageFromProto(size int32, token string) (poi.SearchOption, error) {
+	if size < 0 || size > maxPageSize {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"invalid page size: page_size=%d must be between 0 and %d",
+			size,
+			maxPageSize,
+		)
+	}
+	if size == 0 && token != "" {
+		size = defaultPageSize
+	}
+	return poi.WithPage(int(size), token), nil
+}
 
 func coordinatesPathFromProto(c []*poi_v1.Coordinate) []poi.Coordinates {
 	path := make([]poi.Coordinates, len(c))
@@ -353,3 +1817,33 @@ func coordinatesPathFromProto(c []*poi_v1.Coordinate) []poi.Coordinates {
 	}
 	return path
 }
+
+// validateStreamPage rejects pagination for streamed searches, a stream always delivers all locations
+func validateStreamPage(size int32, token string) error {
+	if size != 0 || token != "" {
+		return status.Errorf(
+			codes.InvalidArgument,
+			"pagination is not supported for streamed searches, page_size and page_token must be empty",
+		)
+	}
+	return nil
+}
+
+// streamErrorStatus maps the error of a failed stream to the corresponding status
+func streamErrorStatus(err error, logger *zap.Logger) error {
+	if errors.Is(err, poi.ErrInvalidSearchCoordinates) {
+		return status.Errorf(codes.InvalidArgument, "%s: %v", invalidGeoParamsMessage, err)
+	}
+	if errors.Is(err, poi.ErrTooLargeSearchArea) {
+		return status.Errorf(codes.OutOfRange, "%s: %v", tooLargeAreaMessage, err)
+	}
+	if errors.Is(err, context.Canceled) {
+		return requestCenceledStatus
+	}
+	// errors of the stream itself are already a status
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	logger.Error("unable to handle request", zap.Error(err))
+	return status.Errorf(codes.Internal, "%s: %v", severErrMessage, err)
+}
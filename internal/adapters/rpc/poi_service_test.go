@@ -4,8 +4,11 @@ import (
 	"context"
 	"net/http"
 	"os"
+	"strconv"
+	"strings"
 	"time"
 
+	"github.com/golang/geo/s2"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"google.golang.org/grpc/codes"
@@ -28,12 +31,10 @@ var routeFixtureCoordinates = []*poiv1.Coordinate{
 }
 
 var _ = Describe("given location search request", Ordered, func() {
-	ctx := context.Background()
 	appCtx := context.Background()
 	appCtxCancel, cancel := context.WithCancel(appCtx)
 
 	var runner *core.ApplicationRunner
-	var container *test.DynamoContainer
 	var rpcTestClient *test.PoIRPCClient
 	var restTestClient *test.PoIHTTPProxyClient
 
@@ -41,11 +42,7 @@ var _ = Describe("given location search request", Ordered, func() {
 		err := os.Chdir("../../../")
 		Expect(err).To(Not(HaveOccurred()))
 
-		container = test.NewDynamoContainer(ctx)
-
-		os.Setenv("DYNAMOLOCAL_HOST", container.Host())
-		os.Setenv("DYNAMOLOCAL_PORT", container.Port())
-		os.Setenv("BOOT_PROFILE_ACTIVE", "test")
+		os.Setenv("BOOT_PROFILE_ACTIVE", "memory")
 
 		runner = core.NewApplicationRunner(core.WithApplicationContext(appCtxCancel))
 		Expect(runner).To(Not(BeNil()))
@@ -146,6 +143,51 @@ var _ = Describe("given location search request", Ordered, func() {
 			Expect(actualStatus.Code()).To(Equal(codes.NotFound))
 		})
 
+		// BatchGetPoIs RPC
+		It("poi batch get returns found pois in order and missing ids", func() {
+			missingID := "3yfD9hciu5kGIGdGXjPuJy3tUvH"
+			all, err := rpcTestClient.Bbox(
+				&poiv1.Coordinate{Lon: 10.040508, Lat: 50.089540},
+				&poiv1.Coordinate{Lon: 8.494772, Lat: 49.425026},
+				true,
+				true,
+				"",
+			)
+			Expect(err).To(Not(HaveOccurred()))
+			ids := make([]string, 0, len(all.Items)+2)
+			for _, item := range all.Items {
+				ids = append(ids, item.Id)
+			}
+			ids = append(ids, missingID, ids[0])
+			resp, err := rpcTestClient.BatchGetPoIs(ids, true, true, "")
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(resp.MissingIds).To(Equal([]string{missingID}))
+			Expect(resp.Items).To(HaveLen(len(all.Items)))
+			for i, item := range resp.Items {
+				Expect(item.Id).To(Equal(ids[i]))
+			}
+			Expect(resp.Etags).To(HaveLen(len(resp.Items)))
+			single, err := rpcTestClient.PoI(ids[0], true, true, "")
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(resp.Etags[0]).To(Equal(single.Etag))
+		})
+
+		It("poi batch get without ids returns invalid arguments", func() {
+			_, err := rpcTestClient.BatchGetPoIs(nil, true, true, "")
+			Expect(err).To(HaveOccurred())
+			actualStatus, ok := status.FromError(err)
+			Expect(ok).To(BeTrue())
+			Expect(actualStatus.Code()).To(Equal(codes.InvalidArgument))
+		})
+
+		It("poi batch get with invalid id format returns invalid arguments", func() {
+			_, err := rpcTestClient.BatchGetPoIs([]string{testDataID, "not a ksuid"}, true, true, "")
+			Expect(err).To(HaveOccurred())
+			actualStatus, ok := status.FromError(err)
+			Expect(ok).To(BeTrue())
+			Expect(actualStatus.Code()).To(Equal(codes.InvalidArgument))
+		})
+
 		// Bbox RPC
 		It("poi rpc bbox search returns result correctly", func() {
 			// large geographic area
@@ -169,6 +211,105 @@ var _ = Describe("given location search request", Ordered, func() {
 			Expect(numPois).To(BeNumerically(">", 50))
 		})
 
+		It("poi rpc strict bbox search discards locations outside bbox", func() {
+			sw := &poiv1.Coordinate{Lon: 8.494772, Lat: 49.425026}
+			ne := &poiv1.Coordinate{Lon: 10.040508, Lat: 50.089540}
+			all, err := rpcTestClient.Bbox(ne, sw, true, true, "")
+			Expect(err).To(Not(HaveOccurred()))
+			resp, err := rpcTestClient.StrictBbox(ne, sw, true, true, "")
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(len(resp.Items) + int(resp.DiscardedCount)).To(Equal(len(all.Items)))
+		})
+
+		It("poi rpc filtered bbox search returns only items matching filter", func() {
+			ne := &poiv1.Coordinate{Lon: 10.040508, Lat: 50.089540}
+			sw := &poiv1.Coordinate{Lon: 8.494772, Lat: 49.425026}
+			all, err := rpcTestClient.Bbox(ne, sw, true, true, "")
+			Expect(err).To(Not(HaveOccurred()))
+			resp, err := rpcTestClient.FilteredBbox(
+				ne,
+				sw,
+				&poiv1.SearchFilter{
+					MinPowerKw:     50,
+					ConnectorTypes: []poiv1.ConnectorType{poiv1.ConnectorType_CONNECTOR_TYPE_DC},
+				},
+				true,
+				true,
+				"",
+			)
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(resp.Items).To(Not(BeEmpty()))
+			Expect(len(resp.Items)).To(BeNumerically("<", len(all.Items)))
+			for _, item := range resp.Items {
+				Expect(item.Features).To(ContainElement("DC_CHARGING"))
+				Expect(item.Features).To(ContainElement(Satisfy(func(f string) bool {
+					kw, found := strings.CutSuffix(f, "_KW_CHARGING")
+					power, err := strconv.Atoi(kw)
+					return found && err == nil && power >= 50
+				})))
+			}
+		})
+
+		It("poi rpc filtered bbox search with invalid connector type returns invalid argument", func() {
+			ne := &poiv1.Coordinate{Lon: 10.040508, Lat: 50.089540}
+			sw := &poiv1.Coordinate{Lon: 8.494772, Lat: 49.425026}
+			_, err := rpcTestClient.FilteredBbox(
+				ne,
+				sw,
+				&poiv1.SearchFilter{
+					ConnectorTypes: []poiv1.ConnectorType{poiv1.ConnectorType_CONNECTOR_TYPE_UNSPECIFIED},
+				},
+				true,
+				true,
+				"",
+			)
+			Expect(err).To((HaveOccurred()))
+			actualStatus, ok := status.FromError(err)
+			Expect(ok).To(BeTrue())
+			Expect(actualStatus.Code()).To(Equal(codes.InvalidArgument))
+		})
+
+		It("poi rpc paged bbox search returns all items page by page", func() {
+			ne := &poiv1.Coordinate{Lon: 10.040508, Lat: 50.089540}
+			sw := &poiv1.Coordinate{Lon: 8.494772, Lat: 49.425026}
+			all, err := rpcTestClient.Bbox(ne, sw, true, true, "")
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(all.NextPageToken).To(BeEmpty())
+			numItems := 0
+			token := ""
+			for {
+				resp, errP := rpcTestClient.PagedBbox(ne, sw, 20, token, true, true, "")
+				Expect(errP).To(Not(HaveOccurred()))
+				Expect(len(resp.Items)).To(BeNumerically("<=", 20))
+				numItems += len(resp.Items)
+				if resp.NextPageToken == "" {
+					break
+				}
+				token = resp.NextPageToken
+			}
+			Expect(numItems).To(Equal(len(all.Items)))
+		})
+
+		It("poi rpc paged bbox search with invalid page size results in invalid arguments", func() {
+			ne := &poiv1.Coordinate{Lon: 10.040508, Lat: 50.089540}
+			sw := &poiv1.Coordinate{Lon: 8.494772, Lat: 49.425026}
+			_, err := rpcTestClient.PagedBbox(ne, sw, 1000, "", true, true, "")
+			Expect(err).To((HaveOccurred()))
+			actualStatus, ok := status.FromError(err)
+			Expect(ok).To(BeTrue())
+			Expect(actualStatus.Code()).To(Equal(codes.InvalidArgument))
+		})
+
+		It("poi rpc paged bbox search with invalid page token results in invalid arguments", func() {
+			ne := &poiv1.Coordinate{Lon: 10.040508, Lat: 50.089540}
+			sw := &poiv1.Coordinate{Lon: 8.494772, Lat: 49.425026}
+			_, err := rpcTestClient.PagedBbox(ne, sw, 20, "invalid.token", true, true, "")
+			Expect(err).To((HaveOccurred()))
+			actualStatus, ok := status.FromError(err)
+			Expect(ok).To(BeTrue())
+			Expect(actualStatus.Code()).To(Equal(codes.InvalidArgument))
+		})
+
 		It("poi http bbox search returns result correctly", func() {
 			// large geographic area
 			sw := test.CoordinatesHTTP{Lon: 8.494772, Lat: 49.425026}
@@ -269,6 +410,70 @@ var _ = Describe("given location search request", Ordered, func() {
 			Expect(numPois).To(BeNumerically(">", 3))
 		})
 
+		It("poi rpc strict proximity search discards locations outside radius", func() {
+			cntr := &poiv1.Coordinate{Lon: 9.147263, Lat: 49.333418}
+			radiusmeter := 30_000.0 // 30km
+			all, err := rpcTestClient.Proximity(cntr, radiusmeter, true, true, "")
+			Expect(err).To(Not(HaveOccurred()))
+			resp, err := rpcTestClient.StrictProximity(cntr, radiusmeter, true, true, "")
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(resp.DiscardedCount).To(BeNumerically(">", 0))
+			Expect(len(resp.Items) + int(resp.DiscardedCount)).To(Equal(len(all.Items)))
+		})
+
+		It("poi rpc proximity search returns items sorted by distance", func() {
+			cntr := &poiv1.Coordinate{Lon: 9.147263, Lat: 49.333418}
+			radiusmeter := 30_000.0 // 30km
+			resp, err := rpcTestClient.Proximity(cntr, radiusmeter, true, true, "")
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(resp.Items).To(Not(BeEmpty()))
+			for i, item := range resp.Items {
+				Expect(item.DistanceMeters).To(Not(BeNil()))
+				Expect(item.RouteFraction).To(BeNil())
+				Expect(item.GetBearingDegrees()).To(BeNumerically(">=", 0))
+				Expect(item.GetBearingDegrees()).To(BeNumerically("<", 360))
+				if i > 0 {
+					Expect(item.GetDistanceMeters()).
+						To(BeNumerically(">=", resp.Items[i-1].GetDistanceMeters()))
+				}
+			}
+		})
+
+		It("poi rpc proximity search sorted by id returns items in id order", func() {
+			cntr := &poiv1.Coordinate{Lon: 9.147263, Lat: 49.333418}
+			radiusmeter := 30_000.0 // 30km
+			resp, err := rpcTestClient.SortedProximity(
+				cntr,
+				radiusmeter,
+				poiv1.SortBy_SORT_BY_ID,
+				true,
+				true,
+				"",
+			)
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(resp.Items).To(Not(BeEmpty()))
+			for i := 1; i < len(resp.Items); i++ {
+				Expect(resp.Items[i].Id > resp.Items[i-1].Id).To(BeTrue())
+			}
+		})
+
+		It("poi rpc proximity search sorted by route position returns invalid arguments", func() {
+			cntr := &poiv1.Coordinate{Lon: 9.147263, Lat: 49.333418}
+			radiusmeter := 30_000.0 // 30km
+			_, err := rpcTestClient.SortedProximity(
+				cntr,
+				radiusmeter,
+				poiv1.SortBy_SORT_BY_ROUTE_POSITION,
+				true,
+				true,
+				"",
+			)
+			Expect(err).To((HaveOccurred()))
+			actualStatus, ok := status.FromError(err)
+			Expect(ok).To(BeTrue())
+			Expect(actualStatus.Code()).To(Equal(codes.InvalidArgument))
+		})
+
 		It("poi rpc proximity search with too large radius returns invalid arguments", func() {
 			// gigantic search radius
 			cntr := &poiv1.Coordinate{Lon: 9.147263, Lat: 49.333418}
@@ -319,6 +524,120 @@ var _ = Describe("given location search request", Ordered, func() {
 			Expect(len(items)).To(BeNumerically(">", 10))
 		})
 
+		It("poi rpc route search returns items sorted by position along route", func() {
+			route := routeFixtureCoordinates
+			resp, err := rpcTestClient.Route(route, true, true, "")
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(len(resp.Items)).To(BeNumerically(">", 0))
+			for i, item := range resp.Items {
+				Expect(item.RouteFraction).To(Not(BeNil()))
+				Expect(item.DistanceFromStartMeters).To(Not(BeNil()))
+				Expect(item.GetRouteFraction()).To(BeNumerically(">=", 0.0))
+				Expect(item.GetRouteFraction()).To(BeNumerically("<=", 1.0))
+				if i > 0 {
+					Expect(item.GetDistanceFromStartMeters()).
+						To(BeNumerically(">=", resp.Items[i-1].GetDistanceFromStartMeters()))
+				}
+			}
+		})
+
+		It("poi rpc route search returns items sorted by distance to route", func() {
+			route := routeFixtureCoordinates
+			resp, err := rpcTestClient.SortedRoute(
+				route,
+				poiv1.SortBy_SORT_BY_DISTANCE,
+				true,
+				true,
+				"",
+			)
+			Expect(err).To(Not(HaveOccurred()))
+			for i := 1; i < len(resp.Items); i++ {
+				Expect(resp.Items[i].GetDistanceMeters()).
+					To(BeNumerically(">=", resp.Items[i-1].GetDistanceMeters()))
+			}
+		})
+
+		It("poi rpc route search sorted by route position returns the same items", func() {
+			route := routeFixtureCoordinates
+			byDistance, err := rpcTestClient.SortedRoute(
+				route,
+				poiv1.SortBy_SORT_BY_DISTANCE,
+				true,
+				true,
+				"",
+			)
+			Expect(err).To(Not(HaveOccurred()))
+			resp, err := rpcTestClient.SortedRoute(
+				route,
+				poiv1.SortBy_SORT_BY_ROUTE_POSITION,
+				true,
+				true,
+				"",
+			)
+			Expect(err).To(Not(HaveOccurred()))
+			ids := make([]string, len(byDistance.Items))
+			for i, item := range byDistance.Items {
+				ids[i] = item.Id
+			}
+			sortedIDs := make([]string, len(resp.Items))
+			for i, item := range resp.Items {
+				sortedIDs[i] = item.Id
+			}
+			Expect(sortedIDs).To(ConsistOf(ids))
+		})
+
+		It("poi rpc route search with corridor returns only items within corridor", func() {
+			route := routeFixtureCoordinates
+			all, err := rpcTestClient.Route(route, true, true, "")
+			Expect(err).To(Not(HaveOccurred()))
+			resp, err := rpcTestClient.CorridorRoute(route, 2000.0, true, true, "")
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(len(resp.Items)).To(BeNumerically(">", 0))
+			Expect(len(resp.Items)).To(BeNumerically("<=", len(all.Items)))
+			for _, item := range resp.Items {
+				Expect(item.GetDistanceMeters()).To(BeNumerically("<=", 2000.0))
+			}
+		})
+
+		It("poi rpc route search across germany with wide corridor returns complete result", func() {
+			route := []*poiv1.Coordinate{
+				{Lon: 10.13, Lat: 54.32}, // Kiel
+				{Lon: 9.99, Lat: 53.55},  // Hamburg
+				{Lon: 8.68, Lat: 50.11},  // Frankfurt
+				{Lon: 11.58, Lat: 48.14}, // Munich
+			}
+			resp, err := rpcTestClient.CorridorRoute(route, 50_000.0, true, true, "")
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(len(resp.Items)).To(BeNumerically(">", 0))
+			Expect(resp.GetTruncated()).To(BeFalse())
+			Expect(resp.GetCoveredFraction()).To(BeZero())
+		})
+
+		It("poi rpc route search zigzagging across europe with wide corridor returns truncated result", func() {
+			route := []*poiv1.Coordinate{
+				{Lon: -9.14, Lat: 38.72}, // Lisbon
+				{Lon: 24.94, Lat: 60.17}, // Helsinki
+				{Lon: -3.70, Lat: 40.42}, // Madrid
+				{Lon: 37.62, Lat: 55.76}, // Moscow
+				{Lon: 12.50, Lat: 41.90}, // Rome
+				{Lon: 10.75, Lat: 59.91}, // Oslo
+				{Lon: 23.73, Lat: 37.98}, // Athens
+				{Lon: -0.13, Lat: 51.51}, // London
+			}
+			resp, err := rpcTestClient.CorridorRoute(route, 50_000.0, true, true, "")
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(resp.GetTruncated()).To(BeTrue())
+			Expect(resp.GetCoveredFraction()).To(And(BeNumerically(">", 0), BeNumerically("<", 1)))
+		})
+
+		It("poi rpc route search with too small corridor returns invalid argument", func() {
+			_, err := rpcTestClient.CorridorRoute(routeFixtureCoordinates, 10.0, true, true, "")
+			Expect(err).To((HaveOccurred()))
+			actualStatus, ok := status.FromError(err)
+			Expect(ok).To(BeTrue())
+			Expect(actualStatus.Code()).To(Equal(codes.InvalidArgument))
+		})
+
 		It("poi http route search return result correctly", func() {
 			// random route from Frankfurt area to Berlin area
 			route := []test.CoordinatesHTTP{
@@ -382,9 +701,482 @@ var _ = Describe("given location search request", Ordered, func() {
 			Expect(errStatus.Code()).To(Equal(codes.InvalidArgument))
 		})
 
+		// Nearest RPC
+		It("poi rpc nearest search returns k results", func() {
+			cntr := &poiv1.Coordinate{Lon: 9.147263, Lat: 49.333418}
+			resp, err := rpcTestClient.Nearest(cntr, 5, 100_000.0, true, true, "")
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(resp.Items).To(HaveLen(5))
+		})
+
+		It("poi rpc nearest search with invalid k returns invalid arguments", func() {
+			cntr := &poiv1.Coordinate{Lon: 9.147263, Lat: 49.333418}
+			_, err := rpcTestClient.Nearest(cntr, 0, 100_000.0, true, true, "")
+			Expect(err).To((HaveOccurred()))
+			actualStatus, ok := status.FromError(err)
+			Expect(ok).To(BeTrue())
+			Expect(actualStatus.Code()).To(Equal(codes.InvalidArgument))
+		})
+
+		It("poi rpc nearest search without center returns invalid arguments", func() {
+			var cntr *poiv1.Coordinate = nil
+			_, err := rpcTestClient.Nearest(cntr, 5, 100_000.0, true, true, "")
+			Expect(err).To((HaveOccurred()))
+			actualStatus, ok := status.FromError(err)
+			Expect(ok).To(BeTrue())
+			Expect(actualStatus.Code()).To(Equal(codes.InvalidArgument))
+		})
+
+		// Polygon RPC
+		It("poi rpc polygon search returns result correctly", func() {
+			ring := []*poiv1.Coordinate{
+				{Lon: 8.494772, Lat: 49.425026},
+				{Lon: 10.040508, Lat: 49.425026},
+				{Lon: 10.040508, Lat: 50.089540},
+				{Lon: 8.494772, Lat: 50.089540},
+			}
+			resp, err := rpcTestClient.Polygon([][]*poiv1.Coordinate{ring}, true, true, "")
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(len(resp.Items)).To(BeNumerically(">", 0))
+		})
+
+		It("poi rpc polygon search with too few coordinates returns invalid arguments", func() {
+			ring := []*poiv1.Coordinate{
+				{Lon: 8.494772, Lat: 49.425026},
+				{Lon: 10.040508, Lat: 50.089540},
+			}
+			_, err := rpcTestClient.Polygon([][]*poiv1.Coordinate{ring}, true, true, "")
+			Expect(err).To((HaveOccurred()))
+			actualStatus, ok := status.FromError(err)
+			Expect(ok).To(BeTrue())
+			Expect(actualStatus.Code()).To(Equal(codes.InvalidArgument))
+		})
+
+		It("poi rpc polygon search with hole outside of polygon returns invalid arguments", func() {
+			ring := []*poiv1.Coordinate{
+				{Lon: 8.494772, Lat: 49.425026},
+				{Lon: 10.040508, Lat: 49.425026},
+				{Lon: 10.040508, Lat: 50.089540},
+			}
+			hole := []*poiv1.Coordinate{
+				{Lon: 13.0, Lat: 52.0},
+				{Lon: 13.2, Lat: 52.0},
+				{Lon: 13.2, Lat: 52.2},
+			}
+			_, err := rpcTestClient.Polygon([][]*poiv1.Coordinate{ring, hole}, true, true, "")
+			Expect(err).To((HaveOccurred()))
+			actualStatus, ok := status.FromError(err)
+			Expect(ok).To(BeTrue())
+			Expect(actualStatus.Code()).To(Equal(codes.InvalidArgument))
+		})
+
+		// Clusters RPC
+		It("poi rpc clusters aggregate the pois within bbox", func() {
+			ne := &poiv1.Coordinate{Lon: 10.040508, Lat: 50.089540}
+			sw := &poiv1.Coordinate{Lon: 8.494772, Lat: 49.425026}
+			strict, err := rpcTestClient.StrictBbox(ne, sw, true, true, "")
+			Expect(err).To(Not(HaveOccurred()))
+			coarse, err := rpcTestClient.Clusters(ne, sw, 6, true, true, "")
+			Expect(err).To(Not(HaveOccurred()))
+			fine, err := rpcTestClient.Clusters(ne, sw, 12, true, true, "")
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(len(coarse.Clusters)).To(BeNumerically("<", len(fine.Clusters)))
+			count := 0
+			for _, c := range fine.Clusters {
+				count += int(c.Count)
+				Expect(c.Centroid.Lat).To(BeNumerically(">=", sw.Lat))
+				Expect(c.Centroid.Lat).To(BeNumerically("<=", ne.Lat))
+				Expect(c.Features).To(Not(BeEmpty()))
+			}
+			Expect(count).To(Equal(len(strict.Items)))
+			// the coarse clusters sum up the density aggregates whose cell center is within the bbox, hence the count is approximate
+			count = 0
+			for _, c := range coarse.Clusters {
+				count += int(c.Count)
+				Expect(c.Features).To(BeEmpty())
+			}
+			Expect(count).To(BeNumerically("~", len(strict.Items), len(strict.Items)/10))
+		})
+
+		It("poi rpc clusters with invalid zoom returns invalid arguments", func() {
+			ne := &poiv1.Coordinate{Lon: 10.040508, Lat: 50.089540}
+			sw := &poiv1.Coordinate{Lon: 8.494772, Lat: 49.425026}
+			_, err := rpcTestClient.Clusters(ne, sw, 23, true, true, "")
+			Expect(err).To(HaveOccurred())
+			actualStatus, ok := status.FromError(err)
+			Expect(ok).To(BeTrue())
+			Expect(actualStatus.Code()).To(Equal(codes.InvalidArgument))
+		})
+
+		// Density RPC
+		It("poi rpc density counts the pois of the cells intersecting bbox", func() {
+			ne := &poiv1.Coordinate{Lon: 10.040508, Lat: 50.089540}
+			sw := &poiv1.Coordinate{Lon: 8.494772, Lat: 49.425026}
+			strict, err := rpcTestClient.StrictBbox(ne, sw, true, true, "")
+			Expect(err).To(Not(HaveOccurred()))
+			coarse, err := rpcTestClient.Density(ne, sw, 8, true, true, "")
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(coarse.Precomputed).To(BeTrue())
+			fine, err := rpcTestClient.Density(ne, sw, 14, true, true, "")
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(fine.Precomputed).To(BeFalse())
+			Expect(len(coarse.Cells)).To(BeNumerically("<", len(fine.Cells)))
+			for _, resp := range []*poiv1.DensityResponse{coarse, fine} {
+				count := 0
+				for _, c := range resp.Cells {
+					count += int(c.Count)
+					Expect(c.Count).To(BeNumerically(">", 0))
+					Expect(s2.CellIDFromToken(c.Token).Level()).To(Equal(int(resp.Level)))
+				}
+				// the cells also count the pois outside of the bbox
+				Expect(count).To(BeNumerically(">=", len(strict.Items)))
+			}
+		})
+
+		It("poi rpc density with invalid level returns invalid arguments", func() {
+			ne := &poiv1.Coordinate{Lon: 10.040508, Lat: 50.089540}
+			sw := &poiv1.Coordinate{Lon: 8.494772, Lat: 49.425026}
+			_, err := rpcTestClient.Density(ne, sw, 31, true, true, "")
+			Expect(err).To(HaveOccurred())
+			actualStatus, ok := status.FromError(err)
+			Expect(ok).To(BeTrue())
+			Expect(actualStatus.Code()).To(Equal(codes.InvalidArgument))
+		})
+
+		// Tiles HTTP endpoint
+		It("poi http tile returns the pois of the tile as vector tile", func() {
+			info, err := rpcTestClient.PoI(testDataID, true, true, "")
+			Expect(err).To(Not(HaveOccurred()))
+			location := test.CoordinatesHTTP{Lat: info.Poi.Coordinate.Lat, Lon: info.Poi.Coordinate.Lon}
+			x, y := test.TileOf(location, 14)
+			resp := restTestClient.Tile(14, x, y, true, true, "")
+			Expect(resp.StatusCode).To(Equal(http.StatusOK))
+			Expect(resp.Ok.Layer).To(Equal("pois"))
+			Expect(resp.Ok.Extent).To(Equal(uint64(4096)))
+			Expect(resp.Ok.Features).To(ContainElement(HaveField("Properties", HaveKeyWithValue("id", testDataID))))
+			for _, f := range resp.Ok.Features {
+				Expect(f.Properties).To(HaveKeyWithValue("cluster", false))
+				Expect(f.X).To(BeNumerically(">=", 0))
+				Expect(f.X).To(BeNumerically("<=", 4096))
+				Expect(f.Y).To(BeNumerically(">=", 0))
+				Expect(f.Y).To(BeNumerically("<=", 4096))
+			}
+		})
+
+		It("poi http tile of low zoom returns clusters", func() {
+			x, y := test.TileOf(test.CoordinatesHTTP{Lat: 49.425026, Lon: 8.494772}, 6)
+			resp := restTestClient.Tile(6, x, y, false, true, "")
+			Expect(resp.StatusCode).To(Equal(http.StatusOK))
+			Expect(resp.Ok.Features).To(Not(BeEmpty()))
+			for _, f := range resp.Ok.Features {
+				Expect(f.Properties).To(HaveKeyWithValue("cluster", true))
+				Expect(f.Properties["point_count"]).To(BeNumerically(">", 0))
+			}
+		})
+
+		It("poi http tiles of zoom 0 and 1 cluster all pois", func() {
+			ne := &poiv1.Coordinate{Lon: 10.040508, Lat: 50.089540}
+			sw := &poiv1.Coordinate{Lon: 8.494772, Lat: 49.425026}
+			strict, err := rpcTestClient.StrictBbox(ne, sw, true, true, "")
+			Expect(err).To(Not(HaveOccurred()))
+			pointCount := func(tile *test.TileHTTP) int64 {
+				count := int64(0)
+				for _, f := range tile.Features {
+					Expect(f.Properties).To(HaveKeyWithValue("cluster", true))
+					count += f.Properties["point_count"].(int64)
+				}
+				return count
+			}
+			world := restTestClient.Tile(0, 0, 0, true, true, "")
+			Expect(world.StatusCode).To(Equal(http.StatusOK))
+			Expect(world.Ok.Features).To(Not(BeEmpty()))
+			x, y := test.TileOf(test.CoordinatesHTTP{Lat: sw.Lat, Lon: sw.Lon}, 1)
+			europe := restTestClient.Tile(1, x, y, true, true, "")
+			Expect(europe.StatusCode).To(Equal(http.StatusOK))
+			Expect(europe.Ok.Features).To(Not(BeEmpty()))
+			Expect(pointCount(world.Ok)).To(BeNumerically(">", len(strict.Items)))
+			Expect(pointCount(europe.Ok)).To(Equal(pointCount(world.Ok)))
+		})
+
+		It("poi http tile outside of the zoom returns bad request", func() {
+			resp := restTestClient.Tile(3, 8, 0, true, true, "")
+			Expect(resp.StatusCode).To(Equal(http.StatusBadRequest))
+		})
+
+		It("poi http tile with invalid key results in forbidden", func() {
+			resp := restTestClient.Tile(14, 8589, 5626, true, true, "NOT THE SECRET")
+			Expect(resp.StatusCode).To(Equal(http.StatusForbidden))
+			resp = restTestClient.Tile(14, 8589, 5626, true, false, "")
+			Expect(resp.StatusCode).To(Equal(http.StatusUnauthorized))
+		})
+
+		// PlanChargingStops RPC
+		It("poi rpc charging stop planning without required stop returns no stops", func() {
+			resp, err := rpcTestClient.PlanChargingStops(
+				&poiv1.PlanChargingStopsRequest{
+					Route:                routeFixtureCoordinates,
+					StateOfChargePercent: 100,
+					UsableRangeMeters:    1_000_000,
+					ReservePercent:       10,
+				},
+				true,
+				true,
+				"",
+			)
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(resp.Stops).To(BeEmpty())
+			Expect(resp.ArrivalStateOfChargePercent).To(BeNumerically(">=", 10))
+			Expect(resp.RouteLengthMeters).To(BeNumerically(">", 0))
+		})
+
+		It("poi rpc charging stop planning returns stops along route above reserve", func() {
+			resp, err := rpcTestClient.PlanChargingStops(
+				&poiv1.PlanChargingStopsRequest{
+					Route:                routeFixtureCoordinates,
+					StateOfChargePercent: 40,
+					UsableRangeMeters:    500_000,
+					ReservePercent:       10,
+					MinPowerKw:           50,
+					Connector:            poiv1.ConnectorType_CONNECTOR_TYPE_DC,
+				},
+				true,
+				true,
+				"",
+			)
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(resp.Stops).To(Not(BeEmpty()))
+			Expect(resp.ArrivalStateOfChargePercent).To(BeNumerically(">=", 10))
+			for i, stop := range resp.Stops {
+				Expect(stop.Station.Features).To(ContainElement("DC_CHARGING"))
+				Expect(stop.ArrivalStateOfChargePercent).To(BeNumerically(">=", 10))
+				Expect(stop.DepartureStateOfChargePercent).To(BeNumerically("~", 80, 0.001))
+				for _, a := range stop.Alternates {
+					Expect(a.Features).To(ContainElement("DC_CHARGING"))
+					Expect(a.GetDistanceFromStartMeters()).
+						To(BeNumerically("<=", stop.Station.GetDistanceFromStartMeters()))
+				}
+				if i > 0 {
+					Expect(stop.Station.GetDistanceFromStartMeters()).
+						To(BeNumerically(">", resp.Stops[i-1].Station.GetDistanceFromStartMeters()))
+				}
+			}
+		})
+
+		It("poi rpc charging stop planning with insufficient range returns failed precondition", func() {
+			_, err := rpcTestClient.PlanChargingStops(
+				&poiv1.PlanChargingStopsRequest{
+					Route:                routeFixtureCoordinates,
+					StateOfChargePercent: 20,
+					UsableRangeMeters:    50_000,
+					ReservePercent:       10,
+				},
+				true,
+				true,
+				"",
+			)
+			Expect(err).To((HaveOccurred()))
+			actualStatus, ok := status.FromError(err)
+			Expect(ok).To(BeTrue())
+			Expect(actualStatus.Code()).To(Equal(codes.FailedPrecondition))
+		})
+
+		It("poi rpc charging stop planning with invalid range returns invalid argument", func() {
+			_, err := rpcTestClient.PlanChargingStops(
+				&poiv1.PlanChargingStopsRequest{
+					Route:                routeFixtureCoordinates,
+					StateOfChargePercent: 80,
+					UsableRangeMeters:    100,
+				},
+				true,
+				true,
+				"",
+			)
+			Expect(err).To((HaveOccurred()))
+			actualStatus, ok := status.FromError(err)
+			Expect(ok).To(BeTrue())
+			Expect(actualStatus.Code()).To(Equal(codes.InvalidArgument))
+		})
+
+		// Stream RPCs
+		It("poi rpc bbox stream returns the same items as the bbox search", func() {
+			sw := &poiv1.Coordinate{Lon: 8.494772, Lat: 49.425026}
+			ne := &poiv1.Coordinate{Lon: 10.040508, Lat: 50.089540}
+			all, err := rpcTestClient.Bbox(ne, sw, true, true, "")
+			Expect(err).To(Not(HaveOccurred()))
+			resps, err := rpcTestClient.StreamBbox(ne, sw, true, true, "")
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(len(resps)).To(BeNumerically(">", 1))
+			numItems := 0
+			for _, r := range resps {
+				numItems += len(r.Items)
+			}
+			Expect(numItems).To(Equal(len(all.Items)))
+		})
+
+		It("poi rpc proximity stream returns result correctly", func() {
+			cntr := &poiv1.Coordinate{Lon: 9.147263, Lat: 49.333418}
+			resps, err := rpcTestClient.StreamProximity(cntr, 100_000.0, true, true, "")
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(resps).To(Not(BeEmpty()))
+		})
+
+		It("poi rpc proximity stream without center returns invalid arguments", func() {
+			var cntr *poiv1.Coordinate = nil
+			_, err := rpcTestClient.StreamProximity(cntr, 100_000.0, true, true, "")
+			Expect(err).To((HaveOccurred()))
+			actualStatus, ok := status.FromError(err)
+			Expect(ok).To(BeTrue())
+			Expect(actualStatus.Code()).To(Equal(codes.InvalidArgument))
+		})
+
+		It("poi rpc route stream returns result correctly", func() {
+			resps, err := rpcTestClient.StreamRoute(routeFixtureCoordinates, true, true, "")
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(resps).To(Not(BeEmpty()))
+		})
+
+		It("poi rpc route stream without key results in unauthenticated", func() {
+			_, err := rpcTestClient.StreamRoute(routeFixtureCoordinates, true, false, "")
+			Expect(err).To(HaveOccurred())
+			statusErr, ok := status.FromError(err)
+			Expect(ok).To(BeTrue())
+			Expect(statusErr.Code()).To(Equal(codes.Unauthenticated))
+		})
+
+		// Write RPCs
+		newWritePoI := func() *poiv1.PoI {
+			return &poiv1.PoI{
+				Coordinate: &poiv1.Coordinate{Lon: -21.895410, Lat: 64.135482},
+				Address: &poiv1.Address{
+					Street:       "Laugavegur",
+					StreetNumber: "1",
+					ZipCode:      "101",
+					City:         "Reykjavik",
+					Country:      "ISL",
+				},
+				Features: []string{"AC_CHARGING"},
+			}
+		}
+
+		It("poi rpc create, update and delete a poi with etags correctly", func() {
+			created, err := rpcTestClient.CreatePoI(newWritePoI(), true, true, "")
+			Expect(err).To(Not(HaveOccurred()))
+			id := created.Poi.Id
+			Expect(id).To(Not(BeEmpty()))
+			Expect(created.Poi.Entrance.Lat).To(Equal(created.Poi.Coordinate.Lat))
+
+			info, err := rpcTestClient.PoI(id, true, true, "")
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(info.Poi.Address.City).To(Equal("Reykjavik"))
+
+			_, err = rpcTestClient.CreatePoI(created.Poi, true, true, "")
+			Expect(err).To(HaveOccurred())
+			actualStatus, ok := status.FromError(err)
+			Expect(ok).To(BeTrue())
+			Expect(actualStatus.Code()).To(Equal(codes.AlreadyExists))
+
+			update := &poiv1.PoI{
+				Id:       id,
+				Address:  &poiv1.Address{StreetNumber: "2b"},
+				Features: []string{"DC_CHARGING"},
+			}
+			updated, err := rpcTestClient.UpdatePoI(
+				update,
+				[]string{"address.street_number"},
+				created.Etag,
+				true,
+				true,
+				"",
+			)
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(updated.Poi.Address.StreetNumber).To(Equal("2b"))
+			Expect(updated.Poi.Address.Street).To(Equal("Laugavegur"))
+			Expect(updated.Poi.Features).To(Equal([]string{"AC_CHARGING"}))
+			Expect(updated.Poi.Version).To(Equal(created.Poi.Version + 1))
+			Expect(updated.Etag).To(Not(Equal(created.Etag)))
+
+			_, err = rpcTestClient.UpdatePoI(update, []string{"features"}, created.Etag, true, true, "")
+			actualStatus, ok = status.FromError(err)
+			Expect(ok).To(BeTrue())
+			Expect(actualStatus.Code()).To(Equal(codes.Aborted))
+			_, err = rpcTestClient.DeletePoI(id, created.Etag, true, true, "")
+			actualStatus, ok = status.FromError(err)
+			Expect(ok).To(BeTrue())
+			Expect(actualStatus.Code()).To(Equal(codes.Aborted))
+
+			_, err = rpcTestClient.DeletePoI(id, updated.Etag, true, true, "")
+			Expect(err).To(Not(HaveOccurred()))
+			_, err = rpcTestClient.PoI(id, true, true, "")
+			actualStatus, ok = status.FromError(err)
+			Expect(ok).To(BeTrue())
+			Expect(actualStatus.Code()).To(Equal(codes.NotFound))
+		})
+
+		It("poi rpc create with invalid coordinate returns invalid argument", func() {
+			location := newWritePoI()
+			location.Coordinate.Lat = 91
+			_, err := rpcTestClient.CreatePoI(location, true, true, "")
+			Expect(err).To(HaveOccurred())
+			actualStatus, ok := status.FromError(err)
+			Expect(ok).To(BeTrue())
+			Expect(actualStatus.Code()).To(Equal(codes.InvalidArgument))
+		})
+
+		It("poi rpc create with incomplete address returns invalid argument", func() {
+			location := newWritePoI()
+			location.Address.City = ""
+			_, err := rpcTestClient.CreatePoI(location, true, true, "")
+			Expect(err).To(HaveOccurred())
+			actualStatus, ok := status.FromError(err)
+			Expect(ok).To(BeTrue())
+			Expect(actualStatus.Code()).To(Equal(codes.InvalidArgument))
+		})
+
+		It("poi rpc create without key results in unauthenticated", func() {
+			_, err := rpcTestClient.CreatePoI(newWritePoI(), true, false, "")
+			Expect(err).To(HaveOccurred())
+			statusErr, ok := status.FromError(err)
+			Expect(ok).To(BeTrue())
+			Expect(statusErr.Code()).To(Equal(codes.Unauthenticated))
+		})
+
+		It("poi rpc update with unknown mask path returns invalid argument", func() {
+			update := &poiv1.PoI{Id: testDataID}
+			_, err := rpcTestClient.UpdatePoI(update, []string{"id"}, "", true, true, "")
+			Expect(err).To(HaveOccurred())
+			actualStatus, ok := status.FromError(err)
+			Expect(ok).To(BeTrue())
+			Expect(actualStatus.Code()).To(Equal(codes.InvalidArgument))
+		})
+
+		It("poi rpc update of none existing poi returns not found", func() {
+			update := &poiv1.PoI{Id: "3yfD9hciu5kGIGdGXjPuJy3tUvH", Features: []string{"DC_CHARGING"}}
+			_, err := rpcTestClient.UpdatePoI(update, []string{"features"}, "", true, true, "")
+			Expect(err).To(HaveOccurred())
+			actualStatus, ok := status.FromError(err)
+			Expect(ok).To(BeTrue())
+			Expect(actualStatus.Code()).To(Equal(codes.NotFound))
+		})
+
+		It("poi rpc update with invalid etag returns invalid argument", func() {
+			update := &poiv1.PoI{Id: testDataID, Features: []string{"DC_CHARGING"}}
+			_, err := rpcTestClient.UpdatePoI(update, []string{"features"}, "W/1", true, true, "")
+			Expect(err).To(HaveOccurred())
+			actualStatus, ok := status.FromError(err)
+			Expect(ok).To(BeTrue())
+			Expect(actualStatus.Code()).To(Equal(codes.InvalidArgument))
+		})
+
+		It("poi rpc delete of none existing poi returns not found", func() {
+			_, err := rpcTestClient.DeletePoI("3yfD9hciu5kGIGdGXjPuJy3tUvH", "", true, true, "")
+			Expect(err).To(HaveOccurred())
+			actualStatus, ok := status.FromError(err)
+			Expect(ok).To(BeTrue())
+			Expect(actualStatus.Code()).To(Equal(codes.NotFound))
+		})
+
 		AfterAll(func() {
 			cancel()
-			container.Stop()
 		})
 	})
 })
@@ -42,6 +42,7 @@ type Server struct {
 	httpProxyTlSConfig       credentials.TransportCredentials
 	albDeregistrationSeconds int64
 	secret                   string
+	tileHandler              *TileHandler
 }
 
 type ServerOption func(s *Server)
@@ -116,6 +117,14 @@ func WithAuthSecret(secret string) ServerOption {
 	}
 }
 
+func WithTileHandler(tileHandler *TileHandler) ServerOption {
+	return func(s *Server) {
+		if tileHandler != nil {
+			s.tileHandler = tileHandler
+		}
+	}
+}
+
 func NewServer(opts ...ServerOption) (*Server, error) {
 	// apply defaults to server
 	server := &Server{
@@ -232,6 +241,10 @@ func (s *Server) startRPCServer() error {
 			authInterceptor.UnaryKeyAuthorizer(),
 			grpclogging.UnaryServerInterceptor(InterceptorLogger(s.logger)),
 		),
+		grpc.ChainStreamInterceptor(
+			authInterceptor.StreamKeyAuthorizer(),
+			grpclogging.StreamServerInterceptor(InterceptorLogger(s.logger)),
+		),
 	}
 	if s.sslEnabled {
 		opts = append(opts, grpc.Creds(s.grpcTlSConfig))
@@ -265,6 +278,7 @@ func (s *Server) startHTTPProxy() error {
 	mux := runtime.NewServeMux(
 		runtime.WithIncomingHeaderMatcher(headerMatcher),
 		runtime.WithForwardResponseOption(correlationIDResponseModifier),
+		runtime.WithMarshalerOption(runtime.MIMEWildcard, newNDJSONMarshaler()),
 	)
 	opts := []grpc.DialOption{
 		grpc.WithChainUnaryInterceptor(
@@ -296,6 +310,20 @@ func (s *Server) startHTTPProxy() error {
 	if err != nil {
 		return fmt.Errorf("failed to register health service proxy handler: %w", err)
 	}
+	if s.tileHandler != nil {
+		authInterceptor, err := NewKeyAuthInterceptor(s.secret)
+		if err != nil {
+			return fmt.Errorf("failed to register tile handler: %w", err)
+		}
+		err = mux.HandlePath(
+			http.MethodGet,
+			tilePath,
+			authInterceptor.HTTPKeyAuthorizer(mux, s.tileHandler.HandlerFunc(mux)),
+		)
+		if err != nil {
+			return fmt.Errorf("failed to register tile handler: %w", err)
+		}
+	}
 
 	// Start HTTP server (and proxy calls to gRPC server endpoint)
 	go func() {
@@ -0,0 +1,207 @@
+package rpc
+
+import (
+	"errors"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/grntlrduck-cloud/go-grpc-geohasing-service-sample/internal/domain/poi"
+)
+
+const (
+	tilePath             = "/api/v1/pois/tiles/{z}/{x}/{y}" // y carries the .mvt suffix
+	tileSuffix           = ".mvt"
+	tileLayerName        = "pois"
+	tileExtent           = 4096
+	maxClusteredTileZoom = 11 // tiles up to this zoom hold clusters instead of the locations
+)
+
+// The TileHandler serves the locations within XYZ map tiles as Mapbox Vector Tiles with a single layer of points.
+// Tiles are requested by map libraries directly, hence it is registered on the HTTP gateway instead of being an RPC.
+type TileHandler struct {
+	logger          *zap.Logger
+	locationService *poi.LocationService
+}
+
+func NewTileHandler(logger *zap.Logger, locationService *poi.LocationService) *TileHandler {
+	return &TileHandler{
+		logger:          logger,
+		locationService: locationService,
+	}
+}
+
+// HandlerFunc returns the handler of the tile path, errors are written by the error handler of the mux
+func (t *TileHandler) HandlerFunc(mux *runtime.ServeMux) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		ctx := r.Context()
+		_, outbound := runtime.MarshalerForRequest(mux, r)
+		z, x, y, err := parseTile(pathParams)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outbound, w, r, err)
+			return
+		}
+		// map libraries do not set a correlation id for each tile, hence it is generated if missing
+		correlationID, err := uuid.Parse(r.Header.Get(correlationHeader))
+		if err != nil {
+			correlationID = uuid.New()
+		}
+		w.Header().Set(correlationHeader, correlationID.String())
+
+		logger := t.logger.With(
+			zap.String("correlation_id", correlationID.String()),
+			zap.String("http_path", tilePath),
+			zap.Int("z", z),
+			zap.Int("x", x),
+			zap.Int("y", y),
+		)
+		logger.Info(
+			"processing tile request",
+		)
+
+		sw, ne := tileBbox(z, x, y)
+		layer := newMVTLayer(tileLayerName, tileExtent)
+		var truncated bool
+		if z <= maxClusteredTileZoom {
+			var result *poi.ClusterResult
+			result, err = t.locationService.Clusters(ctx, sw, ne, z, logger)
+			if err == nil {
+				truncated = result.Truncated
+				for _, c := range result.Clusters {
+					px, py := tilePosition(c.Centroid, z, x, y)
+					if err = layer.addPoint(px, py, clusterProperties(c)); err != nil {
+						break
+					}
+				}
+			}
+		} else {
+			var result *poi.SearchResult
+			result, err = t.locationService.Bbox(ctx, sw, ne, logger, poi.WithStrict(true))
+			if err == nil {
+				truncated = result.Truncated
+				for _, l := range result.Locations {
+					px, py := tilePosition(l.Location, z, x, y)
+					if err = layer.addPoint(px, py, locationProperties(l)); err != nil {
+						break
+					}
+				}
+			}
+		}
+
+		// handle errors accordingly
+		if errors.Is(err, poi.ErrInvalidSearchCoordinates) {
+			runtime.HTTPError(ctx, mux, outbound, w, r,
+				status.Errorf(codes.InvalidArgument, "%s: %v", invalidGeoParamsMessage, err))
+			return
+		}
+		if errors.Is(err, poi.ErrTooLargeSearchArea) {
+			runtime.HTTPError(ctx, mux, outbound, w, r,
+				status.Errorf(codes.OutOfRange, "%s: %v", tooLargeAreaMessage, err))
+			return
+		}
+		if err != nil {
+			logger.Error("unable to handle request", zap.Error(err))
+			runtime.HTTPError(ctx, mux, outbound, w, r, status.Errorf(codes.Internal, "%s: %v", severErrMessage, err))
+			return
+		}
+
+		// log and return
+		logger.Info(
+			"returning tile",
+			zap.Int("num_features", len(layer.features)),
+			zap.Bool("truncated", truncated),
+		)
+		w.Header().Set("Content-Type", mvtContentType)
+		w.WriteHeader(http.StatusOK)
+		if _, err := w.Write(layer.tile()); err != nil {
+			logger.Warn("failed to write tile", zap.Error(err))
+		}
+	}
+}
+
+// parseTile parses the zoom and the column and row of the tile in the XYZ scheme
+func parseTile(pathParams map[string]string) (z, x, y int, err error) {
+	row, ok := strings.CutSuffix(pathParams["y"], tileSuffix)
+	if !ok {
+		return 0, 0, 0, status.Errorf(codes.NotFound, "only %s tiles are supported", tileSuffix)
+	}
+	z, errZ := strconv.Atoi(pathParams["z"])
+	x, errX := strconv.Atoi(pathParams["x"])
+	y, errY := strconv.Atoi(row)
+	if err := errors.Join(errZ, errX, errY); err != nil {
+		return 0, 0, 0, status.Errorf(codes.InvalidArgument, "invalid tile: %v", err)
+	}
+	if z < int(minZoom) || z > int(maxZoom) {
+		return 0, 0, 0, status.Errorf(
+			codes.InvalidArgument,
+			"invalid tile: zoom=%d must be between %d and %d",
+			z,
+			minZoom,
+			maxZoom,
+		)
+	}
+	n := 1 << z
+	if x < 0 || x >= n || y < 0 || y >= n {
+		return 0, 0, 0, status.Errorf(codes.InvalidArgument, "invalid tile: x=%d and y=%d must be below %d", x, y, n)
+	}
+	return z, x, y, nil
+}
+
+// tileBbox returns the bounding box of the tile in the web mercator projection
+func tileBbox(z, x, y int) (sw, ne poi.Coordinates) {
+	n := float64(int(1) << z)
+	lon := func(x int) float64 {
+		return float64(x)/n*360 - 180
+	}
+	lat := func(y int) float64 {
+		return math.Atan(math.Sinh(math.Pi*(1-2*float64(y)/n))) * 180 / math.Pi
+	}
+	return poi.Coordinates{Latitude: lat(y + 1), Longitude: lon(x)}, poi.Coordinates{Latitude: lat(y), Longitude: lon(x + 1)}
+}
+
+// tilePosition returns the position of the coordinates in the coordinate space of the tile, clamped to the extent
+func tilePosition(c poi.Coordinates, z, x, y int) (px, py int32) {
+	n := float64(int(1) << z)
+	lat := c.Latitude * math.Pi / 180
+	wx := (c.Longitude + 180) / 360 * n
+	wy := (1 - math.Asinh(math.Tan(lat))/math.Pi) / 2 * n
+	position := func(w float64, tile int) int32 {
+		return int32(min(max(math.Round((w-float64(tile))*tileExtent), 0), tileExtent))
+	}
+	return position(wx, x), position(wy, y)
+}
+
+func clusterProperties(c *poi.Cluster) []mvtProperty {
+	return []mvtProperty{
+		{key: "cluster", value: true},
+		{key: "point_count", value: int64(c.Count)},
+		{key: "features", value: strings.Join(c.Features, ",")},
+	}
+}
+
+func locationProperties(l *poi.PoILocation) []mvtProperty {
+	properties := []mvtProperty{
+		{key: "cluster", value: false},
+		{key: "id", value: l.ID.String()},
+		{key: "street", value: l.Address.Street},
+		{key: "street_number", value: l.Address.StreetNumber},
+		{key: "zip_code", value: l.Address.ZipCode},
+		{key: "city", value: l.Address.City},
+		{key: "country", value: l.Address.CountryCode},
+		{key: "features", value: strings.Join(l.Features, ",")},
+	}
+	if l.Charging != nil {
+		properties = append(properties,
+			mvtProperty{key: "max_power_kw", value: l.Charging.MaxPowerKW},
+			mvtProperty{key: "charge_points", value: int64(l.Charging.ChargePoints)},
+		)
+	}
+	return properties
+}
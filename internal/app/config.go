@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
 	"dario.cat/mergo"
 	"gopkg.in/yaml.v3"
@@ -14,9 +15,16 @@ const (
 	bootFilePrefix = "boot"
 )
 
+const (
+	StorageBackendDynamoDB = "dynamodb"
+	StorageBackendMemory   = "memory"
+	StorageBackendBolt     = "bolt"
+)
+
 type BootConfig struct {
 	App     AppConfig     `yaml:"app"`
 	Grpc    GrpcConfig    `yaml:"grpc"`
+	Storage StorageConfig `yaml:"storage"`
 	Aws     AwsConfig     `yaml:"aws"`
 	Logging LoggingConfig `yaml:"logging"`
 }
@@ -44,6 +52,29 @@ type SslConfig struct {
 	CaPath   string `yaml:"ca_path"`
 }
 
+// The StorageConfig selects where the locations are stored, Backend is either StorageBackendDynamoDB,
+// StorageBackendMemory, or StorageBackendBolt. Path is the file of the bolt backend.
+// LoadInitData loads the test data into the memory backend, or into the bolt backend if its file is empty.
+type StorageConfig struct {
+	Backend      string      `yaml:"backend"`
+	Path         string      `yaml:"path"`
+	LoadInitData bool        `yaml:"load_init_data"`
+	Cache        CacheConfig `yaml:"cache"`
+}
+
+// The CacheConfig enables the read-through cache in front of the storage backend. MaxCells and MaxLocations bound
+// the number of cached search cells and locations looked up by id, zero uses the defaults of the cache. The hit and
+// miss counters of the cache are logged every StatsInterval, every minute if zero. The cache is disabled by default,
+// since it is local to each instance: reads, including the etags of locations looked up by id, might be stale for
+// up to TTL after writes by other instances.
+type CacheConfig struct {
+	Enabled       bool          `yaml:"enabled"`
+	MaxCells      int           `yaml:"max_cells"`
+	MaxLocations  int           `yaml:"max_locations"`
+	TTL           time.Duration `yaml:"ttl"`
+	StatsInterval time.Duration `yaml:"stats_interval"`
+}
+
 type AwsConfig struct {
 	Config   BasicConfig    `yaml:"config"`
 	DynamoDB DynamoDBConfig `yaml:"dynamodb"`
@@ -54,10 +85,15 @@ type BasicConfig struct {
 	Account string `yaml:"account"`
 }
 
+// The DynamoDBConfig configures the table of the dynamodb backend. Level partitions the default geo index of the spatial
+// index, zero uses the default level, and must match the level the table has been indexed with by cmd/reindex.
 type DynamoDBConfig struct {
 	PoiTableName     string           `yaml:"poi_table_name"`
 	EndpointOverride EndpointOverride `yaml:"endpoint_override"`
 	CreateInitTable  bool             `yaml:"create_init_table"`
+	PageTokenSecret  string           `yaml:"page_token_secret"`
+	SpatialIndex     string           `yaml:"spatial_index"`
+	Level            int              `yaml:"level"`
 }
 
 type EndpointOverride struct {
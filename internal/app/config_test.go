@@ -2,6 +2,7 @@ package app_test
 
 import (
 	"os"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -34,6 +35,14 @@ var _ = Describe("Given application run as in container", Serial, func() {
 			Expect(err).To(Not(HaveOccurred()))
 			Expect(bootConfig.Grpc.Ssl.Enabled).To(Equal(expectedSslEnabled))
 		})
+		It("cache config is loaded with durations", func() {
+			os.Setenv("BOOT_PROFILE_ACTIVE", "")
+			bootConfig, err := app.LoadBootConfig()
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(bootConfig.Storage.Cache.Enabled).To(BeFalse())
+			Expect(bootConfig.Storage.Cache.TTL).To(Equal(time.Minute))
+			Expect(bootConfig.Storage.Cache.StatsInterval).To(Equal(5 * time.Minute))
+		})
 		It("environment variables are expanded", func() {
 			os.Setenv("BOOT_PROFILE_ACTIVE", "")
 
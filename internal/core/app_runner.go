@@ -3,21 +3,31 @@ package core
 import (
 	"context"
 	"fmt"
+	"io"
 	"strings"
+	"time"
 
 	"go.uber.org/zap"
 
+	"github.com/grntlrduck-cloud/go-grpc-geohasing-service-sample/internal/adapters/bolt"
+	"github.com/grntlrduck-cloud/go-grpc-geohasing-service-sample/internal/adapters/cache"
 	"github.com/grntlrduck-cloud/go-grpc-geohasing-service-sample/internal/adapters/dynamo"
+	"github.com/grntlrduck-cloud/go-grpc-geohasing-service-sample/internal/adapters/memory"
 	"github.com/grntlrduck-cloud/go-grpc-geohasing-service-sample/internal/adapters/rpc"
 	"github.com/grntlrduck-cloud/go-grpc-geohasing-service-sample/internal/app"
 	"github.com/grntlrduck-cloud/go-grpc-geohasing-service-sample/internal/domain/poi"
 )
 
+// defaultCacheStatsInterval is the interval of logging the cache statistics if none is configured
+const defaultCacheStatsInterval = time.Minute
+
 type ApplicationRunner struct {
 	ctx        context.Context
 	logger     *zap.Logger
 	bootConfig *app.BootConfig
 	server     *rpc.Server
+	closer     io.Closer // releases the storage of the repository on shutdown, if any
+	cache      *cache.PoIRepository
 	running    bool
 }
 
@@ -61,12 +71,16 @@ func NewApplicationRunner(opts ...ApplicationOpts) *ApplicationRunner {
 	if err != nil {
 		panic(fmt.Errorf("unable to create repo: %w", err))
 	}
+	if a.bootConfig.Storage.Cache.Enabled {
+		repo = a.createCache(repo)
+	}
 	domainService := poi.NewLocationService(repo)
 	serverOpts := a.getSevrerBaseOptions()
 	serverOpts = append(
 		serverOpts,
 		rpc.WithHealthService(&rpc.HealthRPCService{}),
 		rpc.WithRegisterRPCService(rpc.NewPoIRPCService(a.logger, domainService)),
+		rpc.WithTileHandler(rpc.NewTileHandler(a.logger, domainService)),
 	)
 	server, err := rpc.NewServer(serverOpts...)
 	if err != nil {
@@ -81,6 +95,59 @@ func NewApplicationRunner(opts ...ApplicationOpts) *ApplicationRunner {
 }
 
 func (a *ApplicationRunner) createRepo() (poi.Repository, error) {
+	switch a.bootConfig.Storage.Backend {
+	case app.StorageBackendMemory:
+		a.logger.Warn("using in-memory repository, locations are not persisted")
+		repo, err := memory.NewPoIRepository(
+			a.logger,
+			memory.WithLoadInitData(a.bootConfig.Storage.LoadInitData),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to initialize Repository: %w", err)
+		}
+		return repo, nil
+	case app.StorageBackendBolt:
+		a.logger.Info("using bolt repository", zap.String("path", a.bootConfig.Storage.Path))
+		repo, err := bolt.NewPoIRepository(
+			a.logger,
+			bolt.WithPath(a.bootConfig.Storage.Path),
+			bolt.WithLoadInitData(a.bootConfig.Storage.LoadInitData),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to initialize Repository: %w", err)
+		}
+		a.closer = repo
+		return repo, nil
+	case app.StorageBackendDynamoDB, "":
+		return a.createDynamoRepo()
+	default:
+		return nil, fmt.Errorf("unknown storage backend %s", a.bootConfig.Storage.Backend)
+	}
+}
+
+func (a *ApplicationRunner) createCache(repo poi.Repository) poi.Repository {
+	conf := a.bootConfig.Storage.Cache
+	opts := make([]cache.PoIRepositoryOptions, 0)
+	if conf.MaxCells > 0 {
+		opts = append(opts, cache.WithMaxCells(conf.MaxCells))
+	}
+	if conf.MaxLocations > 0 {
+		opts = append(opts, cache.WithMaxLocations(conf.MaxLocations))
+	}
+	if conf.TTL > 0 {
+		opts = append(opts, cache.WithTTL(conf.TTL))
+	}
+	a.logger.Info("using read-through cache",
+		zap.Int("max_cells", conf.MaxCells),
+		zap.Int("max_locations", conf.MaxLocations),
+		zap.Duration("ttl", conf.TTL),
+		zap.Duration("stats_interval", conf.StatsInterval),
+	)
+	a.cache = cache.NewPoIRepository(repo, opts...)
+	return a.cache
+}
+
+func (a *ApplicationRunner) createDynamoRepo() (poi.Repository, error) {
 	dynamoOpts := []dynamo.ClientOptions{
 		dynamo.WithContext(a.ctx),
 		dynamo.WithRegion(a.bootConfig.Aws.Config.Region),
@@ -100,11 +167,20 @@ func (a *ApplicationRunner) createRepo() (poi.Repository, error) {
 			err,
 		)
 	}
+	index, err := dynamo.NewSpatialIndex(
+		a.bootConfig.Aws.DynamoDB.SpatialIndex,
+		a.bootConfig.Aws.DynamoDB.Level,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create spatial index: %w", err)
+	}
 	repo, err := dynamo.NewPoIGeoRepository(
 		a.logger,
 		dynamo.WithDynamoClientWrapper(dyanmoClient),
 		dynamo.WithTableName(a.bootConfig.Aws.DynamoDB.PoiTableName),
 		dynamo.WithCreateAndInitTable(a.bootConfig.Aws.DynamoDB.CreateInitTable),
+		dynamo.WithPageTokenSecret(a.bootConfig.Aws.DynamoDB.PageTokenSecret),
+		dynamo.WithSpatialIndex(index),
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to initialize Repository: %w", err)
@@ -140,18 +216,64 @@ func (a *ApplicationRunner) Run() {
 	defer func(a *ApplicationRunner) {
 		_ = a.logger.Sync()
 	}(a)
+	defer a.closeRepo()
 	defer a.server.Stop()
 	err := a.server.Start()
 	if err != nil {
 		a.logger.Panic("application run failed, unable to start grpc server", zap.Error(err))
 	}
 	a.logger.Info("application running")
+	if a.cache != nil {
+		go a.reportCacheStats()
+	}
 	a.running = true
 	a.awaitTermination()
 	a.running = false
 	a.logger.Info("application shut down")
 }
 
+// reportCacheStats logs the cache statistics periodically until the application context is done
+func (a *ApplicationRunner) reportCacheStats() {
+	interval := a.bootConfig.Storage.Cache.StatsInterval
+	if interval <= 0 {
+		interval = defaultCacheStatsInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-a.ctx.Done():
+			return
+		case <-ticker.C:
+			a.logCacheStats()
+		}
+	}
+}
+
+// logCacheStats logs the counters of the cache since the start of the application
+func (a *ApplicationRunner) logCacheStats() {
+	stats := a.cache.Stats()
+	a.logger.Info("cache statistics",
+		zap.Uint64("cell_hits", stats.CellHits),
+		zap.Uint64("cell_misses", stats.CellMisses),
+		zap.Uint64("location_hits", stats.LocationHits),
+		zap.Uint64("location_misses", stats.LocationMisses),
+		zap.Uint64("evictions", stats.Evictions),
+	)
+}
+
+func (a *ApplicationRunner) closeRepo() {
+	if a.cache != nil {
+		a.logCacheStats()
+	}
+	if a.closer == nil {
+		return
+	}
+	if err := a.closer.Close(); err != nil {
+		a.logger.Error("failed to close repository", zap.Error(err))
+	}
+}
+
 func (a *ApplicationRunner) awaitTermination() {
 	for {
 		select {
@@ -16,19 +16,14 @@ import (
 // basic smoke/integration test
 // can not be run parallel to other suits which start the full application
 var _ = Describe("given application", Ordered, func() {
-	ctx := context.Background()
 	appCtx := context.Background()
 	appCtxCancel, cancel := context.WithCancel(appCtx)
 	var runner *core.ApplicationRunner
-	var container *test.DynamoContainer
 
 	BeforeAll(func() {
 		err := os.Chdir("../..")
 		Expect(err).To(Not(HaveOccurred()))
-		container = test.NewDynamoContainer(ctx)
-		os.Setenv("DYNAMOLOCAL_HOST", container.Host())
-		os.Setenv("DYNAMOLOCAL_PORT", container.Port())
-		os.Setenv("BOOT_PROFILE_ACTIVE", "test")
+		os.Setenv("BOOT_PROFILE_ACTIVE", "memory")
 		runner = core.NewApplicationRunner(core.WithApplicationContext(appCtxCancel))
 		Expect(runner).To(Not(BeNil()))
 		go func() {
@@ -83,6 +78,5 @@ var _ = Describe("given application", Ordered, func() {
 
 	AfterAll(func() {
 		cancel()
-		container.Stop()
 	})
 })
@@ -0,0 +1,173 @@
+package poi
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+const (
+	FeatureACCharging = "AC_CHARGING"
+	FeatureDCCharging = "DC_CHARGING"
+
+	// chargingPowerSuffix marks the features holding the charging power of a station, e.g. 22_KW_CHARGING
+	chargingPowerSuffix = "_KW_CHARGING"
+	// chargePointsSuffix marks the features holding the number of charge points of a station, e.g. 2_CHARGEPOINTS
+	chargePointsSuffix = "_CHARGEPOINTS"
+	// maxChargingAlternates is the number of alternative stations offered for each charging stop
+	maxChargingAlternates = 3
+)
+
+var ErrNoReachableChargingStop = errors.New(
+	"no charging station reachable: destination cannot be reached with the given range",
+)
+
+// The Vehicle describes the electric vehicle a charging plan is created for.
+// All charges are fractions of the battery capacity in [0, 1].
+type Vehicle struct {
+	// StateOfCharge is the charge at the start of the route
+	StateOfCharge float64
+	// UsableRangeMeters is the distance the vehicle can drive with a fully charged battery
+	UsableRangeMeters float64
+	// ReserveCharge is the charge the vehicle must never fall below
+	ReserveCharge float64
+	// TargetCharge is the charge the vehicle is charged to at each stop
+	TargetCharge float64
+	// MinPowerKW is the minimum charging power a station has to provide, zero accepts all stations
+	MinPowerKW float64
+	// Current is the current the vehicle can be charged with
+	Current Current
+}
+
+// accepts reports whether the vehicle can be charged at the location,
+// locations without charging info are evaluated by their legacy features
+func (v *Vehicle) accepts(l *PoILocation) bool {
+	info := l.Charging
+	if info == nil {
+		info = chargingInfoFromFeatures(l.Features)
+	}
+	if info.MaxPowerKW < v.MinPowerKW {
+		return false
+	}
+	for _, c := range info.Connectors {
+		if v.Current == CurrentAny || c.Current == v.Current {
+			return true
+		}
+	}
+	return false
+}
+
+// chargingInfoFromFeatures restores the charging info of a location from the legacy feature strings
+func chargingInfoFromFeatures(features []string) *ChargingInfo {
+	info := &ChargingInfo{Connectors: make([]ChargingConnector, 0)}
+	for _, f := range features {
+		switch {
+		case f == FeatureACCharging:
+			info.Connectors = append(info.Connectors, ChargingConnector{Current: CurrentAC})
+		case f == FeatureDCCharging:
+			info.Connectors = append(info.Connectors, ChargingConnector{Current: CurrentDC})
+		case strings.HasSuffix(f, chargingPowerSuffix):
+			kw, err := strconv.ParseFloat(strings.TrimSuffix(f, chargingPowerSuffix), 64)
+			if err == nil {
+				info.MaxPowerKW = math.Max(info.MaxPowerKW, kw)
+			}
+		case strings.HasSuffix(f, chargePointsSuffix):
+			n, err := strconv.Atoi(strings.TrimSuffix(f, chargePointsSuffix))
+			if err == nil {
+				info.ChargePoints = n
+			}
+		}
+	}
+	return info
+}
+
+// consumption is the charge used to drive the distance
+func (v *Vehicle) consumption(meters float64) float64 {
+	return meters / v.UsableRangeMeters
+}
+
+// The ChargingStop is a station along the route the vehicle has to be charged at
+type ChargingStop struct {
+	Station *PoILocation
+	// Measure is the position of the station relative to the route
+	Measure Measure
+	// ArrivalCharge is the charge when arriving at the station
+	ArrivalCharge float64
+	// DepartureCharge is the charge when leaving the station
+	DepartureCharge float64
+	// Alternates are stations reachable instead of the station, ordered by their position along the route
+	// descending. The alternates of an alternate are always empty.
+	Alternates []*ChargingStop
+}
+
+// The ChargingPlan holds the charging stops of a route ordered by their position along the route
+type ChargingPlan struct {
+	Stops []*ChargingStop
+	// ArrivalCharge is the charge when arriving at the end of the route
+	ArrivalCharge float64
+	// RouteLengthMeters is the length of the route without detours to the stations
+	RouteLengthMeters float64
+}
+
+// planChargingStops greedily selects the station furthest along the route the vehicle can reach above its reserve
+// until the end of the route is reachable, which results in the minimum number of stops.
+// The result has to be ranked by route position. A detour to a station is driven to the station and back.
+func planChargingStops(
+	result *SearchResult,
+	lengthMeters float64,
+	vehicle *Vehicle,
+) (*ChargingPlan, error) {
+	stations := make([]*PoILocation, 0, len(result.Locations))
+	for _, l := range result.Locations {
+		if vehicle.accepts(l) {
+			stations = append(stations, l)
+		}
+	}
+
+	plan := &ChargingPlan{Stops: make([]*ChargingStop, 0), RouteLengthMeters: lengthMeters}
+	position, detour, charge := 0.0, 0.0, vehicle.StateOfCharge
+	for {
+		arrival := charge - vehicle.consumption(detour+lengthMeters-position)
+		if arrival >= vehicle.ReserveCharge {
+			plan.ArrivalCharge = arrival
+			return plan, nil
+		}
+
+		// the stations are ordered by route position, the reachable stations are collected furthest first
+		reachable := make([]*ChargingStop, 0)
+		for i := len(stations) - 1; i >= 0; i-- {
+			m := result.Measures[stations[i].ID]
+			if m.DistanceFromStartMeters <= position {
+				break
+			}
+			arrival := charge - vehicle.consumption(
+				detour+m.DistanceFromStartMeters-position+m.DistanceMeters,
+			)
+			if arrival < vehicle.ReserveCharge {
+				continue
+			}
+			reachable = append(reachable, &ChargingStop{
+				Station:         stations[i],
+				Measure:         m,
+				ArrivalCharge:   arrival,
+				DepartureCharge: math.Max(arrival, vehicle.TargetCharge),
+			})
+		}
+		if len(reachable) == 0 {
+			return nil, fmt.Errorf(
+				"%w: distance_from_start_meters=%f",
+				ErrNoReachableChargingStop,
+				position,
+			)
+		}
+
+		stop := reachable[0]
+		stop.Alternates = reachable[1:min(len(reachable), maxChargingAlternates+1)]
+		plan.Stops = append(plan.Stops, stop)
+		position = stop.Measure.DistanceFromStartMeters
+		detour = stop.Measure.DistanceMeters
+		charge = stop.DepartureCharge
+	}
+}
@@ -0,0 +1,20 @@
+package poi
+
+// A Cluster aggregates the locations within a cell of the map zoom level the clusters have been requested for
+type Cluster struct {
+	// Centroid is the mean position of the locations
+	Centroid Coordinates
+	Count    int
+	// Features are the most frequent features of the locations, ordered descending by their frequency
+	Features []string
+}
+
+// The ClusterResult holds the clusters of the locations within a bounding box
+type ClusterResult struct {
+	Clusters []*Cluster
+	// Truncated is set if the bounding box has been too large to be searched completely, only the part closest
+	// to the center of the bounding box has been clustered
+	Truncated bool
+	// CoveredFraction is the share of the bounding box which has been searched, only set if Truncated
+	CoveredFraction float64
+}
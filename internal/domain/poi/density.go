@@ -0,0 +1,69 @@
+package poi
+
+import "github.com/golang/geo/s2"
+
+// The DensityCount sums the locations of a cell and the charging capacity they provide
+type DensityCount struct {
+	Count        int
+	ChargePoints int
+	// PowerKW is the sum of the max charging power of the locations
+	PowerKW float64
+}
+
+// NewDensityCount returns the count of the location, locations without charging info are evaluated by their
+// legacy features
+func NewDensityCount(l *PoILocation) DensityCount {
+	info := l.Charging
+	if info == nil {
+		info = chargingInfoFromFeatures(l.Features)
+	}
+	return DensityCount{Count: 1, ChargePoints: info.ChargePoints, PowerKW: info.MaxPowerKW}
+}
+
+// Add returns the sum of the counts
+func (c DensityCount) Add(o DensityCount) DensityCount {
+	return DensityCount{
+		Count:        c.Count + o.Count,
+		ChargePoints: c.ChargePoints + o.ChargePoints,
+		PowerKW:      c.PowerKW + o.PowerKW,
+	}
+}
+
+// Sub returns the difference of the counts, e.g. the change of a cell when a location is removed
+func (c DensityCount) Sub(o DensityCount) DensityCount {
+	return DensityCount{
+		Count:        c.Count - o.Count,
+		ChargePoints: c.ChargePoints - o.ChargePoints,
+		PowerKW:      c.PowerKW - o.PowerKW,
+	}
+}
+
+// The DensityCell holds the count of the locations within an S2 cell
+type DensityCell struct {
+	Cell s2.CellID
+	// Center is the center of the cell
+	Center Coordinates
+	DensityCount
+}
+
+// The DensityArea is either a bounding box or a polygon, the polygon if any ring is set
+type DensityArea struct {
+	SW, NE Coordinates
+	// Rings are the outer boundary and the holes of the polygon
+	Rings [][]Coordinates
+}
+
+// The DensityResult holds the cells of a level intersecting the area which contain any location, ordered by their
+// cell id. Each cell counts all of its locations, also those outside of the area.
+type DensityResult struct {
+	Level int
+	Cells []*DensityCell
+	// Precomputed reports whether the cells have been summed up from the aggregates maintained on writes instead of
+	// counting the locations
+	Precomputed bool
+	// Truncated is set if the area has been too large to be searched completely, only the part closest to the
+	// center of the area has been counted
+	Truncated bool
+	// CoveredFraction is the share of the area which has been searched, only set if Truncated
+	CoveredFraction float64
+}
@@ -0,0 +1,78 @@
+package poi
+
+import (
+	"slices"
+	"strings"
+)
+
+// The SearchFilter restricts the locations of a search by their attributes, the zero value matches all locations.
+// Locations without charging info are evaluated by their legacy features.
+type SearchFilter struct {
+	// Features are required to be all present
+	Features []string
+	// MinPowerKW is the minimum maximum charging power of a location
+	MinPowerKW float64
+	// Currents requires a connector providing any of the currents
+	Currents []Current
+	// PlugTypes requires a connector with any of the plug types, matched case-insensitively as part of the plug
+	// type, e.g. "combo" matches "DC Kupplung Combo"
+	PlugTypes []string
+	// CountryCodes requires the location to be in any of the countries
+	CountryCodes []string
+}
+
+// Empty reports whether the filter matches all locations
+func (f *SearchFilter) Empty() bool {
+	return f == nil || len(f.Features) == 0 && f.MinPowerKW == 0 && len(f.Currents) == 0 &&
+		len(f.PlugTypes) == 0 && len(f.CountryCodes) == 0
+}
+
+// Matches reports whether the location has all attributes required by the filter
+func (f *SearchFilter) Matches(l *PoILocation) bool {
+	if f.Empty() {
+		return true
+	}
+	for _, feature := range f.Features {
+		if !slices.Contains(l.Features, feature) {
+			return false
+		}
+	}
+	if len(f.CountryCodes) > 0 && !slices.Contains(f.CountryCodes, l.Address.CountryCode) {
+		return false
+	}
+	if f.MinPowerKW == 0 && len(f.Currents) == 0 && len(f.PlugTypes) == 0 {
+		return true
+	}
+	info := l.Charging
+	if info == nil {
+		info = chargingInfoFromFeatures(l.Features)
+	}
+	if info.MaxPowerKW < f.MinPowerKW {
+		return false
+	}
+	return f.matchesConnectors(info.Connectors)
+}
+
+// matchesConnectors reports whether any connector provides a required current and any connector has a required
+// plug type, both conditions may be met by different connectors
+func (f *SearchFilter) matchesConnectors(connectors []ChargingConnector) bool {
+	current := len(f.Currents) == 0
+	plug := len(f.PlugTypes) == 0
+	for _, c := range connectors {
+		current = current || slices.Contains(f.Currents, c.Current)
+		plug = plug || slices.ContainsFunc(f.PlugTypes, func(p string) bool {
+			return strings.Contains(strings.ToLower(c.PlugType), strings.ToLower(p))
+		})
+	}
+	return current && plug
+}
+
+// filter removes all locations from the result which do not match the filter
+func (sr *SearchResult) filter(f *SearchFilter) {
+	if f.Empty() {
+		return
+	}
+	sr.Locations = slices.DeleteFunc(sr.Locations, func(l *PoILocation) bool {
+		return !f.Matches(l)
+	})
+}
@@ -0,0 +1,153 @@
+package poi
+
+import (
+	"cmp"
+	"math"
+	"slices"
+
+	"github.com/golang/geo/s1"
+	"github.com/golang/geo/s2"
+	"github.com/segmentio/ksuid"
+)
+
+const earthRadiusMeter = 6371000.0
+
+// The Measure describes the position of a location relative to the reference of a search,
+// i.e. the center of a proximity search, the center of a bounding box or polygon, or the nearest segment of a route
+type Measure struct {
+	// DistanceMeters is the great-circle distance between the reference and the location
+	DistanceMeters float64
+	// BearingDegrees is the initial bearing from the reference to the location, clockwise from north in [0, 360)
+	BearingDegrees float64
+	// RouteFraction is the position of the projected location along a route in [0, 1], always zero for point references
+	RouteFraction float64
+	// DistanceFromStartMeters is the length of the route from its start to the projected location,
+	// always zero for point references
+	DistanceFromStartMeters float64
+	// AlongRoute reports whether the location was measured relative to a route
+	AlongRoute bool
+}
+
+type SortOrder int
+
+const (
+	SortByDistance SortOrder = iota
+	SortByID
+	SortByRoutePosition
+)
+
+// a reference measures the position of locations relative to the search geometry
+type reference interface {
+	measure(c Coordinates) Measure
+}
+
+type pointReference struct {
+	point s2.Point
+}
+
+func newPointReference(c Coordinates) *pointReference {
+	return &pointReference{point: pointFromCoordinates(c)}
+}
+
+func newBboxReference(sw, ne Coordinates) *pointReference {
+	rect := s2.RectFromLatLng(s2.LatLngFromDegrees(sw.Latitude, sw.Longitude)).
+		AddPoint(s2.LatLngFromDegrees(ne.Latitude, ne.Longitude))
+	return &pointReference{point: s2.PointFromLatLng(rect.Center())}
+}
+
+// newPolygonReference measures relative to the center of the bounding box of the outer ring
+func newPolygonReference(rings [][]Coordinates) *pointReference {
+	bounder := s2.NewRectBounder()
+	if len(rings) > 0 {
+		for _, c := range rings[0] {
+			bounder.AddPoint(pointFromCoordinates(c))
+		}
+	}
+	return &pointReference{point: s2.PointFromLatLng(bounder.RectBound().Center())}
+}
+
+func (r *pointReference) measure(c Coordinates) Measure {
+	p := pointFromCoordinates(c)
+	return Measure{
+		DistanceMeters: angleToMeters(r.point.Distance(p)),
+		BearingDegrees: bearing(r.point, p),
+	}
+}
+
+type routeReference struct {
+	line   s2.Polyline
+	length float64
+}
+
+func newRouteReference(path []Coordinates) *routeReference {
+	line := make(s2.Polyline, len(path))
+	for i, c := range path {
+		line[i] = pointFromCoordinates(c)
+	}
+	return &routeReference{line: line, length: angleToMeters(line.Length())}
+}
+
+func (r *routeReference) measure(c Coordinates) Measure {
+	p := pointFromCoordinates(c)
+	projected, next := r.line.Project(p)
+	fraction := r.line.Uninterpolate(projected, next)
+	return Measure{
+		DistanceMeters:          angleToMeters(projected.Distance(p)),
+		BearingDegrees:          bearing(projected, p),
+		RouteFraction:           fraction,
+		DistanceFromStartMeters: fraction * r.length,
+		AlongRoute:              true,
+	}
+}
+
+// apply removes the locations not matching the filter of the options and ranks the remaining locations. A paged
+// result is ranked within its page only, the pages follow the order of the geo cells.
+func (sr *SearchResult) apply(ref reference, opts []SearchOption) {
+	options := NewSearchOptions(opts...)
+	sr.filter(options.Filter)
+	sr.rank(ref, options.SortBy)
+}
+
+// rank measures all locations of the result relative to the reference and sorts them by the given order.
+// Ties are broken by the location id so that the order is deterministic.
+func (sr *SearchResult) rank(ref reference, order SortOrder) {
+	sr.Measures = make(map[ksuid.KSUID]Measure, len(sr.Locations))
+	for _, l := range sr.Locations {
+		sr.Measures[l.ID] = ref.measure(l.Location)
+	}
+	slices.SortStableFunc(sr.Locations, func(a, b *PoILocation) int {
+		ma, mb := sr.Measures[a.ID], sr.Measures[b.ID]
+		c := 0
+		switch order {
+		case SortByDistance:
+			c = cmp.Compare(ma.DistanceMeters, mb.DistanceMeters)
+		case SortByRoutePosition:
+			c = cmp.Compare(ma.RouteFraction, mb.RouteFraction)
+		case SortByID:
+			// the id is always the tie-breaker
+		}
+		if c != 0 {
+			return c
+		}
+		return ksuid.Compare(a.ID, b.ID)
+	})
+}
+
+func pointFromCoordinates(c Coordinates) s2.Point {
+	return s2.PointFromLatLng(s2.LatLngFromDegrees(c.Latitude, c.Longitude))
+}
+
+func angleToMeters(a s1.Angle) float64 {
+	return a.Radians() * earthRadiusMeter
+}
+
+// bearing calculates the initial great-circle bearing from a to b in degrees clockwise from north
+func bearing(a, b s2.Point) float64 {
+	from, to := s2.LatLngFromPoint(a), s2.LatLngFromPoint(b)
+	dLng := (to.Lng - from.Lng).Radians()
+	y := math.Sin(dLng) * math.Cos(to.Lat.Radians())
+	x := math.Cos(from.Lat.Radians())*math.Sin(to.Lat.Radians()) -
+		math.Sin(from.Lat.Radians())*math.Cos(to.Lat.Radians())*math.Cos(dLng)
+	deg := s1.Angle(math.Atan2(y, x)).Degrees()
+	return math.Mod(deg+360, 360)
+}
@@ -1,15 +1,57 @@
 package poi
 
 import (
+	"fmt"
+	"regexp"
+	"slices"
+
 	"github.com/segmentio/ksuid"
 )
 
+// The BatchResult holds the locations found by their IDs in the order of the IDs and the IDs of the locations
+// which do not exist
+type BatchResult struct {
+	Locations []*PoILocation
+	Missing   []ksuid.KSUID
+}
+
+// The BatchWriteResult is the outcome of writing a single location of a batch, Err is nil if it has been written
+type BatchWriteResult struct {
+	ID  ksuid.KSUID
+	Err error
+}
+
+// The BatchWriteReport holds the results of a batch write in the order of the locations of the batch
+type BatchWriteReport struct {
+	Results []BatchWriteResult
+}
+
+// Failed returns the results of the locations which have not been written
+func (r *BatchWriteReport) Failed() []BatchWriteResult {
+	failed := make([]BatchWriteResult, 0)
+	for _, res := range r.Results {
+		if res.Err != nil {
+			failed = append(failed, res)
+		}
+	}
+	return failed
+}
+
+// AnyVersion writes a location regardless of its stored version
+const AnyVersion int64 = -1
+
+var countryCodePattern = regexp.MustCompile(`^[A-Z]{3}$`)
+
 type PoILocation struct {
 	ID               ksuid.KSUID
 	Location         Coordinates
 	Address          Address
 	LocationEntrance Coordinates
 	Features         []string
+	// Charging is nil for locations ingested before charging info was introduced
+	Charging *ChargingInfo
+	// Version is incremented by each update, zero for imported locations
+	Version int64
 }
 
 type Coordinates struct {
@@ -24,3 +66,77 @@ type Address struct {
 	City         string
 	CountryCode  string
 }
+
+// The ChargingInfo describes the charging capabilities of a charging location
+type ChargingInfo struct {
+	MaxPowerKW   float64
+	ChargePoints int
+	Connectors   []ChargingConnector
+}
+
+type ChargingConnector struct {
+	// PlugType is the plug as named in the source data, e.g. "AC Steckdose Typ 2"
+	PlugType string
+	Current  Current
+}
+
+// The Current a connector provides or a vehicle can be charged with
+type Current int
+
+const (
+	// CurrentAny is an unknown current of a connector or any current for a vehicle
+	CurrentAny Current = iota
+	CurrentAC
+	CurrentDC
+)
+
+// Validate checks that the coordinates are valid WGS coordinates and the address is complete, the street number
+// is optional. A zero entrance is set to the location as done for imported locations.
+func (l *PoILocation) Validate() error {
+	if !l.Location.valid() {
+		return fmt.Errorf(
+			"%w: invalid coordinate lat=%f, lon=%f",
+			ErrInvalidLocation,
+			l.Location.Latitude,
+			l.Location.Longitude,
+		)
+	}
+	if l.LocationEntrance == (Coordinates{}) {
+		l.LocationEntrance = l.Location
+	}
+	if !l.LocationEntrance.valid() {
+		return fmt.Errorf(
+			"%w: invalid entrance lat=%f, lon=%f",
+			ErrInvalidLocation,
+			l.LocationEntrance.Latitude,
+			l.LocationEntrance.Longitude,
+		)
+	}
+	if l.Address.Street == "" || l.Address.ZipCode == "" || l.Address.City == "" {
+		return fmt.Errorf("%w: street, zip code and city of the address are required", ErrInvalidLocation)
+	}
+	if !countryCodePattern.MatchString(l.Address.CountryCode) {
+		return fmt.Errorf(
+			"%w: country=%s is not an ISO 3166-1 alpha-3 country code",
+			ErrInvalidLocation,
+			l.Address.CountryCode,
+		)
+	}
+	return nil
+}
+
+// Clone returns a deep copy of the location
+func (l *PoILocation) Clone() *PoILocation {
+	c := *l
+	c.Features = slices.Clone(l.Features)
+	if l.Charging != nil {
+		charging := *l.Charging
+		charging.Connectors = slices.Clone(l.Charging.Connectors)
+		c.Charging = &charging
+	}
+	return &c
+}
+
+func (c Coordinates) valid() bool {
+	return c.Latitude >= -90 && c.Latitude <= 90 && c.Longitude >= -180 && c.Longitude <= 180
+}
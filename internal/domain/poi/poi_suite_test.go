@@ -0,0 +1,13 @@
+package poi_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestPoI(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "PoI Suite")
+}
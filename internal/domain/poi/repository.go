@@ -16,31 +16,114 @@ var (
 	ErrDBUpsert                 = errors.New("failed to upsert entity")
 	ErrDBBatchUpsert            = errors.New("failed to upsert batch")
 	ErrInvalidSearchCoordinates = errors.New("invalid geo search parameters: invalid coordinates")
+	ErrInvalidPageToken         = errors.New("invalid page token: token is malformed or does not match the search")
+	ErrLocationAlreadyExists    = errors.New("location already exists")
+	ErrInvalidLocation          = errors.New("invalid location")
+	ErrConcurrentModification   = errors.New("location was deleted by a concurrent request")
+	ErrDBDelete                 = errors.New("failed to delete entity")
+	ErrVersionMismatch          = errors.New("location has been modified: version does not match")
 )
 
 type Repository interface {
-	UpsertBatch(ctx context.Context, pois []*PoILocation, logger *zap.Logger) error
+	// UpsertBatch reports the result of each location, it fails with ErrDBBatchUpsert if any location has not been
+	// written
+	UpsertBatch(ctx context.Context, pois []*PoILocation, logger *zap.Logger) (*BatchWriteReport, error)
 
 	Upsert(ctx context.Context, domain *PoILocation, logger *zap.Logger) error
 
+	// Create stores the location with version 1, it fails with ErrLocationAlreadyExists if a location with the same ID
+	// exists
+	Create(ctx context.Context, domain *PoILocation, logger *zap.Logger) error
+
+	// Update stores the location with the next version if the stored location has the version of the given location,
+	// otherwise it fails with ErrVersionMismatch, or ErrLocationNotFound if the location does not exist
+	Update(ctx context.Context, domain *PoILocation, logger *zap.Logger) error
+
+	// Delete removes the location if it has the given version or the version is AnyVersion, otherwise it fails with
+	// ErrVersionMismatch, or ErrLocationNotFound if the location does not exist
+	Delete(ctx context.Context, id ksuid.KSUID, version int64, logger *zap.Logger) error
+
 	GetByID(ctx context.Context, id ksuid.KSUID, logger *zap.Logger) (*PoILocation, error)
 
+	// GetByIDs returns the existing locations of the ids in no particular order
+	GetByIDs(ctx context.Context, ids []ksuid.KSUID, logger *zap.Logger) ([]*PoILocation, error)
+
 	GetByProximity(
 		ctx context.Context,
 		cntr Coordinates,
 		radius float64,
 		logger *zap.Logger,
-	) ([]*PoILocation, error)
+		opts ...SearchOption,
+	) (*SearchResult, error)
 
 	GetByBbox(
 		ctx context.Context,
 		sw, ne Coordinates,
 		logger *zap.Logger,
-	) ([]*PoILocation, error)
+		opts ...SearchOption,
+	) (*SearchResult, error)
 
 	GetByRoute(
 		ctx context.Context,
 		path []Coordinates,
 		logger *zap.Logger,
+		opts ...SearchOption,
+	) (*SearchResult, error)
+
+	GetByPolygon(
+		ctx context.Context,
+		rings [][]Coordinates,
+		logger *zap.Logger,
+		opts ...SearchOption,
+	) (*SearchResult, error)
+
+	StreamByProximity(
+		ctx context.Context,
+		cntr Coordinates,
+		radius float64,
+		logger *zap.Logger,
+		handle SearchResultHandler,
+		opts ...SearchOption,
+	) error
+
+	StreamByBbox(
+		ctx context.Context,
+		sw, ne Coordinates,
+		logger *zap.Logger,
+		handle SearchResultHandler,
+		opts ...SearchOption,
+	) error
+
+	StreamByRoute(
+		ctx context.Context,
+		path []Coordinates,
+		logger *zap.Logger,
+		handle SearchResultHandler,
+		opts ...SearchOption,
+	) error
+
+	GetNearest(
+		ctx context.Context,
+		cntr Coordinates,
+		k int,
+		maxRadius float64,
+		logger *zap.Logger,
+		opts ...SearchOption,
 	) ([]*PoILocation, error)
+
+	// GetClusters aggregates the locations within the bounding box into the cells appropriate to the map zoom level
+	GetClusters(
+		ctx context.Context,
+		sw, ne Coordinates,
+		zoom int,
+		logger *zap.Logger,
+	) (*ClusterResult, error)
+
+	// GetDensity counts the locations and their charging capacity per cell of the level within the area
+	GetDensity(
+		ctx context.Context,
+		area *DensityArea,
+		level int,
+		logger *zap.Logger,
+	) (*DensityResult, error)
 }
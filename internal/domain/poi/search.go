@@ -0,0 +1,86 @@
+package poi
+
+import "github.com/segmentio/ksuid"
+
+// The SearchOptions control how a geo search is performed by the repository
+type SearchOptions struct {
+	// Strict discards all locations which are in the covering of the search area but not in the exact search area
+	Strict bool
+	// SortBy is the order of the returned locations, defaults to the distance to the search reference
+	SortBy SortOrder
+	// PageSize limits the number of locations returned, zero returns all locations of the search area
+	PageSize int
+	// PageToken continues a previous search at the position the previous page ended
+	PageToken string
+	// CorridorMeters limits a route search to locations within the distance to the route,
+	// zero searches all cells touched by the route
+	CorridorMeters float64
+	// Filter restricts the locations by their attributes, nil returns all locations
+	Filter *SearchFilter
+}
+
+type SearchOption func(o *SearchOptions)
+
+func WithStrict(strict bool) SearchOption {
+	return func(o *SearchOptions) {
+		o.Strict = strict
+	}
+}
+
+func WithSortBy(order SortOrder) SearchOption {
+	return func(o *SearchOptions) {
+		o.SortBy = order
+	}
+}
+
+func WithPage(size int, token string) SearchOption {
+	return func(o *SearchOptions) {
+		o.PageSize = size
+		o.PageToken = token
+	}
+}
+
+func WithCorridor(meters float64) SearchOption {
+	return func(o *SearchOptions) {
+		o.CorridorMeters = meters
+	}
+}
+
+func WithFilter(filter *SearchFilter) SearchOption {
+	return func(o *SearchOptions) {
+		o.Filter = filter
+	}
+}
+
+// Paged reports whether the search has to be performed page by page
+func (o *SearchOptions) Paged() bool {
+	return o.PageSize > 0
+}
+
+func NewSearchOptions(opts ...SearchOption) *SearchOptions {
+	options := &SearchOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
+	return options
+}
+
+// The SearchResult holds the locations of a geo search and additional information about the search
+type SearchResult struct {
+	Locations []*PoILocation
+	// Discarded is the number of locations removed by strict filtering
+	Discarded int
+	// Measures holds the position of each location relative to the search reference keyed by the location id
+	Measures map[ksuid.KSUID]Measure
+	// NextPageToken continues the search with the next page, empty if there are no more locations
+	NextPageToken string
+	// Truncated is set if the search area has been too large to be searched completely, only the part closest
+	// to the search reference, e.g. the beginning of a route, has been searched
+	Truncated bool
+	// CoveredFraction is the share of the search area which has been searched, only set if Truncated
+	CoveredFraction float64
+}
+
+// A SearchResultHandler consumes the partial results of a streamed search. The handler is never called
+// concurrently, returning an error aborts the search.
+type SearchResultHandler func(result *SearchResult) error
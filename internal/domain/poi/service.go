@@ -40,12 +40,145 @@ func (ls *LocationService) Info(
 	return location, err
 }
 
+// BatchInfo gets the locations of the ids at once, duplicate ids are returned once
+func (ls *LocationService) BatchInfo(
+	ctx context.Context,
+	ids []ksuid.KSUID,
+	logger *zap.Logger,
+) (*BatchResult, error) {
+	// handle context cancellation
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+	logger.Debug("getting pois from db", zap.String("operation", "GetByIDs"))
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	locations, err := ls.repo.GetByIDs(ctx, ids, logger)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get locations by ids num_ids=%d: %w", len(ids), err)
+	}
+	found := make(map[ksuid.KSUID]*PoILocation, len(locations))
+	for _, l := range locations {
+		found[l.ID] = l
+	}
+	result := &BatchResult{Locations: make([]*PoILocation, 0, len(locations))}
+	seen := make(map[ksuid.KSUID]bool, len(ids))
+	for _, id := range ids {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		if l, ok := found[id]; ok {
+			result.Locations = append(result.Locations, l)
+		} else {
+			result.Missing = append(result.Missing, id)
+		}
+	}
+	if len(result.Missing) > 0 {
+		logger.Warn("locations not found", zap.Int("num_missing", len(result.Missing)))
+	}
+	return result, nil
+}
+
+// Create stores a new location, a location without ID is assigned a new ID
+func (ls *LocationService) Create(
+	ctx context.Context,
+	location *PoILocation,
+	logger *zap.Logger,
+) (*PoILocation, error) {
+	// handle context cancellation
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+	if location.ID == ksuid.Nil {
+		location.ID = ksuid.New()
+	}
+	if err := location.Validate(); err != nil {
+		return nil, err
+	}
+	logger.Debug("creating poi in db", zap.String("operation", "Create"))
+	ctx, cancel := context.WithTimeout(ctx, 1*time.Second)
+	defer cancel()
+	if err := ls.repo.Create(ctx, location, logger); err != nil {
+		return nil, fmt.Errorf("failed to create location id=%s: %w", location.ID, err)
+	}
+	return location, nil
+}
+
+// Update applies the changes to the stored location and replaces it with the valid result. The update fails with
+// ErrVersionMismatch if the location does not have the given version or has been modified concurrently, use
+// AnyVersion to update the current version. It fails with ErrConcurrentModification if the location has been
+// deleted concurrently.
+func (ls *LocationService) Update(
+	ctx context.Context,
+	id ksuid.KSUID,
+	version int64,
+	update func(l *PoILocation),
+	logger *zap.Logger,
+) (*PoILocation, error) {
+	// handle context cancellation
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+	logger.Debug("updating poi in db", zap.String("operation", "Update"))
+	ctx, cancel := context.WithTimeout(ctx, 1*time.Second)
+	defer cancel()
+	location, err := ls.repo.GetByID(ctx, id, logger)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get location id=%s for update: %w", id, err)
+	}
+	if version != AnyVersion && location.Version != version {
+		return nil, fmt.Errorf(
+			"failed to update location id=%s, version=%d: %w",
+			id,
+			location.Version,
+			ErrVersionMismatch,
+		)
+	}
+	update(location)
+	location.ID = id
+	if err = location.Validate(); err != nil {
+		return nil, err
+	}
+	err = ls.repo.Update(ctx, location, logger)
+	// the location has been read above, hence it was deleted in the meantime
+	if errors.Is(err, ErrLocationNotFound) {
+		// the cause is not wrapped, the location has been found and must not be reported as not found
+		return nil, fmt.Errorf("%w: location id=%s: %v", ErrConcurrentModification, id, err)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to update location id=%s: %w", id, err)
+	}
+	return location, nil
+}
+
+// Delete removes the location if it has the given version, use AnyVersion to delete any version
+func (ls *LocationService) Delete(
+	ctx context.Context,
+	id ksuid.KSUID,
+	version int64,
+	logger *zap.Logger,
+) error {
+	// handle context cancellation
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	logger.Debug("deleting poi from db", zap.String("operation", "Delete"))
+	ctx, cancel := context.WithTimeout(ctx, 1*time.Second)
+	defer cancel()
+	if err := ls.repo.Delete(ctx, id, version, logger); err != nil {
+		return fmt.Errorf("failed to delete location id=%s: %w", id, err)
+	}
+	return nil
+}
+
 func (ls *LocationService) Proximity(
 	ctx context.Context,
 	cntr Coordinates,
 	radius float64,
 	logger *zap.Logger,
-) ([]*PoILocation, error) {
+	opts ...SearchOption,
+) (*SearchResult, error) {
 	// handle context cancellation
 	if ctx.Err() != nil {
 		return nil, ctx.Err()
@@ -57,11 +190,12 @@ func (ls *LocationService) Proximity(
 	// ensure db queries are canceled before causing sever loss of responsiveness
 	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
-	locations, err := ls.repo.GetByProximity(
+	result, err := ls.repo.GetByProximity(
 		ctx,
 		cntr,
 		radius,
 		logger,
+		opts...,
 	)
 	if err != nil {
 		return nil, fmt.Errorf(
@@ -72,14 +206,16 @@ func (ls *LocationService) Proximity(
 			err,
 		)
 	}
-	return locations, nil
+	result.apply(newPointReference(cntr), opts)
+	return result, nil
 }
 
 func (ls *LocationService) Bbox(
 	ctx context.Context,
 	sw, ne Coordinates,
 	logger *zap.Logger,
-) ([]*PoILocation, error) {
+	opts ...SearchOption,
+) (*SearchResult, error) {
 	// handle context cancellation
 	if ctx.Err() != nil {
 		return nil, ctx.Err()
@@ -91,7 +227,7 @@ func (ls *LocationService) Bbox(
 	// ensure db queries are canceled before causing sever loss of responsiveness
 	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
-	locations, err := ls.repo.GetByBbox(ctx, sw, ne, logger)
+	result, err := ls.repo.GetByBbox(ctx, sw, ne, logger, opts...)
 	if err != nil {
 		return nil, fmt.Errorf(
 			"failed bbox search for area ne.lat=%f, ne.lon=%f, sw.lat=%f, sw.lon=%f: %w",
@@ -102,14 +238,16 @@ func (ls *LocationService) Bbox(
 			err,
 		)
 	}
-	return locations, nil
+	result.apply(newBboxReference(sw, ne), opts)
+	return result, nil
 }
 
 func (ls *LocationService) Route(
 	ctx context.Context,
 	wgsPath []Coordinates,
 	logger *zap.Logger,
-) ([]*PoILocation, error) {
+	opts ...SearchOption,
+) (*SearchResult, error) {
 	// handle context cancellation
 	if ctx.Err() != nil {
 		return nil, ctx.Err()
@@ -121,9 +259,293 @@ func (ls *LocationService) Route(
 	// ensure db queries are canceled before causing sever loss of responsiveness
 	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
-	locations, err := ls.repo.GetByRoute(ctx, wgsPath, logger)
+	result, err := ls.repo.GetByRoute(ctx, wgsPath, logger, opts...)
 	if err != nil {
 		return nil, fmt.Errorf("route search failed route_length=%d: %w", len(wgsPath), err)
 	}
-	return locations, nil
+	result.apply(newRouteReference(wgsPath), opts)
+	return result, nil
+}
+
+// PlanChargingStops searches the charging stations along the route and selects the stops required to reach
+// the end of the route without falling below the reserve charge of the vehicle. Routes which are too long to be
+// searched completely fail with ErrTooLargeSearchArea.
+func (ls *LocationService) PlanChargingStops(
+	ctx context.Context,
+	wgsPath []Coordinates,
+	vehicle *Vehicle,
+	logger *zap.Logger,
+	opts ...SearchOption,
+) (*ChargingPlan, error) {
+	// the planner requires all stations ordered along the route
+	opts = append(opts, WithSortBy(SortByRoutePosition), WithPage(0, ""))
+	result, err := ls.Route(ctx, wgsPath, logger, opts...)
+	if err != nil {
+		return nil, err
+	}
+	// the stations of the part of the route which has not been searched would be missing from the plan
+	if result.Truncated {
+		return nil, fmt.Errorf(
+			"%w: route is too long to plan charging stops route_length=%d, covered_fraction=%f",
+			ErrTooLargeSearchArea,
+			len(wgsPath),
+			result.CoveredFraction,
+		)
+	}
+	logger.Debug(
+		"planning charging stops along route",
+		zap.Int("num_locations", len(result.Locations)),
+	)
+	plan, err := planChargingStops(result, newRouteReference(wgsPath).length, vehicle)
+	if err != nil {
+		return nil, fmt.Errorf("charging stop planning failed route_length=%d: %w", len(wgsPath), err)
+	}
+	return plan, nil
+}
+
+func (ls *LocationService) Polygon(
+	ctx context.Context,
+	rings [][]Coordinates,
+	logger *zap.Logger,
+	opts ...SearchOption,
+) (*SearchResult, error) {
+	// handle context cancellation
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+	logger.Debug(
+		"getting locations in polygon from db",
+		zap.String("operation", "GetByPolygon"),
+	)
+	// ensure db queries are canceled before causing sever loss of responsiveness
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	result, err := ls.repo.GetByPolygon(ctx, rings, logger, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("polygon search failed num_rings=%d: %w", len(rings), err)
+	}
+	result.apply(newPolygonReference(rings), opts)
+	return result, nil
+}
+
+func (ls *LocationService) Nearest(
+	ctx context.Context,
+	cntr Coordinates,
+	k int,
+	maxRadius float64,
+	logger *zap.Logger,
+	opts ...SearchOption,
+) (*SearchResult, error) {
+	// handle context cancellation
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+	logger.Debug(
+		"getting nearest locations from db",
+		zap.String("operation", "GetNearest"),
+	)
+	// ensure db queries are canceled before causing sever loss of responsiveness
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	locations, err := ls.repo.GetNearest(ctx, cntr, k, maxRadius, logger, opts...)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"failed nearest search center.lat=%f, center.lon=%f, k=%d, max_radius_meters=%f: %w",
+			cntr.Latitude,
+			cntr.Longitude,
+			k,
+			maxRadius,
+			err,
+		)
+	}
+	result := &SearchResult{Locations: locations}
+	result.apply(newPointReference(cntr), opts)
+	return result, nil
+}
+
+// Clusters aggregates the locations within the bounding box for a map with the zoom level
+func (ls *LocationService) Clusters(
+	ctx context.Context,
+	sw, ne Coordinates,
+	zoom int,
+	logger *zap.Logger,
+) (*ClusterResult, error) {
+	// handle context cancellation
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+	logger.Debug(
+		"getting clusters in bbox from db",
+		zap.String("operation", "GetClusters"),
+	)
+	// ensure db queries are canceled before causing sever loss of responsiveness
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	result, err := ls.repo.GetClusters(ctx, sw, ne, zoom, logger)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"failed clustering for area ne.lat=%f, ne.lon=%f, sw.lat=%f, sw.lon=%f, zoom=%d: %w",
+			ne.Latitude,
+			ne.Longitude,
+			sw.Latitude,
+			sw.Longitude,
+			zoom,
+			err,
+		)
+	}
+	return result, nil
+}
+
+// Density counts the locations and their charging capacity per cell of the level within the bounding box or polygon
+func (ls *LocationService) Density(
+	ctx context.Context,
+	area *DensityArea,
+	level int,
+	logger *zap.Logger,
+) (*DensityResult, error) {
+	// handle context cancellation
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+	logger.Debug(
+		"getting density in area from db",
+		zap.String("operation", "GetDensity"),
+	)
+	// ensure db queries are canceled before causing sever loss of responsiveness
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	result, err := ls.repo.GetDensity(ctx, area, level, logger)
+	if err != nil {
+		if len(area.Rings) > 0 {
+			return nil, fmt.Errorf("density failed for polygon num_rings=%d, level=%d: %w", len(area.Rings), level, err)
+		}
+		return nil, fmt.Errorf(
+			"density failed for area ne.lat=%f, ne.lon=%f, sw.lat=%f, sw.lon=%f, level=%d: %w",
+			area.NE.Latitude,
+			area.NE.Longitude,
+			area.SW.Latitude,
+			area.SW.Longitude,
+			level,
+			err,
+		)
+	}
+	return result, nil
+}
+
+func (ls *LocationService) StreamProximity(
+	ctx context.Context,
+	cntr Coordinates,
+	radius float64,
+	logger *zap.Logger,
+	handle SearchResultHandler,
+	opts ...SearchOption,
+) error {
+	// handle context cancellation
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	logger.Debug(
+		"streaming locations within proximity from db",
+		zap.String("operation", "StreamByProximity"),
+	)
+	// ensure db queries are canceled before causing sever loss of responsiveness
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	err := ls.repo.StreamByProximity(
+		ctx,
+		cntr,
+		radius,
+		logger,
+		rankedHandler(newPointReference(cntr), handle, opts),
+		opts...,
+	)
+	if err != nil {
+		return fmt.Errorf(
+			"failed proximity stream center.lat=%f, center.lon=%f, radius_meters=%f: %w",
+			cntr.Latitude,
+			cntr.Longitude,
+			radius,
+			err,
+		)
+	}
+	return nil
+}
+
+func (ls *LocationService) StreamBbox(
+	ctx context.Context,
+	sw, ne Coordinates,
+	logger *zap.Logger,
+	handle SearchResultHandler,
+	opts ...SearchOption,
+) error {
+	// handle context cancellation
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	logger.Debug(
+		"streaming locations in bbox from db",
+		zap.String("operation", "StreamByBbox"),
+	)
+	// ensure db queries are canceled before causing sever loss of responsiveness
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	err := ls.repo.StreamByBbox(
+		ctx,
+		sw,
+		ne,
+		logger,
+		rankedHandler(newBboxReference(sw, ne), handle, opts),
+		opts...,
+	)
+	if err != nil {
+		return fmt.Errorf(
+			"failed bbox stream for area ne.lat=%f, ne.lon=%f, sw.lat=%f, sw.lon=%f: %w",
+			ne.Latitude,
+			ne.Longitude,
+			sw.Latitude,
+			sw.Longitude,
+			err,
+		)
+	}
+	return nil
+}
+
+func (ls *LocationService) StreamRoute(
+	ctx context.Context,
+	wgsPath []Coordinates,
+	logger *zap.Logger,
+	handle SearchResultHandler,
+	opts ...SearchOption,
+) error {
+	// handle context cancellation
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	logger.Debug(
+		"streaming locations along route from db",
+		zap.String("operation", "StreamByRoute"),
+	)
+	// ensure db queries are canceled before causing sever loss of responsiveness
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	err := ls.repo.StreamByRoute(
+		ctx,
+		wgsPath,
+		logger,
+		rankedHandler(newRouteReference(wgsPath), handle, opts),
+		opts...,
+	)
+	if err != nil {
+		return fmt.Errorf("route stream failed route_length=%d: %w", len(wgsPath), err)
+	}
+	return nil
+}
+
+// rankedHandler filters and ranks each partial result before it is handed to the handler,
+// the order is therefore only guaranteed within a partial result
+func rankedHandler(ref reference, handle SearchResultHandler, opts []SearchOption) SearchResultHandler {
+	return func(result *SearchResult) error {
+		result.apply(ref, opts)
+		return handle(result)
+	}
 }
@@ -0,0 +1,122 @@
+package poi_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/segmentio/ksuid"
+	"go.uber.org/zap"
+
+	"github.com/grntlrduck-cloud/go-grpc-geohasing-service-sample/internal/domain/poi"
+)
+
+// fakeRepository returns the configured results, all other methods of the Repository are not implemented
+type fakeRepository struct {
+	poi.Repository
+	routeResult *poi.SearchResult
+	location    *poi.PoILocation
+	// beforeUpdate is called by Update before the location is written, e.g. to delete it concurrently
+	beforeUpdate func()
+}
+
+func (r *fakeRepository) GetByID(_ context.Context, id ksuid.KSUID, _ *zap.Logger) (*poi.PoILocation, error) {
+	if r.location == nil || r.location.ID != id {
+		return nil, poi.ErrLocationNotFound
+	}
+	return r.location.Clone(), nil
+}
+
+func (r *fakeRepository) Update(_ context.Context, l *poi.PoILocation, _ *zap.Logger) error {
+	if r.beforeUpdate != nil {
+		r.beforeUpdate()
+	}
+	if r.location == nil || r.location.ID != l.ID {
+		return poi.ErrLocationNotFound
+	}
+	r.location = l.Clone()
+	return nil
+}
+
+func (r *fakeRepository) GetByRoute(
+	_ context.Context,
+	_ []poi.Coordinates,
+	_ *zap.Logger,
+	_ ...poi.SearchOption,
+) (*poi.SearchResult, error) {
+	return r.routeResult, nil
+}
+
+var _ = Describe("given location service", func() {
+	ctx := context.Background()
+	route := []poi.Coordinates{{Latitude: 48.796183, Longitude: 9.181946}, {Latitude: 49.75371, Longitude: 8.611994}}
+	vehicle := &poi.Vehicle{StateOfCharge: 1, UsableRangeMeters: 1_000_000, ReserveCharge: 0.1, TargetCharge: 0.8}
+
+	When("charging stops are planned", func() {
+		It("plans the stops of completely searched route", func() {
+			service := poi.NewLocationService(&fakeRepository{routeResult: &poi.SearchResult{}})
+			plan, err := service.PlanChargingStops(ctx, route, vehicle, zap.NewNop())
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(plan.Stops).To(BeEmpty())
+		})
+
+		It("returns error for truncated route", func() {
+			service := poi.NewLocationService(&fakeRepository{
+				routeResult: &poi.SearchResult{Truncated: true, CoveredFraction: 0.6},
+			})
+			_, err := service.PlanChargingStops(ctx, route, vehicle, zap.NewNop())
+			Expect(err).To(MatchError(poi.ErrTooLargeSearchArea))
+		})
+	})
+
+	When("location is updated", func() {
+		id := ksuid.New()
+		stored := func() *poi.PoILocation {
+			return &poi.PoILocation{
+				ID:       id,
+				Location: poi.Coordinates{Latitude: 49.64636, Longitude: 8.78141},
+				Address: poi.Address{
+					Street:       "Schulstr.",
+					StreetNumber: "12",
+					ZipCode:      "64658",
+					City:         "Fürth",
+					CountryCode:  "DEU",
+				},
+				LocationEntrance: poi.Coordinates{Latitude: 49.64636, Longitude: 8.78141},
+			}
+		}
+
+		It("updates the stored location", func() {
+			repo := &fakeRepository{location: stored()}
+			service := poi.NewLocationService(repo)
+			updated, err := service.Update(ctx, id, poi.AnyVersion, func(l *poi.PoILocation) {
+				l.Address.City = "Rimbach"
+			}, zap.NewNop())
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(updated.Address.City).To(Equal("Rimbach"))
+			Expect(repo.location.Address.City).To(Equal("Rimbach"))
+		})
+
+		It("returns concurrent modification error for location deleted during the update", func() {
+			repo := &fakeRepository{location: stored()}
+			repo.beforeUpdate = func() {
+				repo.location = nil
+			}
+			service := poi.NewLocationService(repo)
+			_, err := service.Update(ctx, id, poi.AnyVersion, func(l *poi.PoILocation) {
+				l.Address.City = "Rimbach"
+			}, zap.NewNop())
+			Expect(err).To(MatchError(poi.ErrConcurrentModification))
+			Expect(err).To(Not(MatchError(poi.ErrLocationNotFound)))
+			Expect(err.Error()).To(ContainSubstring(id.String()))
+			Expect(err.Error()).To(ContainSubstring(poi.ErrLocationNotFound.Error()))
+		})
+
+		It("returns not found error for location deleted before the update", func() {
+			service := poi.NewLocationService(&fakeRepository{})
+			_, err := service.Update(ctx, id, poi.AnyVersion, func(_ *poi.PoILocation) {}, zap.NewNop())
+			Expect(err).To(MatchError(poi.ErrLocationNotFound))
+			Expect(err).To(Not(MatchError(poi.ErrConcurrentModification)))
+		})
+	})
+})
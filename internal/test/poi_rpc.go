@@ -2,13 +2,16 @@ package test
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
 
 	"github.com/google/uuid"
 	. "github.com/onsi/gomega" //nolint:stylecheck
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 
 	poiv1 "github.com/grntlrduck-cloud/go-grpc-geohasing-service-sample/api/gen/v1/poi"
 )
@@ -28,6 +31,60 @@ func (p *PoIRPCClient) PoI(
 	return resp, err
 }
 
+func (p *PoIRPCClient) BatchGetPoIs(
+	ids []string,
+	correlation bool,
+	apiKey bool,
+	apiKeyOverride string,
+) (*poiv1.BatchGetPoIsResponse, error) {
+	ctx := contextWithHeaders(correlation, apiKey, apiKeyOverride)
+	resp, err := p.client.BatchGetPoIs(ctx, &poiv1.BatchGetPoIsRequest{Ids: ids})
+	return resp, err
+}
+
+func (p *PoIRPCClient) CreatePoI(
+	location *poiv1.PoI,
+	correlation bool,
+	apiKey bool,
+	apiKeyOverride string,
+) (*poiv1.PoIResponse, error) {
+	ctx := contextWithHeaders(correlation, apiKey, apiKeyOverride)
+	resp, err := p.client.CreatePoI(ctx, &poiv1.CreatePoIRequest{Poi: location})
+	return resp, err
+}
+
+func (p *PoIRPCClient) UpdatePoI(
+	location *poiv1.PoI,
+	paths []string,
+	etag string,
+	correlation bool,
+	apiKey bool,
+	apiKeyOverride string,
+) (*poiv1.PoIResponse, error) {
+	ctx := contextWithHeaders(correlation, apiKey, apiKeyOverride)
+	resp, err := p.client.UpdatePoI(
+		ctx,
+		&poiv1.UpdatePoIRequest{
+			Poi:        location,
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: paths},
+			Etag:       etag,
+		},
+	)
+	return resp, err
+}
+
+func (p *PoIRPCClient) DeletePoI(
+	id string,
+	etag string,
+	correlation bool,
+	apiKey bool,
+	apiKeyOverride string,
+) (*poiv1.DeletePoIResponse, error) {
+	ctx := contextWithHeaders(correlation, apiKey, apiKeyOverride)
+	resp, err := p.client.DeletePoI(ctx, &poiv1.DeletePoIRequest{Id: id, Etag: etag})
+	return resp, err
+}
+
 func (p *PoIRPCClient) Bbox(
 	ne, sw *poiv1.Coordinate,
 	correlation bool,
@@ -39,6 +96,55 @@ func (p *PoIRPCClient) Bbox(
 	return resp, err
 }
 
+func (p *PoIRPCClient) StrictBbox(
+	ne, sw *poiv1.Coordinate,
+	correlation bool,
+	apiKey bool,
+	apiKeyOverride string,
+) (*poiv1.PoISearchResponse, error) {
+	ctx := contextWithHeaders(correlation, apiKey, apiKeyOverride)
+	resp, err := p.client.BBox(
+		ctx,
+		&poiv1.BBoxRequest{Bbox: &poiv1.BBox{Ne: ne, Sw: sw}, Strict: true},
+	)
+	return resp, err
+}
+
+func (p *PoIRPCClient) FilteredBbox(
+	ne, sw *poiv1.Coordinate,
+	filter *poiv1.SearchFilter,
+	correlation bool,
+	apiKey bool,
+	apiKeyOverride string,
+) (*poiv1.PoISearchResponse, error) {
+	ctx := contextWithHeaders(correlation, apiKey, apiKeyOverride)
+	resp, err := p.client.BBox(
+		ctx,
+		&poiv1.BBoxRequest{Bbox: &poiv1.BBox{Ne: ne, Sw: sw}, Filter: filter},
+	)
+	return resp, err
+}
+
+func (p *PoIRPCClient) PagedBbox(
+	ne, sw *poiv1.Coordinate,
+	pageSize int32,
+	pageToken string,
+	correlation bool,
+	apiKey bool,
+	apiKeyOverride string,
+) (*poiv1.PoISearchResponse, error) {
+	ctx := contextWithHeaders(correlation, apiKey, apiKeyOverride)
+	resp, err := p.client.BBox(
+		ctx,
+		&poiv1.BBoxRequest{
+			Bbox:      &poiv1.BBox{Ne: ne, Sw: sw},
+			PageSize:  pageSize,
+			PageToken: pageToken,
+		},
+	)
+	return resp, err
+}
+
 func (p *PoIRPCClient) Proximity(
 	cntr *poiv1.Coordinate,
 	radiusMeters float64,
@@ -54,6 +160,37 @@ func (p *PoIRPCClient) Proximity(
 	return resp, err
 }
 
+func (p *PoIRPCClient) StrictProximity(
+	cntr *poiv1.Coordinate,
+	radiusMeters float64,
+	correlation bool,
+	apiKey bool,
+	apiKeyOverride string,
+) (*poiv1.PoISearchResponse, error) {
+	ctx := contextWithHeaders(correlation, apiKey, apiKeyOverride)
+	resp, err := p.client.Proximity(
+		ctx,
+		&poiv1.ProximityRequest{Center: cntr, RadiusMeters: radiusMeters, Strict: true},
+	)
+	return resp, err
+}
+
+func (p *PoIRPCClient) SortedProximity(
+	cntr *poiv1.Coordinate,
+	radiusMeters float64,
+	sortBy poiv1.SortBy,
+	correlation bool,
+	apiKey bool,
+	apiKeyOverride string,
+) (*poiv1.PoISearchResponse, error) {
+	ctx := contextWithHeaders(correlation, apiKey, apiKeyOverride)
+	resp, err := p.client.Proximity(
+		ctx,
+		&poiv1.ProximityRequest{Center: cntr, RadiusMeters: radiusMeters, SortBy: sortBy},
+	)
+	return resp, err
+}
+
 func (p *PoIRPCClient) Route(
 	route []*poiv1.Coordinate,
 	correlation bool,
@@ -65,6 +202,164 @@ func (p *PoIRPCClient) Route(
 	return resp, err
 }
 
+func (p *PoIRPCClient) SortedRoute(
+	route []*poiv1.Coordinate,
+	sortBy poiv1.SortBy,
+	correlation bool,
+	apiKey bool,
+	apiKeyOverride string,
+) (*poiv1.PoISearchResponse, error) {
+	ctx := contextWithHeaders(correlation, apiKey, apiKeyOverride)
+	resp, err := p.client.Route(ctx, &poiv1.RouteRequest{Route: route, SortBy: sortBy})
+	return resp, err
+}
+
+func (p *PoIRPCClient) CorridorRoute(
+	route []*poiv1.Coordinate,
+	corridorMeters float64,
+	correlation bool,
+	apiKey bool,
+	apiKeyOverride string,
+) (*poiv1.PoISearchResponse, error) {
+	ctx := contextWithHeaders(correlation, apiKey, apiKeyOverride)
+	resp, err := p.client.Route(
+		ctx,
+		&poiv1.RouteRequest{Route: route, CorridorMeters: corridorMeters},
+	)
+	return resp, err
+}
+
+func (p *PoIRPCClient) Nearest(
+	cntr *poiv1.Coordinate,
+	k int32,
+	maxRadiusMeters float64,
+	correlation bool,
+	apiKey bool,
+	apiKeyOverride string,
+) (*poiv1.PoISearchResponse, error) {
+	ctx := contextWithHeaders(correlation, apiKey, apiKeyOverride)
+	resp, err := p.client.Nearest(
+		ctx,
+		&poiv1.NearestRequest{Center: cntr, K: k, MaxRadiusMeters: maxRadiusMeters},
+	)
+	return resp, err
+}
+
+func (p *PoIRPCClient) Polygon(
+	rings [][]*poiv1.Coordinate,
+	correlation bool,
+	apiKey bool,
+	apiKeyOverride string,
+) (*poiv1.PoISearchResponse, error) {
+	ctx := contextWithHeaders(correlation, apiKey, apiKeyOverride)
+	request := &poiv1.PolygonRequest{Rings: make([]*poiv1.Ring, len(rings))}
+	for i, r := range rings {
+		request.Rings[i] = &poiv1.Ring{Coordinates: r}
+	}
+	resp, err := p.client.Polygon(ctx, request)
+	return resp, err
+}
+
+func (p *PoIRPCClient) PlanChargingStops(
+	request *poiv1.PlanChargingStopsRequest,
+	correlation bool,
+	apiKey bool,
+	apiKeyOverride string,
+) (*poiv1.PlanChargingStopsResponse, error) {
+	ctx := contextWithHeaders(correlation, apiKey, apiKeyOverride)
+	resp, err := p.client.PlanChargingStops(ctx, request)
+	return resp, err
+}
+
+func (p *PoIRPCClient) Clusters(
+	ne, sw *poiv1.Coordinate,
+	zoom int32,
+	correlation bool,
+	apiKey bool,
+	apiKeyOverride string,
+) (*poiv1.ClustersResponse, error) {
+	ctx := contextWithHeaders(correlation, apiKey, apiKeyOverride)
+	resp, err := p.client.Clusters(ctx, &poiv1.ClustersRequest{Bbox: &poiv1.BBox{Ne: ne, Sw: sw}, Zoom: zoom})
+	return resp, err
+}
+
+func (p *PoIRPCClient) Density(
+	ne, sw *poiv1.Coordinate,
+	level int32,
+	correlation bool,
+	apiKey bool,
+	apiKeyOverride string,
+) (*poiv1.DensityResponse, error) {
+	ctx := contextWithHeaders(correlation, apiKey, apiKeyOverride)
+	resp, err := p.client.Density(ctx, &poiv1.DensityRequest{Bbox: &poiv1.BBox{Ne: ne, Sw: sw}, Level: level})
+	return resp, err
+}
+
+func (p *PoIRPCClient) StreamProximity(
+	cntr *poiv1.Coordinate,
+	radiusMeters float64,
+	correlation bool,
+	apiKey bool,
+	apiKeyOverride string,
+) ([]*poiv1.PoISearchResponse, error) {
+	ctx := contextWithHeaders(correlation, apiKey, apiKeyOverride)
+	stream, err := p.client.StreamProximity(
+		ctx,
+		&poiv1.ProximityRequest{Center: cntr, RadiusMeters: radiusMeters},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return receiveAll(stream)
+}
+
+func (p *PoIRPCClient) StreamBbox(
+	ne, sw *poiv1.Coordinate,
+	correlation bool,
+	apiKey bool,
+	apiKeyOverride string,
+) ([]*poiv1.PoISearchResponse, error) {
+	ctx := contextWithHeaders(correlation, apiKey, apiKeyOverride)
+	stream, err := p.client.StreamBBox(ctx, &poiv1.BBoxRequest{Bbox: &poiv1.BBox{Ne: ne, Sw: sw}})
+	if err != nil {
+		return nil, err
+	}
+	return receiveAll(stream)
+}
+
+func (p *PoIRPCClient) StreamRoute(
+	route []*poiv1.Coordinate,
+	correlation bool,
+	apiKey bool,
+	apiKeyOverride string,
+) ([]*poiv1.PoISearchResponse, error) {
+	ctx := contextWithHeaders(correlation, apiKey, apiKeyOverride)
+	stream, err := p.client.StreamRoute(ctx, &poiv1.RouteRequest{Route: route})
+	if err != nil {
+		return nil, err
+	}
+	return receiveAll(stream)
+}
+
+// searchStream is implemented by the clients of all streamed search RPCs
+type searchStream interface {
+	Recv() (*poiv1.PoISearchResponse, error)
+}
+
+func receiveAll(stream searchStream) ([]*poiv1.PoISearchResponse, error) {
+	responses := make([]*poiv1.PoISearchResponse, 0)
+	for {
+		resp, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return responses, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		responses = append(responses, resp)
+	}
+}
+
 func contextWithHeaders(
 	correlation bool,
 	apiKey bool,
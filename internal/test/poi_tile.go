@@ -0,0 +1,144 @@
+package test
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+
+	. "github.com/onsi/gomega" //nolint:stylecheck
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+const tilesPath = "tiles"
+
+// The TileHTTP is the decoded layer of a vector tile with point features
+type TileHTTP struct {
+	Layer    string
+	Extent   uint64
+	Features []TileFeatureHTTP
+}
+
+type TileFeatureHTTP struct {
+	X, Y       int64
+	Properties map[string]any
+}
+
+func (p *PoIHTTPProxyClient) Tile(
+	z, x, y int,
+	correlation,
+	apiKey bool,
+	apiKeyOverride string,
+) *HTTPResponse[TileHTTP] {
+	url := fmt.Sprintf("%s/%s/%d/%d/%d.mvt", p.baseURI, tilesPath, z, x, y)
+	req, err := http.NewRequest( //nolint:noctx // no production code
+		http.MethodGet,
+		url,
+		http.NoBody,
+	)
+	Expect(err).To(Not(HaveOccurred()))
+
+	withdHeaders(req, correlation, apiKey, apiKeyOverride)
+	res, err := p.client.Do(req)
+	Expect(err).To(Not(HaveOccurred()))
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return handleHTTPResponse[TileHTTP](res)
+	}
+	Expect(res.Header.Get("Content-Type")).To(Equal("application/vnd.mapbox-vector-tile"))
+	body, err := io.ReadAll(res.Body)
+	Expect(err).To(Not(HaveOccurred()))
+	return &HTTPResponse[TileHTTP]{Ok: decodeTile(body), StatusCode: res.StatusCode}
+}
+
+// TileOf returns the column and row of the XYZ tile of the zoom containing the coordinates
+func TileOf(c CoordinatesHTTP, z int) (x, y int) {
+	n := float64(int(1) << z)
+	lat := c.Lat * math.Pi / 180
+	x = int((c.Lon + 180) / 360 * n)
+	y = int((1 - math.Asinh(math.Tan(lat))/math.Pi) / 2 * n)
+	return x, y
+}
+
+// decodeTile decodes the only layer of the tile, expecting point features with a single point
+func decodeTile(b []byte) *TileHTTP {
+	fields := consumeFields(b)
+	Expect(fields[3]).To(HaveLen(1))
+	layer := consumeFields(fields[3][0].([]byte))
+	Expect(layer[15]).To(Equal([]any{uint64(2)}))
+	keys := make([]string, len(layer[3]))
+	for i, k := range layer[3] {
+		keys[i] = string(k.([]byte))
+	}
+	values := make([]any, len(layer[4]))
+	for i, v := range layer[4] {
+		value := consumeFields(v.([]byte))
+		switch {
+		case value[1] != nil:
+			values[i] = string(value[1][0].([]byte))
+		case value[3] != nil:
+			values[i] = math.Float64frombits(value[3][0].(uint64))
+		case value[4] != nil:
+			values[i] = int64(value[4][0].(uint64)) //nolint:gosec // two's complement
+		case value[7] != nil:
+			values[i] = protowire.DecodeBool(value[7][0].(uint64))
+		}
+	}
+	tile := &TileHTTP{Layer: string(layer[1][0].([]byte)), Extent: layer[5][0].(uint64)}
+	for _, f := range layer[2] {
+		feature := consumeFields(f.([]byte))
+		Expect(feature[3]).To(Equal([]any{uint64(1)}))
+		tags := consumePacked(feature[2][0].([]byte))
+		geometry := consumePacked(feature[4][0].([]byte))
+		Expect(geometry).To(HaveLen(3))
+		Expect(geometry[0]).To(Equal(uint64(9)))
+		properties := make(map[string]any, len(tags)/2)
+		for i := 0; i < len(tags); i += 2 {
+			properties[keys[tags[i]]] = values[tags[i+1]]
+		}
+		tile.Features = append(tile.Features, TileFeatureHTTP{
+			X:          protowire.DecodeZigZag(geometry[1]),
+			Y:          protowire.DecodeZigZag(geometry[2]),
+			Properties: properties,
+		})
+	}
+	return tile
+}
+
+// consumeFields returns the values of the fields by their number, bytes for length-delimited fields and uint64
+// for varint and fixed64 fields
+func consumeFields(b []byte) map[protowire.Number][]any {
+	fields := make(map[protowire.Number][]any)
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		Expect(n).To(BeNumerically(">", 0))
+		b = b[n:]
+		var value any
+		switch typ {
+		case protowire.VarintType:
+			value, n = protowire.ConsumeVarint(b)
+		case protowire.Fixed64Type:
+			value, n = protowire.ConsumeFixed64(b)
+		case protowire.BytesType:
+			value, n = protowire.ConsumeBytes(b)
+		default:
+			n = protowire.ConsumeFieldValue(num, typ, b)
+		}
+		Expect(n).To(BeNumerically(">", 0))
+		b = b[n:]
+		fields[num] = append(fields[num], value)
+	}
+	return fields
+}
+
+func consumePacked(b []byte) []uint64 {
+	var values []uint64
+	for len(b) > 0 {
+		v, n := protowire.ConsumeVarint(b)
+		Expect(n).To(BeNumerically(">", 0))
+		values = append(values, v)
+		b = b[n:]
+	}
+	return values
+}